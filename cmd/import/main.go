@@ -0,0 +1,101 @@
+// Команда import загружает Markdown-файлы или zip-архивы с ними как материалы владельца,
+// используя те же проверки и транзакцию, что и REST-эндпоинт импорта.
+//
+//	import -owner <uuid> [-dry-run] docs/*.md archive.zip
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/google/uuid"
+	kafkalib "github.com/s21platform/kafka-lib"
+
+	"github.com/s21platform/materials-service/internal/config"
+	"github.com/s21platform/materials-service/internal/model"
+	"github.com/s21platform/materials-service/internal/repository/postgres"
+	"github.com/s21platform/materials-service/internal/repository/redis"
+	"github.com/s21platform/materials-service/internal/usecase"
+)
+
+func main() {
+	ownerUUID := flag.String("owner", "", "UUID владельца создаваемых материалов")
+	dryRun := flag.Bool("dry-run", false, "только проверить файлы, ничего не записывая")
+	flag.Parse()
+
+	if _, err := uuid.Parse(*ownerUUID); err != nil {
+		log.Fatalf("invalid -owner: %v", err)
+	}
+	if flag.NArg() == 0 {
+		log.Fatal("no files to import")
+	}
+
+	files := make([]model.ImportFile, 0, flag.NArg())
+	for _, name := range flag.Args() {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			log.Fatalf("failed to read %s: %v", name, err)
+		}
+		files = append(files, model.ImportFile{Name: filepath.Base(name), Data: data})
+	}
+
+	ctx := context.Background()
+	cfg := config.MustLoad()
+
+	dbRepo := postgres.New(cfg)
+	defer dbRepo.Close()
+
+	redisRepo := redis.New(cfg)
+	defer redisRepo.Close()
+
+	createKafkaProducer := kafkalib.NewProducer(kafkalib.DefaultProducerConfig(cfg.Kafka.Host, cfg.Kafka.Port, cfg.Kafka.MaterialCreatedTopic))
+	editKafkaProducer := kafkalib.NewProducer(kafkalib.DefaultProducerConfig(cfg.Kafka.Host, cfg.Kafka.Port, cfg.Kafka.EditMaterialTopic))
+	likeKafkaProducer := kafkalib.NewProducer(kafkalib.DefaultProducerConfig(cfg.Kafka.Host, cfg.Kafka.Port, cfg.Kafka.ToggleLikeMaterialTopic))
+	bulkKafkaProducer := kafkalib.NewProducer(kafkalib.DefaultProducerConfig(cfg.Kafka.Host, cfg.Kafka.Port, cfg.Kafka.BulkOperationTopic))
+
+	// импорту не нужны ни модерация, ни выгрузка материалов, ни обложки и вложения
+	materialsUseCase := usecase.New(dbRepo, redisRepo, createKafkaProducer, editKafkaProducer, likeKafkaProducer, bulkKafkaProducer, nil, nil, nil, nil, cfg)
+
+	report, err := materialsUseCase.ImportMaterials(ctx, *ownerUUID, files, *dryRun)
+	if err != nil {
+		log.Fatalf("failed to import materials: %v", err)
+	}
+
+	printReport(report)
+
+	if !report.Committed && !(report.DryRun && allSucceeded(report)) {
+		os.Exit(1)
+	}
+}
+
+func printReport(report *model.ImportReport) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "FILE\tSTATUS\tTITLE\tUUID\tERROR")
+	for _, result := range report.Results {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", result.Filename, result.Status, result.Title, result.MaterialUUID, result.Error)
+	}
+	_ = w.Flush()
+
+	switch {
+	case report.Committed:
+		fmt.Printf("imported %d materials\n", len(report.Results))
+	case report.DryRun:
+		fmt.Println("dry run, nothing was written")
+	default:
+		fmt.Println("import failed, nothing was written")
+	}
+}
+
+func allSucceeded(report *model.ImportReport) bool {
+	for _, result := range report.Results {
+		if !result.Success {
+			return false
+		}
+	}
+	return true
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/s21platform/materials-service/internal/config"
 	"github.com/s21platform/materials-service/internal/databus/user"
 	"github.com/s21platform/materials-service/internal/repository/postgres"
+	"github.com/s21platform/materials-service/internal/repository/redis"
 )
 
 func main() {
@@ -19,6 +20,9 @@ func main() {
 	dbRepo := postgres.New(cfg)
 	defer dbRepo.Close()
 
+	redisRepo := redis.New(cfg)
+	defer redisRepo.Close()
+
 	metrics, err := pkg.NewMetrics(cfg.Metrics.Host, cfg.Metrics.Port, cfg.Service.Name, cfg.Platform.Env)
 	if err != nil {
 		logger_lib.Error(logger_lib.WithError(ctx, err), "failed to connect graphite:")
@@ -38,6 +42,20 @@ func main() {
 		cfg.Kafka.UserCreatedConsumerGroup,
 	)
 
+	profileConsumerConfig := kafkalib.DefaultConsumerConfig(
+		cfg.Kafka.Host,
+		cfg.Kafka.Port,
+		cfg.Kafka.UserProfileUpdatedTopic,
+		cfg.Kafka.UserProfileUpdatedConsumerGroup,
+	)
+
+	userDeletedConsumerConfig := kafkalib.DefaultConsumerConfig(
+		cfg.Kafka.Host,
+		cfg.Kafka.Port,
+		cfg.Kafka.UserDeletedTopic,
+		cfg.Kafka.UserDeletedConsumerGroup,
+	)
+
 	nicknameConsumer, err := kafkalib.NewConsumer(nicknameConsumerConfig, metrics)
 	if err != nil {
 		logger_lib.Error(logger_lib.WithError(ctx, err), "failed to create consumer:")
@@ -48,9 +66,22 @@ func main() {
 		logger_lib.Error(logger_lib.WithError(ctx, err), "failed to create consumer:")
 	}
 
-	userHandler := user.New(dbRepo)
+	profileConsumer, err := kafkalib.NewConsumer(profileConsumerConfig, metrics)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), "failed to create consumer:")
+	}
+
+	userDeletedConsumer, err := kafkalib.NewConsumer(userDeletedConsumerConfig, metrics)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), "failed to create consumer:")
+	}
+
+	// файлы вложений удаляет воркер сервиса по очереди blob_deletions: хранилище consumer'у недоступно
+	userHandler := user.New(dbRepo, redisRepo, cfg)
 	nicknameConsumer.RegisterHandler(ctx, userHandler.UpdateNickname)
 	userConsumer.RegisterHandler(ctx, userHandler.UserCreated)
+	profileConsumer.RegisterHandler(ctx, userHandler.ProfileUpdated)
+	userDeletedConsumer.RegisterHandler(ctx, userHandler.UserDeleted)
 
 	<-ctx.Done()
 }
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"net"
 	"net/http"
 	"os"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/soheilhy/cmux"
@@ -20,11 +22,24 @@ import (
 	"github.com/s21platform/materials-service/internal/config"
 	api "github.com/s21platform/materials-service/internal/generated"
 	"github.com/s21platform/materials-service/internal/infra"
+	"github.com/s21platform/materials-service/internal/pkg/attachment"
+	"github.com/s21platform/materials-service/internal/pkg/auth"
+	"github.com/s21platform/materials-service/internal/pkg/blob"
+	"github.com/s21platform/materials-service/internal/pkg/cover"
+	"github.com/s21platform/materials-service/internal/pkg/mdexport"
+	"github.com/s21platform/materials-service/internal/pkg/ratelimit"
 	"github.com/s21platform/materials-service/internal/pkg/tx"
 	"github.com/s21platform/materials-service/internal/repository/postgres"
 	"github.com/s21platform/materials-service/internal/repository/redis"
 	"github.com/s21platform/materials-service/internal/rest"
 	"github.com/s21platform/materials-service/internal/service"
+	"github.com/s21platform/materials-service/internal/usecase"
+	"github.com/s21platform/materials-service/internal/worker/blobs"
+	"github.com/s21platform/materials-service/internal/worker/covers"
+	"github.com/s21platform/materials-service/internal/worker/likes"
+	"github.com/s21platform/materials-service/internal/worker/purge"
+	"github.com/s21platform/materials-service/internal/worker/related"
+	"github.com/s21platform/materials-service/internal/worker/trending"
 	"github.com/s21platform/materials-service/pkg/materials"
 )
 
@@ -46,31 +61,64 @@ func main() {
 	}
 	defer metrics.Disconnect()
 
-	materialsService := service.New(dbRepo)
+	authenticator, err := auth.New(cfg)
+	if err != nil {
+		log.Fatalf("failed to create authenticator: %v", err)
+	}
 
-	grpcServer := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			infra.AuthInterceptorGRPC,
-			infra.LoggerGRPC(logger),
-			infra.MetricsInterceptorGRPC(metrics),
-			tx.TxMiddleWareGRPC(dbRepo),
-		),
-	)
-	materials.RegisterMaterialsServiceServer(grpcServer, materialsService)
+	limiter, err := ratelimit.New(redisRepo, cfg)
+	if err != nil {
+		log.Fatalf("failed to create rate limiter: %v", err)
+	}
 
 	createProducerConfig := kafkalib.DefaultProducerConfig(cfg.Kafka.Host, cfg.Kafka.Port, cfg.Kafka.MaterialCreatedTopic)
 	likeProducerConfig := kafkalib.DefaultProducerConfig(cfg.Kafka.Host, cfg.Kafka.Port, cfg.Kafka.ToggleLikeMaterialTopic)
 	editProducerConfig := kafkalib.DefaultProducerConfig(cfg.Kafka.Host, cfg.Kafka.Port, cfg.Kafka.EditMaterialTopic)
+	bulkProducerConfig := kafkalib.DefaultProducerConfig(cfg.Kafka.Host, cfg.Kafka.Port, cfg.Kafka.BulkOperationTopic)
+	moderationProducerConfig := kafkalib.DefaultProducerConfig(cfg.Kafka.Host, cfg.Kafka.Port, cfg.Kafka.ModerationDecisionTopic)
 
 	createKafkaProducer := kafkalib.NewProducer(createProducerConfig)
 	likeKafkaProducer := kafkalib.NewProducer(likeProducerConfig)
 	editKafkaProducer := kafkalib.NewProducer(editProducerConfig)
+	bulkKafkaProducer := kafkalib.NewProducer(bulkProducerConfig)
+	moderationKafkaProducer := kafkalib.NewProducer(moderationProducerConfig)
+
+	blobStorage, err := blob.NewLocal(cfg)
+	if err != nil {
+		log.Fatalf("failed to create blob storage: %v", err)
+	}
+	coverUploader := cover.New(dbRepo, blobStorage, cfg)
+	attachmentManager := attachment.New(dbRepo, blobStorage, cfg)
+	materialExporter := mdexport.New(dbRepo, blobStorage)
 
-	handler := rest.New(dbRepo, createKafkaProducer, likeKafkaProducer, editKafkaProducer, redisRepo)
+	materialsUseCase := usecase.New(dbRepo, redisRepo, createKafkaProducer, editKafkaProducer, likeKafkaProducer, bulkKafkaProducer, moderationKafkaProducer, materialExporter, coverUploader, attachmentManager, cfg)
+
+	materialsService := service.New(materialsUseCase, cfg)
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			infra.AuthInterceptorGRPC(authenticator),
+			infra.LoggerGRPC(logger),
+			infra.MetricsInterceptorGRPC(metrics),
+			infra.RateLimitInterceptorGRPC(limiter, metrics),
+			infra.IdempotencyInterceptorGRPC(redisRepo, cfg),
+			tx.TxMiddleWareGRPC(dbRepo),
+		),
+		grpc.ChainStreamInterceptor(
+			infra.AuthStreamInterceptorGRPC(authenticator),
+			infra.LoggerStreamGRPC(logger),
+			infra.MetricsStreamInterceptorGRPC(metrics),
+			infra.RateLimitStreamInterceptorGRPC(limiter, metrics),
+			tx.TxMiddleWareStreamGRPC(dbRepo),
+		),
+	)
+	materials.RegisterMaterialsServiceServer(grpcServer, materialsService)
+
+	handler := rest.New(materialsUseCase, cfg)
 	router := chi.NewRouter()
 
 	router.Use(func(next http.Handler) http.Handler {
-		return infra.AuthInterceptorHTTP(next)
+		return infra.AuthInterceptorHTTP(next, authenticator, cfg)
 	})
 	router.Use(func(next http.Handler) http.Handler {
 		return infra.LoggerHTTP(next, logger)
@@ -78,13 +126,27 @@ func main() {
 	router.Use(func(next http.Handler) http.Handler {
 		return infra.MetricsInterceptorHTTP(next, metrics)
 	})
+	router.Use(func(next http.Handler) http.Handler {
+		return infra.RateLimitInterceptorHTTP(next, limiter, metrics)
+	})
+	router.Use(func(next http.Handler) http.Handler {
+		return infra.IdempotencyInterceptorHTTP(next, redisRepo, cfg)
+	})
 	router.Use(func(next http.Handler) http.Handler {
 		return tx.TxMiddlewareHTTP(dbRepo)(next)
 	})
 
 	api.HandlerFromMux(handler, router)
+	// вложения отдаются через router: видимость материала проверяется так же, как в GetMaterial
+	router.Get(strings.TrimRight(cfg.Blob.BaseURL, "/")+"/attachments/{material_uuid}/{file}", handler.ServeAttachment)
+
+	// обложки публичны и отдаются напрямую из хранилища в обход middleware
+	mux := http.NewServeMux()
+	mux.Handle(strings.TrimRight(cfg.Blob.BaseURL, "/")+"/covers/", blobStorage.Handler())
+	mux.Handle("/", router)
+
 	httpServer := &http.Server{
-		Handler: router,
+		Handler: mux,
 	}
 
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%s", cfg.Service.Port))
@@ -116,6 +178,42 @@ func main() {
 		return nil
 	})
 
+	g.Go(func() error {
+		purgeLogger := logger_lib.New(cfg.Logger.Host, cfg.Logger.Port, cfg.Service.Name, cfg.Platform.Env)
+		purge.New(dbRepo, redisRepo, cfg).Run(logger_lib.NewContext(ctx, purgeLogger))
+		return nil
+	})
+
+	g.Go(func() error {
+		likesLogger := logger_lib.New(cfg.Logger.Host, cfg.Logger.Port, cfg.Service.Name, cfg.Platform.Env)
+		likes.New(dbRepo, redisRepo, cfg).Run(logger_lib.NewContext(ctx, likesLogger))
+		return nil
+	})
+
+	g.Go(func() error {
+		trendingLogger := logger_lib.New(cfg.Logger.Host, cfg.Logger.Port, cfg.Service.Name, cfg.Platform.Env)
+		trending.New(dbRepo, redisRepo, cfg).Run(logger_lib.NewContext(ctx, trendingLogger))
+		return nil
+	})
+
+	g.Go(func() error {
+		relatedLogger := logger_lib.New(cfg.Logger.Host, cfg.Logger.Port, cfg.Service.Name, cfg.Platform.Env)
+		related.New(dbRepo, cfg).Run(logger_lib.NewContext(ctx, relatedLogger))
+		return nil
+	})
+
+	g.Go(func() error {
+		coversLogger := logger_lib.New(cfg.Logger.Host, cfg.Logger.Port, cfg.Service.Name, cfg.Platform.Env)
+		covers.New(dbRepo, blobStorage, cfg).Run(logger_lib.NewContext(ctx, coversLogger))
+		return nil
+	})
+
+	g.Go(func() error {
+		blobsLogger := logger_lib.New(cfg.Logger.Host, cfg.Logger.Port, cfg.Service.Name, cfg.Platform.Env)
+		blobs.New(dbRepo, blobStorage, cfg).Run(logger_lib.NewContext(ctx, blobsLogger))
+		return nil
+	})
+
 	g.Go(func() error {
 		if err := m.Serve(); err != nil {
 			logger_lib.Error(logger_lib.WithError(ctx, err), "cannot start service")
@@ -2,18 +2,34 @@ package config
 
 import (
 	"log"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
 
 type Config struct {
-	Service  Service
-	Metrics  Metrics
-	Platform Platform
-	Postgres Postgres
-	Logger   Logger
-	Kafka    Kafka
-	Redis    Redis
+	Service     Service
+	Metrics     Metrics
+	Platform    Platform
+	Postgres    Postgres
+	Logger      Logger
+	Kafka       Kafka
+	Redis       Redis
+	Trash       Trash
+	Likes       Likes
+	Reactions   Reactions
+	Trending    Trending
+	Related     Related
+	Reports     Reports
+	Blob        Blob
+	Covers      Covers
+	Attachments Attachments
+	Auth        Auth
+	RateLimit   RateLimit
+	Idempotency Idempotency
+	Export      Export
+	Import      Import
+	Privacy     Privacy
 }
 
 type Service struct {
@@ -50,11 +66,17 @@ type Kafka struct {
 	UserNicknameConsumerGroup               string `env:"USER_NICKNAME_CONSUMER_GROUP"`
 	UserTopic                               string `env:"USER_SET_NEW_PROFILE"`
 	UserCreatedConsumerGroup                string `env:"USER_CREATED_CONSUMER_GROUP"`
+	UserDeletedTopic                        string `env:"USER_DELETED"`
+	UserDeletedConsumerGroup                string `env:"USER_DELETED_CONSUMER_GROUP"`
+	UserProfileUpdatedTopic                 string `env:"USER_PROFILE_UPDATED"`
+	UserProfileUpdatedConsumerGroup         string `env:"USER_PROFILE_UPDATED_CONSUMER_GROUP"`
 	AvatarTopic                             string `env:"MATERIALS_UPDATE_AVATAR_LINK"`
 	MaterialsAvatarUpdateKafkaConsumerGroup string `env:"MATERIALS_AVATAR_UPDATE_KAFKA_CONSUMER_GROUP"`
 	MaterialCreatedTopic                    string `env:"MATERIALS_CREATED_MATERIAL"`
 	ToggleLikeMaterialTopic                 string `env:"MATERIALS_TOGGLE_MATERIAL_LIKE"`
 	EditMaterialTopic                       string `env:"MATERIALS_SET_MATERIAL_EDITED"`
+	BulkOperationTopic                      string `env:"MATERIALS_BULK_OPERATION"`
+	ModerationDecisionTopic                 string `env:"MATERIALS_MODERATION_DECISION"`
 }
 
 type Redis struct {
@@ -62,6 +84,122 @@ type Redis struct {
 	Port string `env:"MATERIALS_SERVICE_REDIS_PORT"`
 }
 
+type Trash struct {
+	RetentionPeriod time.Duration `env:"MATERIALS_TRASH_RETENTION_PERIOD" env-default:"720h"`
+	PurgeInterval   time.Duration `env:"MATERIALS_TRASH_PURGE_INTERVAL" env-default:"1h"`
+	PurgeBatchSize  int           `env:"MATERIALS_TRASH_PURGE_BATCH_SIZE" env-default:"100"`
+}
+
+type Likes struct {
+	ReconcileInterval  time.Duration `env:"MATERIALS_LIKES_RECONCILE_INTERVAL" env-default:"1h"`
+	ReconcileBatchSize int           `env:"MATERIALS_LIKES_RECONCILE_BATCH_SIZE" env-default:"500"`
+}
+
+type Reactions struct {
+	Types []string `env:"MATERIALS_REACTION_TYPES" env-default:"like,helpful,insightful,confusing"`
+}
+
+type Trending struct {
+	Window          time.Duration `env:"MATERIALS_TRENDING_WINDOW" env-default:"168h"`
+	HalfLife        time.Duration `env:"MATERIALS_TRENDING_HALF_LIFE" env-default:"24h"`
+	LikeWeight      float64       `env:"MATERIALS_TRENDING_LIKE_WEIGHT" env-default:"1"`
+	ViewWeight      float64       `env:"MATERIALS_TRENDING_VIEW_WEIGHT" env-default:"0.05"`
+	RefreshInterval time.Duration `env:"MATERIALS_TRENDING_REFRESH_INTERVAL" env-default:"10m"`
+	Size            int           `env:"MATERIALS_TRENDING_SIZE" env-default:"1000"`
+}
+
+type Related struct {
+	RefreshInterval time.Duration `env:"MATERIALS_RELATED_REFRESH_INTERVAL" env-default:"1h"`
+	Size            int           `env:"MATERIALS_RELATED_SIZE" env-default:"50"`
+	ColdStartLikes  int           `env:"MATERIALS_RELATED_COLD_START_LIKES" env-default:"10"`
+	TagWeight       float64       `env:"MATERIALS_RELATED_TAG_WEIGHT" env-default:"0.6"`
+	TextWeight      float64       `env:"MATERIALS_RELATED_TEXT_WEIGHT" env-default:"0.4"`
+	// CooccurrenceLimit — сколько материалов с общими лайкнувшими загружается на один материал
+	CooccurrenceLimit int `env:"MATERIALS_RELATED_COOCCURRENCE_LIMIT" env-default:"200"`
+}
+
+type Reports struct {
+	HideThreshold int    `env:"MATERIALS_REPORTS_HIDE_THRESHOLD" env-default:"5"`
+	ModeratorRole string `env:"MATERIALS_REPORTS_MODERATOR_ROLE" env-default:"moderator"`
+}
+
+// Blob — хранилище загруженных файлов. Оно принадлежит сервису: consumer пользователей и очистка корзины
+// не удаляют файлы сами, а ставят ключи в очередь blob_deletions, которую с повторами разбирает воркер сервиса
+type Blob struct {
+	LocalDir    string        `env:"MATERIALS_BLOB_LOCAL_DIR" env-default:"./media"`
+	BaseURL     string        `env:"MATERIALS_BLOB_BASE_URL" env-default:"/media"`
+	GCInterval  time.Duration `env:"MATERIALS_BLOB_GC_INTERVAL" env-default:"1m"`
+	GCBatchSize int           `env:"MATERIALS_BLOB_GC_BATCH_SIZE" env-default:"100"`
+}
+
+type Covers struct {
+	MaxBytes        int64         `env:"MATERIALS_COVERS_MAX_BYTES" env-default:"10485760"`
+	MinWidth        int           `env:"MATERIALS_COVERS_MIN_WIDTH" env-default:"320"`
+	MinHeight       int           `env:"MATERIALS_COVERS_MIN_HEIGHT" env-default:"180"`
+	MaxWidth        int           `env:"MATERIALS_COVERS_MAX_WIDTH" env-default:"8000"`
+	MaxHeight       int           `env:"MATERIALS_COVERS_MAX_HEIGHT" env-default:"8000"`
+	Width           int           `env:"MATERIALS_COVERS_WIDTH" env-default:"1600"`
+	ThumbnailWidths []int         `env:"MATERIALS_COVERS_THUMBNAIL_WIDTHS" env-default:"320,640"`
+	GCInterval      time.Duration `env:"MATERIALS_COVERS_GC_INTERVAL" env-default:"1h"`
+	GCGracePeriod   time.Duration `env:"MATERIALS_COVERS_GC_GRACE_PERIOD" env-default:"1h"`
+	GCBatchSize     int           `env:"MATERIALS_COVERS_GC_BATCH_SIZE" env-default:"100"`
+}
+
+type Attachments struct {
+	MaxBytes     int64    `env:"MATERIALS_ATTACHMENTS_MAX_BYTES" env-default:"20971520"`
+	AllowedTypes []string `env:"MATERIALS_ATTACHMENTS_ALLOWED_TYPES" env-default:"image/png,image/jpeg,image/gif,image/webp,application/pdf"`
+}
+
+// Auth — проверка подлинности запросов. Сервис не стартует без одного из наборов переменных:
+//
+//	MATERIALS_AUTH_MODE=jwt (по умолчанию): обязателен MATERIALS_AUTH_JWKS_PATH, по желанию
+//	MATERIALS_AUTH_ISSUER и MATERIALS_AUTH_AUDIENCE;
+//	MATERIALS_AUTH_MODE=header: UUID и роли берутся из заголовков шлюза, нужен явный
+//	MATERIALS_AUTH_TRUST_GATEWAY_HEADERS=true. Роли принимаются только с подписью
+//	MATERIALS_AUTH_HEADER_ROLES_SECRET, без секрета запросы идут без ролей.
+type Auth struct {
+	Mode                string        `env:"MATERIALS_AUTH_MODE" env-default:"jwt"`
+	TrustGatewayHeaders bool          `env:"MATERIALS_AUTH_TRUST_GATEWAY_HEADERS" env-default:"false"`
+	HeaderRolesSecret   string        `env:"MATERIALS_AUTH_HEADER_ROLES_SECRET"`
+	JWKSPath            string        `env:"MATERIALS_AUTH_JWKS_PATH"`
+	JWKSReloadInterval  time.Duration `env:"MATERIALS_AUTH_JWKS_RELOAD_INTERVAL" env-default:"1m"`
+	Issuer              string        `env:"MATERIALS_AUTH_ISSUER"`
+	Audience            string        `env:"MATERIALS_AUTH_AUDIENCE"`
+	UUIDClaim           string        `env:"MATERIALS_AUTH_UUID_CLAIM" env-default:"sub"`
+	RolesClaim          string        `env:"MATERIALS_AUTH_ROLES_CLAIM" env-default:"roles"`
+	Leeway              time.Duration `env:"MATERIALS_AUTH_LEEWAY" env-default:"30s"`
+}
+
+type RateLimit struct {
+	Default        string            `env:"MATERIALS_RATE_LIMIT_DEFAULT" env-default:"300/1m"`
+	Methods        map[string]string `env:"MATERIALS_RATE_LIMITS" env-default:"ToggleLike:60/1m,SaveDraftMaterial:20/1m,PUT /api/materials:60/1m,POST /api/materials/save-draft-material:20/1m,POST /api/materials/upload-cover:10/1m,POST /api/materials/upload-attachment:30/1m,ExportMaterials:5/1m,POST /api/materials/import:5/1m,ExportMaterial:20/1m,POST /api/materials/export-material:20/1m"`
+	TrustForwarded bool              `env:"MATERIALS_RATE_LIMIT_TRUST_FORWARDED" env-default:"false"`
+}
+
+type Idempotency struct {
+	TTL     time.Duration `env:"MATERIALS_IDEMPOTENCY_TTL" env-default:"24h"`
+	LockTTL time.Duration `env:"MATERIALS_IDEMPOTENCY_LOCK_TTL" env-default:"30s"`
+	Methods []string      `env:"MATERIALS_IDEMPOTENCY_METHODS" env-default:"SaveDraftMaterial,ToggleLike,DuplicateMaterial,POST /api/materials/save-draft-material,PUT /api/materials,POST /api/materials/duplicate-material"`
+}
+
+type Export struct {
+	Role      string `env:"MATERIALS_EXPORT_ROLE" env-default:"exporter"`
+	BatchSize int    `env:"MATERIALS_EXPORT_BATCH_SIZE" env-default:"500"`
+}
+
+type Import struct {
+	MaxBytes int64 `env:"MATERIALS_IMPORT_MAX_BYTES" env-default:"20971520"`
+	MaxFiles int   `env:"MATERIALS_IMPORT_MAX_FILES" env-default:"100"`
+}
+
+// Privacy — выгрузка и удаление данных пользователя по запросу. ErasurePolicy: reassign — материалы
+// переходят к ReassignTo, mark — остаются за удалённым пользователем с отметкой, delete — удаляются окончательно
+type Privacy struct {
+	AdminRole     string `env:"MATERIALS_PRIVACY_ADMIN_ROLE" env-default:"privacy-admin"`
+	ErasurePolicy string `env:"MATERIALS_ERASURE_POLICY" env-default:"reassign"`
+	ReassignTo    string `env:"MATERIALS_ERASURE_REASSIGN_TO" env-default:"00000000-0000-0000-0000-000000000000"`
+}
+
 func MustLoad() *Config {
 	cfg := &Config{}
 	err := cleanenv.ReadEnv(cfg)
@@ -4,6 +4,7 @@ type key string
 
 const (
 	KeyUUID    = key("uuid")
+	KeyRoles   = key("roles")
 	KeyMetrics = key("metrics")
 	KeyLogger  = key("logger")
 )
@@ -1,3 +1,4 @@
+//go:generate mockgen -destination=mock_contract_test.go -package=${GOPACKAGE} -source=contract.go
 package user
 
 import (
@@ -7,6 +8,22 @@ import (
 )
 
 type DBRepo interface {
-	UpdateUserNickname(ctx context.Context, userUUID, newNickname string) error
-	CreateUser(ctx context.Context, user model.User) error
+	UpsertUser(ctx context.Context, user model.User) error
+	UpsertUserProfile(ctx context.Context, profile *model.UserProfileUpdate) error
+	DeleteUserReactions(ctx context.Context, userUUID string) ([]string, error)
+	ReconcileLikesCounts(ctx context.Context, uuids []string) ([]string, error)
+	EraseUserReports(ctx context.Context, userUUID string) error
+	GetOwnedMaterialUUIDs(ctx context.Context, ownerUUID string) ([]string, error)
+	GetAttachmentStorageKeys(ctx context.Context, materialUUIDs []string) ([]string, error)
+	EnqueueBlobDeletions(ctx context.Context, keys []string) error
+	PurgeMaterials(ctx context.Context, uuids []string) error
+	ReassignUserMaterials(ctx context.Context, fromUUID, toUUID string) error
+	MarkUserMaterials(ctx context.Context, ownerUUID string) error
+	ScrubUser(ctx context.Context, userUUID string) error
+	WithTx(ctx context.Context, cb func(ctx context.Context) error) (err error)
+}
+
+type RedisRepo interface {
+	DeleteMaterials(ctx context.Context, uuids []string) error
+	DeleteAutosave(ctx context.Context, materialUUID string) error
 }
@@ -3,19 +3,30 @@ package user
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
+	"github.com/google/uuid"
 	logger_lib "github.com/s21platform/logger-lib"
 
+	"github.com/s21platform/materials-service/internal/config"
 	"github.com/s21platform/materials-service/internal/model"
 	"github.com/s21platform/user-service/pkg/user"
 )
 
 type Handler struct {
-	repository DBRepo
+	repository    DBRepo
+	redis         RedisRepo
+	erasurePolicy string
+	reassignTo    string
 }
 
-func New(repo DBRepo) *Handler {
-	return &Handler{repository: repo}
+func New(repo DBRepo, redis RedisRepo, cfg *config.Config) *Handler {
+	return &Handler{
+		repository:    repo,
+		redis:         redis,
+		erasurePolicy: cfg.Privacy.ErasurePolicy,
+		reassignTo:    cfg.Privacy.ReassignTo,
+	}
 }
 
 func convertMessage(bMessage []byte, target interface{}) error {
@@ -37,7 +48,7 @@ func (h *Handler) UpdateNickname(ctx context.Context, in []byte) error {
 
 	ctx = logger_lib.WithUserUuid(ctx, msg.UserUuid)
 
-	err = h.repository.UpdateUserNickname(ctx, msg.UserUuid, msg.Nickname)
+	err = h.repository.UpsertUserProfile(ctx, &model.UserProfileUpdate{Uuid: msg.UserUuid, Nickname: &msg.Nickname})
 	if err != nil {
 		logger_lib.Error(logger_lib.WithError(ctx, err), "failed to update user nickname")
 		return err
@@ -47,7 +58,7 @@ func (h *Handler) UpdateNickname(ctx context.Context, in []byte) error {
 }
 
 func (h *Handler) UserCreated(ctx context.Context, in []byte) error {
-	var msg user.UserCreatedMessage
+	var msg model.UserCreatedMessage
 
 	err := convertMessage(in, &msg)
 	if err != nil {
@@ -60,13 +71,12 @@ func (h *Handler) UserCreated(ctx context.Context, in []byte) error {
 	u := model.User{
 		Uuid:       msg.UserUuid,
 		Nickname:   msg.UserNickname,
-		AvatarLink: "",
-		Name:       "",
-		Surname:    "",
+		AvatarLink: msg.AvatarLink,
+		Name:       msg.Name,
+		Surname:    msg.Surname,
 	}
 
-	//TODO: добавить параметры avatarLink, name, surname в метод репозитория CreateUser.
-	err = h.repository.CreateUser(ctx, u)
+	err = h.repository.UpsertUser(ctx, u)
 	if err != nil {
 		logger_lib.Error(logger_lib.WithError(ctx, err), "failed to create user")
 		return err
@@ -74,3 +84,146 @@ func (h *Handler) UserCreated(ctx context.Context, in []byte) error {
 
 	return nil
 }
+
+func (h *Handler) ProfileUpdated(ctx context.Context, in []byte) error {
+	var msg model.UserProfileUpdatedMessage
+
+	err := convertMessage(in, &msg)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), "failed to convert message:")
+		return err
+	}
+
+	ctx = logger_lib.WithUserUuid(ctx, msg.UserUuid)
+
+	profile := &model.UserProfileUpdate{}
+	profile.ToDTO(&msg)
+
+	err = h.repository.UpsertUserProfile(ctx, profile)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), "failed to update user profile")
+		return err
+	}
+
+	return nil
+}
+
+// UserDeleted удаляет данные пользователя: реакции и жалобы удаляются всегда, материалы по ErasurePolicy
+// переходят другому владельцу, помечаются или удаляются окончательно. Зеркало профиля при любой политике
+// остаётся пустым с отметкой удаления, чтобы повторные события профиля не вернули персональные данные.
+// Повторная обработка события ничего не ломает.
+func (h *Handler) UserDeleted(ctx context.Context, in []byte) error {
+	var msg model.UserDeletedMessage
+
+	err := convertMessage(in, &msg)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), "failed to convert message:")
+		return err
+	}
+
+	ctx = logger_lib.WithUserUuid(ctx, msg.UserUuid)
+
+	if _, err = uuid.Parse(msg.UserUuid); err != nil || msg.UserUuid == model.DeletedUserUUID || msg.UserUuid == h.reassignTo {
+		logger_lib.Error(ctx, fmt.Sprintf("invalid deleted user uuid %q", msg.UserUuid))
+		return fmt.Errorf("invalid deleted user uuid %q", msg.UserUuid)
+	}
+
+	erasure, err := h.eraseUser(ctx, msg.UserUuid)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to erase user data: %v", err))
+		return err
+	}
+
+	// кэш чистится после коммита, файлы вложений удалит воркер сервиса по очереди из той же транзакции
+	if len(erasure.MaterialUUIDs) > 0 {
+		if err = h.redis.DeleteMaterials(ctx, erasure.MaterialUUIDs); err != nil {
+			logger_lib.Error(logger_lib.WithError(ctx, err), "failed to invalidate erased materials cache")
+		}
+	}
+
+	for _, materialUUID := range erasure.PurgedUUIDs {
+		if err = h.redis.DeleteAutosave(ctx, materialUUID); err != nil {
+			logger_lib.Error(logger_lib.WithError(ctx, err), "failed to delete erased material autosave")
+		}
+	}
+
+	logger_lib.Info(ctx, fmt.Sprintf("erased user data: policy %s, %d materials affected", h.erasurePolicy, len(erasure.MaterialUUIDs)))
+
+	return nil
+}
+
+func (h *Handler) eraseUser(ctx context.Context, userUUID string) (*model.UserErasure, error) {
+	if !model.IsErasurePolicy(h.erasurePolicy) {
+		return nil, fmt.Errorf("unknown erasure policy %q", h.erasurePolicy)
+	}
+	if h.erasurePolicy == model.ErasurePolicyReassign {
+		if _, err := uuid.Parse(h.reassignTo); err != nil {
+			return nil, fmt.Errorf("invalid erasure reassign target %q", h.reassignTo)
+		}
+	}
+
+	erasure := &model.UserErasure{}
+
+	err := h.repository.WithTx(ctx, func(ctx context.Context) error {
+		reacted, err := h.repository.DeleteUserReactions(ctx, userUUID)
+		if err != nil {
+			return err
+		}
+		if len(reacted) > 0 {
+			if _, err = h.repository.ReconcileLikesCounts(ctx, reacted); err != nil {
+				return err
+			}
+		}
+
+		if err = h.repository.EraseUserReports(ctx, userUUID); err != nil {
+			return err
+		}
+
+		owned, err := h.repository.GetOwnedMaterialUUIDs(ctx, userUUID)
+		if err != nil {
+			return err
+		}
+
+		erasure.MaterialUUIDs = append(reacted, owned...)
+
+		switch h.erasurePolicy {
+		case model.ErasurePolicyMark:
+			if err = h.repository.MarkUserMaterials(ctx, userUUID); err != nil {
+				return err
+			}
+
+		case model.ErasurePolicyDelete:
+			if len(owned) > 0 {
+				keys, err := h.repository.GetAttachmentStorageKeys(ctx, owned)
+				if err != nil {
+					return err
+				}
+				if err = h.repository.EnqueueBlobDeletions(ctx, keys); err != nil {
+					return err
+				}
+				if err = h.repository.PurgeMaterials(ctx, owned); err != nil {
+					return err
+				}
+				erasure.PurgedUUIDs = owned
+			}
+
+			// после окончательного удаления у пользователя остаются только загруженные обложки
+			if err = h.repository.ReassignUserMaterials(ctx, userUUID, model.DeletedUserUUID); err != nil {
+				return err
+			}
+
+		default:
+			if err = h.repository.ReassignUserMaterials(ctx, userUUID, h.reassignTo); err != nil {
+				return err
+			}
+		}
+
+		// строка не удаляется: отметка удаления отсекает повторные UserCreated и ProfileUpdated
+		return h.repository.ScrubUser(ctx, userUUID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return erasure, nil
+}
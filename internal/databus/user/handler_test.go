@@ -0,0 +1,281 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/s21platform/materials-service/internal/model"
+	"github.com/s21platform/user-service/pkg/user"
+)
+
+// usersTable повторяет поведение таблицы users: отметка удаления запрещает upsert-ам менять строку
+type usersTable map[string]*usersRow
+
+type usersRow struct {
+	user    model.User
+	deleted bool
+}
+
+func (t usersTable) upsertUser(_ context.Context, u model.User) error {
+	row, ok := t[u.Uuid]
+	if !ok {
+		t[u.Uuid] = &usersRow{user: u}
+		return nil
+	}
+	if row.deleted {
+		return nil
+	}
+	if row.user.Nickname == "" {
+		row.user.Nickname = u.Nickname
+	}
+	if row.user.AvatarLink == "" {
+		row.user.AvatarLink = u.AvatarLink
+	}
+	if row.user.Name == "" {
+		row.user.Name = u.Name
+	}
+	if row.user.Surname == "" {
+		row.user.Surname = u.Surname
+	}
+	return nil
+}
+
+func (t usersTable) upsertUserProfile(_ context.Context, profile *model.UserProfileUpdate) error {
+	row, ok := t[profile.Uuid]
+	if !ok {
+		row = &usersRow{user: model.User{Uuid: profile.Uuid}}
+		t[profile.Uuid] = row
+	}
+	if row.deleted {
+		return nil
+	}
+	if profile.Nickname != nil {
+		row.user.Nickname = *profile.Nickname
+	}
+	if profile.AvatarLink != nil {
+		row.user.AvatarLink = *profile.AvatarLink
+	}
+	if profile.Name != nil {
+		row.user.Name = *profile.Name
+	}
+	if profile.Surname != nil {
+		row.user.Surname = *profile.Surname
+	}
+	return nil
+}
+
+func (t usersTable) scrubUser(_ context.Context, userUUID string) error {
+	t[userUUID] = &usersRow{user: model.User{Uuid: userUUID, Nickname: model.DeletedUserNickname}, deleted: true}
+	return nil
+}
+
+func mustMarshal(t *testing.T, v interface{}) []byte {
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return data
+}
+
+func TestHandler_UserDeleted_ReplayedEvents(t *testing.T) {
+	t.Parallel()
+
+	policies := []string{model.ErasurePolicyReassign, model.ErasurePolicyMark, model.ErasurePolicyDelete}
+
+	for _, policy := range policies {
+		policy := policy
+		t.Run(policy, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			userUUID := uuid.New().String()
+			materialUUID := uuid.New().String()
+			reassignTo := uuid.New().String()
+			attachmentKey := "attachments/" + materialUUID + "/file.png"
+
+			mockDB := NewMockDBRepo(ctrl)
+			mockRedis := NewMockRedisRepo(ctrl)
+
+			users := usersTable{}
+			mockDB.EXPECT().UpsertUser(gomock.Any(), gomock.Any()).DoAndReturn(users.upsertUser).AnyTimes()
+			mockDB.EXPECT().UpsertUserProfile(gomock.Any(), gomock.Any()).DoAndReturn(users.upsertUserProfile).AnyTimes()
+			mockDB.EXPECT().ScrubUser(gomock.Any(), userUUID).DoAndReturn(users.scrubUser)
+
+			mockDB.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+				return cb(ctx)
+			})
+			mockDB.EXPECT().DeleteUserReactions(gomock.Any(), userUUID).Return(nil, nil)
+			mockDB.EXPECT().EraseUserReports(gomock.Any(), userUUID).Return(nil)
+			mockDB.EXPECT().GetOwnedMaterialUUIDs(gomock.Any(), userUUID).Return([]string{materialUUID}, nil)
+			mockRedis.EXPECT().DeleteMaterials(gomock.Any(), []string{materialUUID}).Return(nil)
+
+			switch policy {
+			case model.ErasurePolicyReassign:
+				mockDB.EXPECT().ReassignUserMaterials(gomock.Any(), userUUID, reassignTo).Return(nil)
+			case model.ErasurePolicyMark:
+				mockDB.EXPECT().MarkUserMaterials(gomock.Any(), userUUID).Return(nil)
+			case model.ErasurePolicyDelete:
+				mockDB.EXPECT().GetAttachmentStorageKeys(gomock.Any(), []string{materialUUID}).Return([]string{attachmentKey}, nil)
+				mockDB.EXPECT().PurgeMaterials(gomock.Any(), []string{materialUUID}).Return(nil)
+				mockDB.EXPECT().ReassignUserMaterials(gomock.Any(), userUUID, model.DeletedUserUUID).Return(nil)
+				mockDB.EXPECT().EnqueueBlobDeletions(gomock.Any(), []string{attachmentKey}).Return(nil)
+				mockRedis.EXPECT().DeleteAutosave(gomock.Any(), materialUUID).Return(nil)
+			}
+
+			handler := &Handler{
+				repository:    mockDB,
+				redis:         mockRedis,
+				erasurePolicy: policy,
+				reassignTo:    reassignTo,
+			}
+
+			name, surname := "Ivan", "Ivanov"
+			require.NoError(t, handler.UserCreated(ctx, mustMarshal(t, model.UserCreatedMessage{
+				UserUuid:     userUUID,
+				UserNickname: "ivan",
+				AvatarLink:   "https://avatars/ivan.png",
+				Name:         name,
+				Surname:      surname,
+			})))
+			require.NoError(t, handler.UserDeleted(ctx, mustMarshal(t, model.UserDeletedMessage{UserUuid: userUUID})))
+
+			// события из очереди, пришедшие после удаления, не возвращают персональные данные
+			nickname := "ivan2"
+			require.NoError(t, handler.UserCreated(ctx, mustMarshal(t, model.UserCreatedMessage{
+				UserUuid:     userUUID,
+				UserNickname: "ivan",
+				AvatarLink:   "https://avatars/ivan.png",
+				Name:         name,
+				Surname:      surname,
+			})))
+			require.NoError(t, handler.ProfileUpdated(ctx, mustMarshal(t, model.UserProfileUpdatedMessage{
+				UserUuid: userUUID,
+				Nickname: &nickname,
+				Name:     &name,
+				Surname:  &surname,
+			})))
+			require.NoError(t, handler.UpdateNickname(ctx, mustMarshal(t, &user.UserNicknameUpdated{
+				UserUuid: userUUID,
+				Nickname: nickname,
+			})))
+
+			require.Contains(t, users, userUUID)
+			assert.True(t, users[userUUID].deleted)
+			assert.Equal(t, model.User{Uuid: userUUID, Nickname: model.DeletedUserNickname}, users[userUUID].user)
+		})
+	}
+}
+
+func TestHandler_UserDeleted_WithoutProfile(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+
+	mockDB := NewMockDBRepo(ctrl)
+	mockRedis := NewMockRedisRepo(ctrl)
+
+	users := usersTable{}
+	mockDB.EXPECT().UpsertUser(gomock.Any(), gomock.Any()).DoAndReturn(users.upsertUser)
+	mockDB.EXPECT().ScrubUser(gomock.Any(), userUUID).DoAndReturn(users.scrubUser)
+	mockDB.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+		return cb(ctx)
+	})
+	mockDB.EXPECT().DeleteUserReactions(gomock.Any(), userUUID).Return(nil, nil)
+	mockDB.EXPECT().EraseUserReports(gomock.Any(), userUUID).Return(nil)
+	mockDB.EXPECT().GetOwnedMaterialUUIDs(gomock.Any(), userUUID).Return(nil, nil)
+	mockDB.EXPECT().ReassignUserMaterials(gomock.Any(), userUUID, model.DeletedUserUUID).Return(nil)
+
+	handler := &Handler{
+		repository:    mockDB,
+		redis:         mockRedis,
+		erasurePolicy: model.ErasurePolicyReassign,
+		reassignTo:    model.DeletedUserUUID,
+	}
+
+	// удаление пришло раньше создания: отметка всё равно появляется и отсекает запоздавшее событие
+	require.NoError(t, handler.UserDeleted(ctx, mustMarshal(t, model.UserDeletedMessage{UserUuid: userUUID})))
+	require.NoError(t, handler.UserCreated(ctx, mustMarshal(t, model.UserCreatedMessage{
+		UserUuid:     userUUID,
+		UserNickname: "ivan",
+		Name:         "Ivan",
+	})))
+
+	assert.True(t, users[userUUID].deleted)
+	assert.Equal(t, model.DeletedUserNickname, users[userUUID].user.Nickname)
+	assert.Empty(t, users[userUUID].user.Name)
+}
+
+func TestHandler_UserDeleted_Errors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid_uuid", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler := &Handler{repository: NewMockDBRepo(ctrl), erasurePolicy: model.ErasurePolicyMark}
+
+		for _, userUUID := range []string{"", "not-a-uuid", model.DeletedUserUUID} {
+			err := handler.UserDeleted(context.Background(), mustMarshal(t, model.UserDeletedMessage{UserUuid: userUUID}))
+			assert.Error(t, err, userUUID)
+		}
+	})
+
+	t.Run("scrub_fails", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userUUID := uuid.New().String()
+		mockDB := NewMockDBRepo(ctrl)
+
+		mockDB.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+		mockDB.EXPECT().DeleteUserReactions(gomock.Any(), userUUID).Return(nil, nil)
+		mockDB.EXPECT().EraseUserReports(gomock.Any(), userUUID).Return(nil)
+		mockDB.EXPECT().GetOwnedMaterialUUIDs(gomock.Any(), userUUID).Return(nil, nil)
+		mockDB.EXPECT().MarkUserMaterials(gomock.Any(), userUUID).Return(nil)
+		mockDB.EXPECT().ScrubUser(gomock.Any(), userUUID).Return(assert.AnError)
+
+		handler := &Handler{repository: mockDB, erasurePolicy: model.ErasurePolicyMark}
+
+		err := handler.UserDeleted(context.Background(), mustMarshal(t, model.UserDeletedMessage{UserUuid: userUUID}))
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("enqueue_fails_rolls_back", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userUUID := uuid.New().String()
+		materialUUID := uuid.New().String()
+		mockDB := NewMockDBRepo(ctrl)
+		mockRedis := NewMockRedisRepo(ctrl)
+
+		// ошибка внутри транзакции откатывает удаление целиком: кэш не трогается, файлы остаются
+		mockDB.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+		mockDB.EXPECT().DeleteUserReactions(gomock.Any(), userUUID).Return(nil, nil)
+		mockDB.EXPECT().EraseUserReports(gomock.Any(), userUUID).Return(nil)
+		mockDB.EXPECT().GetOwnedMaterialUUIDs(gomock.Any(), userUUID).Return([]string{materialUUID}, nil)
+		mockDB.EXPECT().GetAttachmentStorageKeys(gomock.Any(), []string{materialUUID}).Return([]string{"attachments/" + materialUUID + "/a.png"}, nil)
+		mockDB.EXPECT().EnqueueBlobDeletions(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		handler := &Handler{repository: mockDB, redis: mockRedis, erasurePolicy: model.ErasurePolicyDelete}
+
+		err := handler.UserDeleted(context.Background(), mustMarshal(t, model.UserDeletedMessage{UserUuid: userUUID}))
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
@@ -0,0 +1,273 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+
+// Package user is a generated GoMock package.
+package user
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/s21platform/materials-service/internal/model"
+)
+
+// MockDBRepo is a mock of DBRepo interface.
+type MockDBRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockDBRepoMockRecorder
+}
+
+// MockDBRepoMockRecorder is the mock recorder for MockDBRepo.
+type MockDBRepoMockRecorder struct {
+	mock *MockDBRepo
+}
+
+// NewMockDBRepo creates a new mock instance.
+func NewMockDBRepo(ctrl *gomock.Controller) *MockDBRepo {
+	mock := &MockDBRepo{ctrl: ctrl}
+	mock.recorder = &MockDBRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDBRepo) EXPECT() *MockDBRepoMockRecorder {
+	return m.recorder
+}
+
+// DeleteUserReactions mocks base method.
+func (m *MockDBRepo) DeleteUserReactions(ctx context.Context, userUUID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserReactions", ctx, userUUID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserReactions indicates an expected call of DeleteUserReactions.
+func (mr *MockDBRepoMockRecorder) DeleteUserReactions(ctx, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserReactions", reflect.TypeOf((*MockDBRepo)(nil).DeleteUserReactions), ctx, userUUID)
+}
+
+// EnqueueBlobDeletions mocks base method.
+func (m *MockDBRepo) EnqueueBlobDeletions(ctx context.Context, keys []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueBlobDeletions", ctx, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueBlobDeletions indicates an expected call of EnqueueBlobDeletions.
+func (mr *MockDBRepoMockRecorder) EnqueueBlobDeletions(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueBlobDeletions", reflect.TypeOf((*MockDBRepo)(nil).EnqueueBlobDeletions), ctx, keys)
+}
+
+// EraseUserReports mocks base method.
+func (m *MockDBRepo) EraseUserReports(ctx context.Context, userUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseUserReports", ctx, userUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EraseUserReports indicates an expected call of EraseUserReports.
+func (mr *MockDBRepoMockRecorder) EraseUserReports(ctx, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseUserReports", reflect.TypeOf((*MockDBRepo)(nil).EraseUserReports), ctx, userUUID)
+}
+
+// GetAttachmentStorageKeys mocks base method.
+func (m *MockDBRepo) GetAttachmentStorageKeys(ctx context.Context, materialUUIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentStorageKeys", ctx, materialUUIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentStorageKeys indicates an expected call of GetAttachmentStorageKeys.
+func (mr *MockDBRepoMockRecorder) GetAttachmentStorageKeys(ctx, materialUUIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentStorageKeys", reflect.TypeOf((*MockDBRepo)(nil).GetAttachmentStorageKeys), ctx, materialUUIDs)
+}
+
+// GetOwnedMaterialUUIDs mocks base method.
+func (m *MockDBRepo) GetOwnedMaterialUUIDs(ctx context.Context, ownerUUID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwnedMaterialUUIDs", ctx, ownerUUID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwnedMaterialUUIDs indicates an expected call of GetOwnedMaterialUUIDs.
+func (mr *MockDBRepoMockRecorder) GetOwnedMaterialUUIDs(ctx, ownerUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnedMaterialUUIDs", reflect.TypeOf((*MockDBRepo)(nil).GetOwnedMaterialUUIDs), ctx, ownerUUID)
+}
+
+// MarkUserMaterials mocks base method.
+func (m *MockDBRepo) MarkUserMaterials(ctx context.Context, ownerUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUserMaterials", ctx, ownerUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkUserMaterials indicates an expected call of MarkUserMaterials.
+func (mr *MockDBRepoMockRecorder) MarkUserMaterials(ctx, ownerUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUserMaterials", reflect.TypeOf((*MockDBRepo)(nil).MarkUserMaterials), ctx, ownerUUID)
+}
+
+// PurgeMaterials mocks base method.
+func (m *MockDBRepo) PurgeMaterials(ctx context.Context, uuids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeMaterials", ctx, uuids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeMaterials indicates an expected call of PurgeMaterials.
+func (mr *MockDBRepoMockRecorder) PurgeMaterials(ctx, uuids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeMaterials", reflect.TypeOf((*MockDBRepo)(nil).PurgeMaterials), ctx, uuids)
+}
+
+// ReassignUserMaterials mocks base method.
+func (m *MockDBRepo) ReassignUserMaterials(ctx context.Context, fromUUID, toUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReassignUserMaterials", ctx, fromUUID, toUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReassignUserMaterials indicates an expected call of ReassignUserMaterials.
+func (mr *MockDBRepoMockRecorder) ReassignUserMaterials(ctx, fromUUID, toUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignUserMaterials", reflect.TypeOf((*MockDBRepo)(nil).ReassignUserMaterials), ctx, fromUUID, toUUID)
+}
+
+// ReconcileLikesCounts mocks base method.
+func (m *MockDBRepo) ReconcileLikesCounts(ctx context.Context, uuids []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileLikesCounts", ctx, uuids)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileLikesCounts indicates an expected call of ReconcileLikesCounts.
+func (mr *MockDBRepoMockRecorder) ReconcileLikesCounts(ctx, uuids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileLikesCounts", reflect.TypeOf((*MockDBRepo)(nil).ReconcileLikesCounts), ctx, uuids)
+}
+
+// ScrubUser mocks base method.
+func (m *MockDBRepo) ScrubUser(ctx context.Context, userUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScrubUser", ctx, userUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScrubUser indicates an expected call of ScrubUser.
+func (mr *MockDBRepoMockRecorder) ScrubUser(ctx, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScrubUser", reflect.TypeOf((*MockDBRepo)(nil).ScrubUser), ctx, userUUID)
+}
+
+// UpsertUser mocks base method.
+func (m *MockDBRepo) UpsertUser(ctx context.Context, user model.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertUser", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertUser indicates an expected call of UpsertUser.
+func (mr *MockDBRepoMockRecorder) UpsertUser(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertUser", reflect.TypeOf((*MockDBRepo)(nil).UpsertUser), ctx, user)
+}
+
+// UpsertUserProfile mocks base method.
+func (m *MockDBRepo) UpsertUserProfile(ctx context.Context, profile *model.UserProfileUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertUserProfile", ctx, profile)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertUserProfile indicates an expected call of UpsertUserProfile.
+func (mr *MockDBRepoMockRecorder) UpsertUserProfile(ctx, profile interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertUserProfile", reflect.TypeOf((*MockDBRepo)(nil).UpsertUserProfile), ctx, profile)
+}
+
+// WithTx mocks base method.
+func (m *MockDBRepo) WithTx(ctx context.Context, cb func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", ctx, cb)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockDBRepoMockRecorder) WithTx(ctx, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockDBRepo)(nil).WithTx), ctx, cb)
+}
+
+// MockRedisRepo is a mock of RedisRepo interface.
+type MockRedisRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockRedisRepoMockRecorder
+}
+
+// MockRedisRepoMockRecorder is the mock recorder for MockRedisRepo.
+type MockRedisRepoMockRecorder struct {
+	mock *MockRedisRepo
+}
+
+// NewMockRedisRepo creates a new mock instance.
+func NewMockRedisRepo(ctrl *gomock.Controller) *MockRedisRepo {
+	mock := &MockRedisRepo{ctrl: ctrl}
+	mock.recorder = &MockRedisRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRedisRepo) EXPECT() *MockRedisRepoMockRecorder {
+	return m.recorder
+}
+
+// DeleteAutosave mocks base method.
+func (m *MockRedisRepo) DeleteAutosave(ctx context.Context, materialUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAutosave", ctx, materialUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAutosave indicates an expected call of DeleteAutosave.
+func (mr *MockRedisRepoMockRecorder) DeleteAutosave(ctx, materialUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutosave", reflect.TypeOf((*MockRedisRepo)(nil).DeleteAutosave), ctx, materialUUID)
+}
+
+// DeleteMaterials mocks base method.
+func (m *MockRedisRepo) DeleteMaterials(ctx context.Context, uuids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMaterials", ctx, uuids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMaterials indicates an expected call of DeleteMaterials.
+func (mr *MockRedisRepoMockRecorder) DeleteMaterials(ctx, uuids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMaterials", reflect.TypeOf((*MockRedisRepo)(nil).DeleteMaterials), ctx, uuids)
+}
@@ -3,6 +3,119 @@
 // Code generated by github.com/deepmap/oapi-codegen version v1.16.3 DO NOT EDIT.
 package api
 
+import (
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for ErrorReason.
+const (
+	CONFLICT           ErrorReason = "CONFLICT"
+	FAILEDPRECONDITION ErrorReason = "FAILED_PRECONDITION"
+	FORBIDDEN          ErrorReason = "FORBIDDEN"
+	NOTFOUND           ErrorReason = "NOT_FOUND"
+	VALIDATION         ErrorReason = "VALIDATION"
+)
+
+// Defines values for ExportMaterialInFormat.
+const (
+	Html     ExportMaterialInFormat = "html"
+	Markdown ExportMaterialInFormat = "markdown"
+	Zip      ExportMaterialInFormat = "zip"
+)
+
+// ArchiveMaterialIn defines model for ArchiveMaterialIn.
+type ArchiveMaterialIn struct {
+	// Uuid UUID of the material to archive
+	Uuid string `json:"uuid"`
+}
+
+// AutosaveDraftIn defines model for AutosaveDraftIn.
+type AutosaveDraftIn struct {
+	Content         string `json:"content"`
+	CoverImageUrl   string `json:"cover_image_url"`
+	Description     string `json:"description"`
+	ReadTimeMinutes int32  `json:"read_time_minutes"`
+	Title           string `json:"title"`
+	Uuid            string `json:"uuid"`
+}
+
+// AutosaveDraftOut defines model for AutosaveDraftOut.
+type AutosaveDraftOut struct {
+	// SavedAt Time when the autosave was stored
+	SavedAt time.Time `json:"saved_at"`
+}
+
+// BulkItemResult defines model for BulkItemResult.
+type BulkItemResult struct {
+	// Error Reason of the failure if success is false
+	Error   *string `json:"error,omitempty"`
+	Success bool    `json:"success"`
+	Uuid    string  `json:"uuid"`
+}
+
+// BulkMaterialsIn defines model for BulkMaterialsIn.
+type BulkMaterialsIn struct {
+	// Uuids UUIDs of the materials, at most 100
+	Uuids []string `json:"uuids"`
+}
+
+// BulkMaterialsOut defines model for BulkMaterialsOut.
+type BulkMaterialsOut struct {
+	// Results Results in the order of the input list
+	Results []BulkItemResult `json:"results"`
+}
+
+// BulkTagMaterialsIn defines model for BulkTagMaterialsIn.
+type BulkTagMaterialsIn struct {
+	// Tags Tags to add to every material
+	Tags []string `json:"tags"`
+
+	// Uuids UUIDs of the materials, at most 100
+	Uuids []string `json:"uuids"`
+}
+
+// ClearReactionIn defines model for ClearReactionIn.
+type ClearReactionIn struct {
+	// MaterialUuid UUID of the material
+	MaterialUuid string `json:"material_uuid"`
+
+	// Reaction Reaction type, one of the configured types
+	Reaction string `json:"reaction"`
+}
+
+// CoverThumbnail defines model for CoverThumbnail.
+type CoverThumbnail struct {
+	Url string `json:"url"`
+
+	// Width Thumbnail width in pixels
+	Width int32 `json:"width"`
+}
+
+// DeleteMaterialAttachmentIn defines model for DeleteMaterialAttachmentIn.
+type DeleteMaterialAttachmentIn struct {
+	AttachmentUuid string `json:"attachment_uuid"`
+	MaterialUuid   string `json:"material_uuid"`
+}
+
+// DeleteMaterialIn defines model for DeleteMaterialIn.
+type DeleteMaterialIn struct {
+	// Uuid UUID of the material to delete
+	Uuid string `json:"uuid"`
+}
+
+// DuplicateMaterialIn defines model for DuplicateMaterialIn.
+type DuplicateMaterialIn struct {
+	// Uuid UUID of the material to duplicate
+	Uuid string `json:"uuid"`
+}
+
+// DuplicateMaterialOut defines model for DuplicateMaterialOut.
+type DuplicateMaterialOut struct {
+	Material Material `json:"material"`
+}
+
 // EditMaterialIn defines model for EditMaterialIn.
 type EditMaterialIn struct {
 	Content         string  `json:"content"`
@@ -19,17 +132,48 @@ type EditMaterialOut struct {
 	Material Material `json:"material"`
 }
 
-// Error defines model for Error.
+// Error Описание ошибки в формате RFC 7807 (application/problem+json); code и message сохранены для совместимости
 type Error struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
+	Code          int             `json:"code"`
+	Detail        string          `json:"detail"`
+	InvalidParams *[]InvalidParam `json:"invalid_params,omitempty"`
+	Message       string          `json:"message"`
+
+	// Reason Категория доменной ошибки
+	Reason *ErrorReason `json:"reason,omitempty"`
+	Status int          `json:"status"`
+	Title  string       `json:"title"`
+	Type   string       `json:"type"`
+}
+
+// ErrorReason Категория доменной ошибки
+type ErrorReason string
+
+// ExportMaterialIn defines model for ExportMaterialIn.
+type ExportMaterialIn struct {
+	// Format Defaults to markdown
+	Format       *ExportMaterialInFormat `json:"format,omitempty"`
+	MaterialUuid string                  `json:"material_uuid"`
 }
 
+// ExportMaterialInFormat Defaults to markdown
+type ExportMaterialInFormat string
+
 // GetAllMaterialsOut defines model for GetAllMaterialsOut.
 type GetAllMaterialsOut struct {
 	MaterialList []Material `json:"material_list"`
 }
 
+// GetArchivedMaterialsOut defines model for GetArchivedMaterialsOut.
+type GetArchivedMaterialsOut struct {
+	MaterialList []Material `json:"material_list"`
+}
+
+// GetDeletedMaterialsOut defines model for GetDeletedMaterialsOut.
+type GetDeletedMaterialsOut struct {
+	MaterialList []Material `json:"material_list"`
+}
+
 // GetMaterialIn defines model for GetMaterialIn.
 type GetMaterialIn struct {
 	// MaterialUuid UUID of the material to retrieve
@@ -41,16 +185,168 @@ type GetMaterialOut struct {
 	Material Material `json:"material"`
 }
 
+// GetMaterialsByUUIDsIn defines model for GetMaterialsByUUIDsIn.
+type GetMaterialsByUUIDsIn struct {
+	// Uuids UUIDs of the materials to retrieve
+	Uuids []string `json:"uuids"`
+}
+
+// GetMaterialsByUUIDsOut defines model for GetMaterialsByUUIDsOut.
+type GetMaterialsByUUIDsOut struct {
+	Materials []Material `json:"materials"`
+}
+
+// GetRelatedMaterialsOut defines model for GetRelatedMaterialsOut.
+type GetRelatedMaterialsOut struct {
+	// MaterialList Materials in descending order of similarity, excluding the caller's own
+	MaterialList []Material `json:"material_list"`
+}
+
+// GetTrendingMaterialsOut defines model for GetTrendingMaterialsOut.
+type GetTrendingMaterialsOut struct {
+	// MaterialList Materials in descending order of the trending score
+	MaterialList []Material `json:"material_list"`
+}
+
+// HideMaterialIn defines model for HideMaterialIn.
+type HideMaterialIn struct {
+	// MaterialUuid UUID of the material
+	MaterialUuid string `json:"material_uuid"`
+
+	// Reason Reason for hiding
+	Reason *string `json:"reason,omitempty"`
+}
+
+// ImportFileResult defines model for ImportFileResult.
+type ImportFileResult struct {
+	// Error Reason of the failure if success is false
+	Error *string `json:"error,omitempty"`
+
+	// Filename File name, for archive entries prefixed with the archive name
+	Filename string `json:"filename"`
+
+	// MaterialUuid UUID of the created material, set only when the import is committed
+	MaterialUuid *string `json:"material_uuid,omitempty"`
+
+	// Status draft or published
+	Status  *string `json:"status,omitempty"`
+	Success bool    `json:"success"`
+	Title   *string `json:"title,omitempty"`
+}
+
+// ImportMaterialsIn defines model for ImportMaterialsIn.
+type ImportMaterialsIn struct {
+	// DryRun Validate files without creating materials
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// Files Markdown files (.md, .markdown) or zip archives of them
+	Files []openapi_types.File `json:"files"`
+}
+
+// ImportMaterialsOut defines model for ImportMaterialsOut.
+type ImportMaterialsOut struct {
+	// Committed Whether the materials were created
+	Committed bool `json:"committed"`
+	DryRun    bool `json:"dry_run"`
+
+	// Results Results in the order of the uploaded files
+	Results []ImportFileResult `json:"results"`
+}
+
+// InvalidParam defines model for InvalidParam.
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// ListMaterialAttachmentsOut defines model for ListMaterialAttachmentsOut.
+type ListMaterialAttachmentsOut struct {
+	Attachments []MaterialAttachment `json:"attachments"`
+}
+
+// ListMaterialLikersOut defines model for ListMaterialLikersOut.
+type ListMaterialLikersOut struct {
+	Likers []UserSummary `json:"likers"`
+}
+
+// ListMaterialReportsOut defines model for ListMaterialReportsOut.
+type ListMaterialReportsOut struct {
+	Reports []MaterialReport `json:"reports"`
+}
+
 // Material defines model for Material.
 type Material struct {
-	Content         string  `json:"content"`
-	CoverImageUrl   string  `json:"cover_image_url"`
-	Description     string  `json:"description"`
-	OwnerUuid       *string `json:"owner_uuid,omitempty"`
-	ReadTimeMinutes int32   `json:"read_time_minutes"`
-	Status          string  `json:"status"`
-	Title           string  `json:"title"`
-	Uuid            string  `json:"uuid"`
+	Content       string `json:"content"`
+	CoverImageUrl string `json:"cover_image_url"`
+
+	// CoverThumbnails Resized copies of the uploaded cover
+	CoverThumbnails *[]CoverThumbnail `json:"cover_thumbnails,omitempty"`
+	Description     string            `json:"description"`
+
+	// ForkedFromUuid UUID of the material this one was duplicated from
+	ForkedFromUuid *string `json:"forked_from_uuid,omitempty"`
+
+	// ForksCount Number of duplicates made from this material
+	ForksCount *int32 `json:"forks_count,omitempty"`
+
+	// MyReactions Reactions of the current user
+	MyReactions *[]string `json:"my_reactions,omitempty"`
+	OwnerUuid   *string   `json:"owner_uuid,omitempty"`
+
+	// Reactions Reaction counters by type
+	Reactions       *[]ReactionCount `json:"reactions,omitempty"`
+	ReadTimeMinutes int32            `json:"read_time_minutes"`
+	Status          string           `json:"status"`
+	Title           string           `json:"title"`
+	Uuid            string           `json:"uuid"`
+}
+
+// MaterialAttachment defines model for MaterialAttachment.
+type MaterialAttachment struct {
+	// ContentType MIME type detected from the file content
+	ContentType  string    `json:"content_type"`
+	CreatedAt    time.Time `json:"created_at"`
+	Filename     string    `json:"filename"`
+	MaterialUuid string    `json:"material_uuid"`
+	SizeBytes    int64     `json:"size_bytes"`
+	Url          string    `json:"url"`
+
+	// Uuid Reference it in content as attachment://<uuid>
+	Uuid string `json:"uuid"`
+}
+
+// MaterialReport defines model for MaterialReport.
+type MaterialReport struct {
+	Comment      string     `json:"comment"`
+	CreatedAt    time.Time  `json:"created_at"`
+	MaterialUuid string     `json:"material_uuid"`
+	Reason       string     `json:"reason"`
+	ReporterUuid string     `json:"reporter_uuid"`
+	ResolvedAt   *time.Time `json:"resolved_at,omitempty"`
+
+	// ResolvedBy UUID of the moderator
+	ResolvedBy *string `json:"resolved_by,omitempty"`
+
+	// Status open, actioned or dismissed
+	Status string `json:"status"`
+	Uuid   string `json:"uuid"`
+}
+
+// ModerateMaterialOut defines model for ModerateMaterialOut.
+type ModerateMaterialOut struct {
+	// ResolvedReports Number of open reports resolved together with the decision
+	ResolvedReports int32 `json:"resolved_reports"`
+}
+
+// PromoteAutosaveIn defines model for PromoteAutosaveIn.
+type PromoteAutosaveIn struct {
+	// Uuid UUID of the material to promote autosave into
+	Uuid string `json:"uuid"`
+}
+
+// PromoteAutosaveOut defines model for PromoteAutosaveOut.
+type PromoteAutosaveOut struct {
+	Material Material `json:"material"`
 }
 
 // PublishMaterialIn defines model for PublishMaterialIn.
@@ -64,6 +360,61 @@ type PublishMaterialOut struct {
 	Material Material `json:"material"`
 }
 
+// ReactionCount defines model for ReactionCount.
+type ReactionCount struct {
+	Count    int32  `json:"count"`
+	Reaction string `json:"reaction"`
+}
+
+// ReactionsOut defines model for ReactionsOut.
+type ReactionsOut struct {
+	MyReactions []string        `json:"my_reactions"`
+	Reactions   []ReactionCount `json:"reactions"`
+}
+
+// ReportMaterialIn defines model for ReportMaterialIn.
+type ReportMaterialIn struct {
+	// Comment Optional comment, up to 1000 characters
+	Comment *string `json:"comment,omitempty"`
+
+	// MaterialUuid UUID of the material
+	MaterialUuid string `json:"material_uuid"`
+
+	// Reason Reason code, one of spam, abuse, copyright, misinformation, other
+	Reason string `json:"reason"`
+}
+
+// ReportMaterialOut defines model for ReportMaterialOut.
+type ReportMaterialOut struct {
+	// MaterialHidden The material was hidden because the reports threshold was reached
+	MaterialHidden bool   `json:"material_hidden"`
+	ReportUuid     string `json:"report_uuid"`
+}
+
+// ResolveMaterialReportIn defines model for ResolveMaterialReportIn.
+type ResolveMaterialReportIn struct {
+	ReportUuid string `json:"report_uuid"`
+
+	// Resolution actioned or dismissed
+	Resolution string `json:"resolution"`
+}
+
+// ResolveMaterialReportOut defines model for ResolveMaterialReportOut.
+type ResolveMaterialReportOut struct {
+	Report MaterialReport `json:"report"`
+}
+
+// RestoreMaterialIn defines model for RestoreMaterialIn.
+type RestoreMaterialIn struct {
+	// Uuid UUID of the material to restore
+	Uuid string `json:"uuid"`
+}
+
+// RestoreMaterialOut defines model for RestoreMaterialOut.
+type RestoreMaterialOut struct {
+	Material Material `json:"material"`
+}
+
 // SaveDraftMaterialIn defines model for SaveDraftMaterialIn.
 type SaveDraftMaterialIn struct {
 	Content         string `json:"content"`
@@ -78,6 +429,33 @@ type SaveDraftMaterialOut struct {
 	Uuid string `json:"uuid"`
 }
 
+// SetLikeIn defines model for SetLikeIn.
+type SetLikeIn struct {
+	// Liked Desired like state
+	Liked bool `json:"liked"`
+
+	// MaterialUuid UUID of the material
+	MaterialUuid string `json:"material_uuid"`
+}
+
+// SetLikeOut defines model for SetLikeOut.
+type SetLikeOut struct {
+	// IsLiked Whether the material is now liked by the user
+	IsLiked bool `json:"is_liked"`
+
+	// LikesCount Updated count of likes on the material
+	LikesCount int32 `json:"likes_count"`
+}
+
+// SetReactionIn defines model for SetReactionIn.
+type SetReactionIn struct {
+	// MaterialUuid UUID of the material
+	MaterialUuid string `json:"material_uuid"`
+
+	// Reaction Reaction type, one of the configured types
+	Reaction string `json:"reaction"`
+}
+
 // ToggleLikeIn defines model for ToggleLikeIn.
 type ToggleLikeIn struct {
 	// MaterialUuid UUID of the material to toggle like on
@@ -93,6 +471,61 @@ type ToggleLikeOut struct {
 	LikesCount int32 `json:"likes_count"`
 }
 
+// UnarchiveMaterialIn defines model for UnarchiveMaterialIn.
+type UnarchiveMaterialIn struct {
+	// Uuid UUID of the material to unarchive
+	Uuid string `json:"uuid"`
+}
+
+// UnarchiveMaterialOut defines model for UnarchiveMaterialOut.
+type UnarchiveMaterialOut struct {
+	Material Material `json:"material"`
+}
+
+// UnhideMaterialIn defines model for UnhideMaterialIn.
+type UnhideMaterialIn struct {
+	// MaterialUuid UUID of the material
+	MaterialUuid string `json:"material_uuid"`
+}
+
+// UploadAttachmentIn defines model for UploadAttachmentIn.
+type UploadAttachmentIn struct {
+	File openapi_types.File `json:"file"`
+
+	// MaterialUuid UUID of the material
+	MaterialUuid string `json:"material_uuid"`
+}
+
+// UploadAttachmentOut defines model for UploadAttachmentOut.
+type UploadAttachmentOut struct {
+	Attachment MaterialAttachment `json:"attachment"`
+}
+
+// UploadCoverIn defines model for UploadCoverIn.
+type UploadCoverIn struct {
+	// File JPEG or PNG image
+	File openapi_types.File `json:"file"`
+
+	// MaterialUuid UUID of the material
+	MaterialUuid string `json:"material_uuid"`
+}
+
+// UploadCoverOut defines model for UploadCoverOut.
+type UploadCoverOut struct {
+	CoverImageUrl   string           `json:"cover_image_url"`
+	CoverThumbnails []CoverThumbnail `json:"cover_thumbnails"`
+}
+
+// UserSummary defines model for UserSummary.
+type UserSummary struct {
+	AvatarLink string    `json:"avatar_link"`
+	LikedAt    time.Time `json:"liked_at"`
+	Name       *string   `json:"name,omitempty"`
+	Nickname   string    `json:"nickname"`
+	Surname    *string   `json:"surname,omitempty"`
+	Uuid       string    `json:"uuid"`
+}
+
 // GetAllMaterialsParams defines parameters for GetAllMaterials.
 type GetAllMaterialsParams struct {
 	// Page Page number (starting from 1)
@@ -100,19 +533,166 @@ type GetAllMaterialsParams struct {
 
 	// Limit Number of materials per page (max 10)
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// IncludeArchived Include archived materials in the list
+	IncludeArchived *bool `form:"include_archived,omitempty" json:"include_archived,omitempty"`
+}
+
+// GetArchivedMaterialsParams defines parameters for GetArchivedMaterials.
+type GetArchivedMaterialsParams struct {
+	// Page Page number (starting from 1)
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of materials per page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListMaterialAttachmentsParams defines parameters for ListMaterialAttachments.
+type ListMaterialAttachmentsParams struct {
+	// MaterialUuid UUID of the material
+	MaterialUuid string `form:"material_uuid" json:"material_uuid"`
+}
+
+// ListMaterialLikersParams defines parameters for ListMaterialLikers.
+type ListMaterialLikersParams struct {
+	// MaterialUuid UUID of the material
+	MaterialUuid string `form:"material_uuid" json:"material_uuid"`
+
+	// Page Page number (starting from 1)
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of users per page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetRelatedMaterialsParams defines parameters for GetRelatedMaterials.
+type GetRelatedMaterialsParams struct {
+	// MaterialUuid UUID of the material
+	MaterialUuid string `form:"material_uuid" json:"material_uuid"`
+
+	// Page Page number (starting from 1)
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of materials per page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListMaterialReportsParams defines parameters for ListMaterialReports.
+type ListMaterialReportsParams struct {
+	// MaterialUuid Return only reports on this material
+	MaterialUuid *string `form:"material_uuid,omitempty" json:"material_uuid,omitempty"`
+
+	// Page Page number (starting from 1)
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of reports per page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetDeletedMaterialsParams defines parameters for GetDeletedMaterials.
+type GetDeletedMaterialsParams struct {
+	// Page Page number (starting from 1)
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of materials per page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetTrendingMaterialsParams defines parameters for GetTrendingMaterials.
+type GetTrendingMaterialsParams struct {
+	// Page Page number (starting from 1)
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of materials per page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Tag Return only materials with this tag
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
 }
 
 // ToggleLikeJSONRequestBody defines body for ToggleLike for application/json ContentType.
 type ToggleLikeJSONRequestBody = ToggleLikeIn
 
+// ArchiveMaterialJSONRequestBody defines body for ArchiveMaterial for application/json ContentType.
+type ArchiveMaterialJSONRequestBody = ArchiveMaterialIn
+
+// AutosaveDraftJSONRequestBody defines body for AutosaveDraft for application/json ContentType.
+type AutosaveDraftJSONRequestBody = AutosaveDraftIn
+
+// BulkArchiveMaterialsJSONRequestBody defines body for BulkArchiveMaterials for application/json ContentType.
+type BulkArchiveMaterialsJSONRequestBody = BulkMaterialsIn
+
+// BulkDeleteMaterialsJSONRequestBody defines body for BulkDeleteMaterials for application/json ContentType.
+type BulkDeleteMaterialsJSONRequestBody = BulkMaterialsIn
+
+// BulkPublishMaterialsJSONRequestBody defines body for BulkPublishMaterials for application/json ContentType.
+type BulkPublishMaterialsJSONRequestBody = BulkMaterialsIn
+
+// BulkTagMaterialsJSONRequestBody defines body for BulkTagMaterials for application/json ContentType.
+type BulkTagMaterialsJSONRequestBody = BulkTagMaterialsIn
+
+// ClearReactionJSONRequestBody defines body for ClearReaction for application/json ContentType.
+type ClearReactionJSONRequestBody = ClearReactionIn
+
+// DeleteMaterialAttachmentJSONRequestBody defines body for DeleteMaterialAttachment for application/json ContentType.
+type DeleteMaterialAttachmentJSONRequestBody = DeleteMaterialAttachmentIn
+
+// DeleteMaterialJSONRequestBody defines body for DeleteMaterial for application/json ContentType.
+type DeleteMaterialJSONRequestBody = DeleteMaterialIn
+
+// DuplicateMaterialJSONRequestBody defines body for DuplicateMaterial for application/json ContentType.
+type DuplicateMaterialJSONRequestBody = DuplicateMaterialIn
+
 // EditMaterialJSONRequestBody defines body for EditMaterial for application/json ContentType.
 type EditMaterialJSONRequestBody = EditMaterialIn
 
+// ExportMaterialJSONRequestBody defines body for ExportMaterial for application/json ContentType.
+type ExportMaterialJSONRequestBody = ExportMaterialIn
+
 // GetMaterialJSONRequestBody defines body for GetMaterial for application/json ContentType.
 type GetMaterialJSONRequestBody = GetMaterialIn
 
+// GetMaterialsByUUIDsJSONRequestBody defines body for GetMaterialsByUUIDs for application/json ContentType.
+type GetMaterialsByUUIDsJSONRequestBody = GetMaterialsByUUIDsIn
+
+// HideMaterialJSONRequestBody defines body for HideMaterial for application/json ContentType.
+type HideMaterialJSONRequestBody = HideMaterialIn
+
+// ImportMaterialsMultipartRequestBody defines body for ImportMaterials for multipart/form-data ContentType.
+type ImportMaterialsMultipartRequestBody = ImportMaterialsIn
+
+// PromoteAutosaveJSONRequestBody defines body for PromoteAutosave for application/json ContentType.
+type PromoteAutosaveJSONRequestBody = PromoteAutosaveIn
+
 // PublishMaterialJSONRequestBody defines body for PublishMaterial for application/json ContentType.
 type PublishMaterialJSONRequestBody = PublishMaterialIn
 
+// ReportMaterialJSONRequestBody defines body for ReportMaterial for application/json ContentType.
+type ReportMaterialJSONRequestBody = ReportMaterialIn
+
+// ResolveMaterialReportJSONRequestBody defines body for ResolveMaterialReport for application/json ContentType.
+type ResolveMaterialReportJSONRequestBody = ResolveMaterialReportIn
+
+// RestoreMaterialJSONRequestBody defines body for RestoreMaterial for application/json ContentType.
+type RestoreMaterialJSONRequestBody = RestoreMaterialIn
+
 // SaveDraftMaterialJSONRequestBody defines body for SaveDraftMaterial for application/json ContentType.
 type SaveDraftMaterialJSONRequestBody = SaveDraftMaterialIn
+
+// SetLikeJSONRequestBody defines body for SetLike for application/json ContentType.
+type SetLikeJSONRequestBody = SetLikeIn
+
+// SetReactionJSONRequestBody defines body for SetReaction for application/json ContentType.
+type SetReactionJSONRequestBody = SetReactionIn
+
+// UnarchiveMaterialJSONRequestBody defines body for UnarchiveMaterial for application/json ContentType.
+type UnarchiveMaterialJSONRequestBody = UnarchiveMaterialIn
+
+// UnhideMaterialJSONRequestBody defines body for UnhideMaterial for application/json ContentType.
+type UnhideMaterialJSONRequestBody = UnhideMaterialIn
+
+// UploadAttachmentMultipartRequestBody defines body for UploadAttachment for multipart/form-data ContentType.
+type UploadAttachmentMultipartRequestBody = UploadAttachmentIn
+
+// UploadCoverMultipartRequestBody defines body for UploadCover for multipart/form-data ContentType.
+type UploadCoverMultipartRequestBody = UploadCoverIn
@@ -19,18 +19,111 @@ type ServerInterface interface {
 	// Toggle like on a material
 	// (PUT /api/materials)
 	ToggleLike(w http.ResponseWriter, r *http.Request)
+	// Archive a material, its previous status is restored on unarchive
+	// (POST /api/materials/archive-material)
+	ArchiveMaterial(w http.ResponseWriter, r *http.Request)
+	// Get archived materials of the caller
+	// (GET /api/materials/archived)
+	GetArchivedMaterials(w http.ResponseWriter, r *http.Request, params GetArchivedMaterialsParams)
+	// List attachments of a material, owner only
+	// (GET /api/materials/attachments)
+	ListMaterialAttachments(w http.ResponseWriter, r *http.Request, params ListMaterialAttachmentsParams)
+	// Autosave in-progress content of a material
+	// (POST /api/materials/autosave-draft)
+	AutosaveDraft(w http.ResponseWriter, r *http.Request)
+	// Archive several materials of the caller
+	// (POST /api/materials/bulk-archive)
+	BulkArchiveMaterials(w http.ResponseWriter, r *http.Request)
+	// Move several materials of the caller to the trash
+	// (POST /api/materials/bulk-delete)
+	BulkDeleteMaterials(w http.ResponseWriter, r *http.Request)
+	// Publish several materials of the caller
+	// (POST /api/materials/bulk-publish)
+	BulkPublishMaterials(w http.ResponseWriter, r *http.Request)
+	// Add tags to several materials of the caller
+	// (POST /api/materials/bulk-tag)
+	BulkTagMaterials(w http.ResponseWriter, r *http.Request)
+	// Clear a reaction of the caller on a material
+	// (POST /api/materials/clear-reaction)
+	ClearReaction(w http.ResponseWriter, r *http.Request)
+	// Delete an attachment of a material, owner only
+	// (POST /api/materials/delete-attachment)
+	DeleteMaterialAttachment(w http.ResponseWriter, r *http.Request)
+	// Move a material to the trash, it can be restored until the retention period expires
+	// (POST /api/materials/delete-material)
+	DeleteMaterial(w http.ResponseWriter, r *http.Request)
+	// Duplicate a material into a new draft owned by the caller
+	// (POST /api/materials/duplicate-material)
+	DuplicateMaterial(w http.ResponseWriter, r *http.Request)
 	// Edit a material
 	// (POST /api/materials/edit-material)
 	EditMaterial(w http.ResponseWriter, r *http.Request)
+	// Download a material owned by the calling user as Markdown, standalone HTML or a zip bundle with images
+	// (POST /api/materials/export-material)
+	ExportMaterial(w http.ResponseWriter, r *http.Request)
 	// Get a material by UUID
 	// (POST /api/materials/get-material)
 	GetMaterial(w http.ResponseWriter, r *http.Request)
+	// Get up to 100 materials by UUIDs in request order
+	// (POST /api/materials/get-materials-by-uuids)
+	GetMaterialsByUUIDs(w http.ResponseWriter, r *http.Request)
+	// Hide a material from readers, moderators only
+	// (POST /api/materials/hide-material)
+	HideMaterial(w http.ResponseWriter, r *http.Request)
+	// Import materials of the calling user from Markdown files or zip archives with YAML front matter
+	// (POST /api/materials/import)
+	ImportMaterials(w http.ResponseWriter, r *http.Request)
+	// Get users who liked a material
+	// (GET /api/materials/likers)
+	ListMaterialLikers(w http.ResponseWriter, r *http.Request, params ListMaterialLikersParams)
+	// Promote the autosaved content into the material
+	// (POST /api/materials/promote-autosave)
+	PromoteAutosave(w http.ResponseWriter, r *http.Request)
 	// Publish a material
 	// (POST /api/materials/publish-material)
 	PublishMaterial(w http.ResponseWriter, r *http.Request)
+	// Get materials related to the given one
+	// (GET /api/materials/related)
+	GetRelatedMaterials(w http.ResponseWriter, r *http.Request, params GetRelatedMaterialsParams)
+	// Report a material as inappropriate
+	// (POST /api/materials/report-material)
+	ReportMaterial(w http.ResponseWriter, r *http.Request)
+	// Get open reports, moderators only
+	// (GET /api/materials/reports)
+	ListMaterialReports(w http.ResponseWriter, r *http.Request, params ListMaterialReportsParams)
+	// Resolve an open report, moderators only
+	// (POST /api/materials/resolve-report)
+	ResolveMaterialReport(w http.ResponseWriter, r *http.Request)
+	// Restore a deleted material from the trash
+	// (POST /api/materials/restore-material)
+	RestoreMaterial(w http.ResponseWriter, r *http.Request)
 	// Save a draft material
 	// (POST /api/materials/save-draft-material)
 	SaveDraftMaterial(w http.ResponseWriter, r *http.Request)
+	// Set or remove like of the caller on a material
+	// (POST /api/materials/set-like)
+	SetLike(w http.ResponseWriter, r *http.Request)
+	// Set a reaction of the caller on a material
+	// (POST /api/materials/set-reaction)
+	SetReaction(w http.ResponseWriter, r *http.Request)
+	// Get deleted materials of the caller that can still be restored
+	// (GET /api/materials/trash)
+	GetDeletedMaterials(w http.ResponseWriter, r *http.Request, params GetDeletedMaterialsParams)
+	// Get published materials ranked by time-decayed popularity
+	// (GET /api/materials/trending)
+	GetTrendingMaterials(w http.ResponseWriter, r *http.Request, params GetTrendingMaterialsParams)
+	// Unarchive a material and restore its previous status
+	// (POST /api/materials/unarchive-material)
+	UnarchiveMaterial(w http.ResponseWriter, r *http.Request)
+	// Return a hidden material to readers, moderators only
+	// (POST /api/materials/unhide-material)
+	UnhideMaterial(w http.ResponseWriter, r *http.Request)
+	// Upload a file to embed into material content, owner only
+	// (POST /api/materials/upload-attachment)
+	UploadAttachment(w http.ResponseWriter, r *http.Request)
+	// Upload a cover image for a material, owner only
+	// (POST /api/materials/upload-cover)
+	UploadCover(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -49,47 +142,869 @@ func (_ Unimplemented) ToggleLike(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Archive a material, its previous status is restored on unarchive
+// (POST /api/materials/archive-material)
+func (_ Unimplemented) ArchiveMaterial(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get archived materials of the caller
+// (GET /api/materials/archived)
+func (_ Unimplemented) GetArchivedMaterials(w http.ResponseWriter, r *http.Request, params GetArchivedMaterialsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List attachments of a material, owner only
+// (GET /api/materials/attachments)
+func (_ Unimplemented) ListMaterialAttachments(w http.ResponseWriter, r *http.Request, params ListMaterialAttachmentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Autosave in-progress content of a material
+// (POST /api/materials/autosave-draft)
+func (_ Unimplemented) AutosaveDraft(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Archive several materials of the caller
+// (POST /api/materials/bulk-archive)
+func (_ Unimplemented) BulkArchiveMaterials(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Move several materials of the caller to the trash
+// (POST /api/materials/bulk-delete)
+func (_ Unimplemented) BulkDeleteMaterials(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Publish several materials of the caller
+// (POST /api/materials/bulk-publish)
+func (_ Unimplemented) BulkPublishMaterials(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add tags to several materials of the caller
+// (POST /api/materials/bulk-tag)
+func (_ Unimplemented) BulkTagMaterials(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Clear a reaction of the caller on a material
+// (POST /api/materials/clear-reaction)
+func (_ Unimplemented) ClearReaction(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete an attachment of a material, owner only
+// (POST /api/materials/delete-attachment)
+func (_ Unimplemented) DeleteMaterialAttachment(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Move a material to the trash, it can be restored until the retention period expires
+// (POST /api/materials/delete-material)
+func (_ Unimplemented) DeleteMaterial(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Duplicate a material into a new draft owned by the caller
+// (POST /api/materials/duplicate-material)
+func (_ Unimplemented) DuplicateMaterial(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Edit a material
 // (POST /api/materials/edit-material)
 func (_ Unimplemented) EditMaterial(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get a material by UUID
-// (POST /api/materials/get-material)
-func (_ Unimplemented) GetMaterial(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// Download a material owned by the calling user as Markdown, standalone HTML or a zip bundle with images
+// (POST /api/materials/export-material)
+func (_ Unimplemented) ExportMaterial(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a material by UUID
+// (POST /api/materials/get-material)
+func (_ Unimplemented) GetMaterial(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get up to 100 materials by UUIDs in request order
+// (POST /api/materials/get-materials-by-uuids)
+func (_ Unimplemented) GetMaterialsByUUIDs(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Hide a material from readers, moderators only
+// (POST /api/materials/hide-material)
+func (_ Unimplemented) HideMaterial(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import materials of the calling user from Markdown files or zip archives with YAML front matter
+// (POST /api/materials/import)
+func (_ Unimplemented) ImportMaterials(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get users who liked a material
+// (GET /api/materials/likers)
+func (_ Unimplemented) ListMaterialLikers(w http.ResponseWriter, r *http.Request, params ListMaterialLikersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Promote the autosaved content into the material
+// (POST /api/materials/promote-autosave)
+func (_ Unimplemented) PromoteAutosave(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Publish a material
+// (POST /api/materials/publish-material)
+func (_ Unimplemented) PublishMaterial(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get materials related to the given one
+// (GET /api/materials/related)
+func (_ Unimplemented) GetRelatedMaterials(w http.ResponseWriter, r *http.Request, params GetRelatedMaterialsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Report a material as inappropriate
+// (POST /api/materials/report-material)
+func (_ Unimplemented) ReportMaterial(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get open reports, moderators only
+// (GET /api/materials/reports)
+func (_ Unimplemented) ListMaterialReports(w http.ResponseWriter, r *http.Request, params ListMaterialReportsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Resolve an open report, moderators only
+// (POST /api/materials/resolve-report)
+func (_ Unimplemented) ResolveMaterialReport(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Restore a deleted material from the trash
+// (POST /api/materials/restore-material)
+func (_ Unimplemented) RestoreMaterial(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Save a draft material
+// (POST /api/materials/save-draft-material)
+func (_ Unimplemented) SaveDraftMaterial(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set or remove like of the caller on a material
+// (POST /api/materials/set-like)
+func (_ Unimplemented) SetLike(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set a reaction of the caller on a material
+// (POST /api/materials/set-reaction)
+func (_ Unimplemented) SetReaction(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get deleted materials of the caller that can still be restored
+// (GET /api/materials/trash)
+func (_ Unimplemented) GetDeletedMaterials(w http.ResponseWriter, r *http.Request, params GetDeletedMaterialsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get published materials ranked by time-decayed popularity
+// (GET /api/materials/trending)
+func (_ Unimplemented) GetTrendingMaterials(w http.ResponseWriter, r *http.Request, params GetTrendingMaterialsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unarchive a material and restore its previous status
+// (POST /api/materials/unarchive-material)
+func (_ Unimplemented) UnarchiveMaterial(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Return a hidden material to readers, moderators only
+// (POST /api/materials/unhide-material)
+func (_ Unimplemented) UnhideMaterial(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upload a file to embed into material content, owner only
+// (POST /api/materials/upload-attachment)
+func (_ Unimplemented) UploadAttachment(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upload a cover image for a material, owner only
+// (POST /api/materials/upload-cover)
+func (_ Unimplemented) UploadCover(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetAllMaterials operation middleware
+func (siw *ServerInterfaceWrapper) GetAllMaterials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAllMaterialsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_archived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_archived", r.URL.Query(), &params.IncludeArchived)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_archived", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAllMaterials(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ToggleLike operation middleware
+func (siw *ServerInterfaceWrapper) ToggleLike(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ToggleLike(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ArchiveMaterial operation middleware
+func (siw *ServerInterfaceWrapper) ArchiveMaterial(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ArchiveMaterial(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetArchivedMaterials operation middleware
+func (siw *ServerInterfaceWrapper) GetArchivedMaterials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArchivedMaterialsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetArchivedMaterials(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListMaterialAttachments operation middleware
+func (siw *ServerInterfaceWrapper) ListMaterialAttachments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMaterialAttachmentsParams
+
+	// ------------- Required query parameter "material_uuid" -------------
+
+	if paramValue := r.URL.Query().Get("material_uuid"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "material_uuid"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "material_uuid", r.URL.Query(), &params.MaterialUuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "material_uuid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMaterialAttachments(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AutosaveDraft operation middleware
+func (siw *ServerInterfaceWrapper) AutosaveDraft(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AutosaveDraft(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// BulkArchiveMaterials operation middleware
+func (siw *ServerInterfaceWrapper) BulkArchiveMaterials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BulkArchiveMaterials(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// BulkDeleteMaterials operation middleware
+func (siw *ServerInterfaceWrapper) BulkDeleteMaterials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BulkDeleteMaterials(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// BulkPublishMaterials operation middleware
+func (siw *ServerInterfaceWrapper) BulkPublishMaterials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BulkPublishMaterials(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// BulkTagMaterials operation middleware
+func (siw *ServerInterfaceWrapper) BulkTagMaterials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BulkTagMaterials(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ClearReaction operation middleware
+func (siw *ServerInterfaceWrapper) ClearReaction(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ClearReaction(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteMaterialAttachment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMaterialAttachment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMaterialAttachment(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteMaterial operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMaterial(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMaterial(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DuplicateMaterial operation middleware
+func (siw *ServerInterfaceWrapper) DuplicateMaterial(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DuplicateMaterial(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// EditMaterial operation middleware
+func (siw *ServerInterfaceWrapper) EditMaterial(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EditMaterial(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ExportMaterial operation middleware
+func (siw *ServerInterfaceWrapper) ExportMaterial(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportMaterial(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetMaterial operation middleware
+func (siw *ServerInterfaceWrapper) GetMaterial(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMaterial(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetMaterialsByUUIDs operation middleware
+func (siw *ServerInterfaceWrapper) GetMaterialsByUUIDs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMaterialsByUUIDs(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// HideMaterial operation middleware
+func (siw *ServerInterfaceWrapper) HideMaterial(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HideMaterial(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ImportMaterials operation middleware
+func (siw *ServerInterfaceWrapper) ImportMaterials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportMaterials(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListMaterialLikers operation middleware
+func (siw *ServerInterfaceWrapper) ListMaterialLikers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMaterialLikersParams
+
+	// ------------- Required query parameter "material_uuid" -------------
+
+	if paramValue := r.URL.Query().Get("material_uuid"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "material_uuid"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "material_uuid", r.URL.Query(), &params.MaterialUuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "material_uuid", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMaterialLikers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PromoteAutosave operation middleware
+func (siw *ServerInterfaceWrapper) PromoteAutosave(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PromoteAutosave(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PublishMaterial operation middleware
+func (siw *ServerInterfaceWrapper) PublishMaterial(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PublishMaterial(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetRelatedMaterials operation middleware
+func (siw *ServerInterfaceWrapper) GetRelatedMaterials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRelatedMaterialsParams
+
+	// ------------- Required query parameter "material_uuid" -------------
+
+	if paramValue := r.URL.Query().Get("material_uuid"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "material_uuid"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "material_uuid", r.URL.Query(), &params.MaterialUuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "material_uuid", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRelatedMaterials(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ReportMaterial operation middleware
+func (siw *ServerInterfaceWrapper) ReportMaterial(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReportMaterial(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Publish a material
-// (POST /api/materials/publish-material)
-func (_ Unimplemented) PublishMaterial(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ListMaterialReports operation middleware
+func (siw *ServerInterfaceWrapper) ListMaterialReports(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMaterialReportsParams
+
+	// ------------- Optional query parameter "material_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "material_uuid", r.URL.Query(), &params.MaterialUuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "material_uuid", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMaterialReports(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Save a draft material
-// (POST /api/materials/save-draft-material)
-func (_ Unimplemented) SaveDraftMaterial(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ResolveMaterialReport operation middleware
+func (siw *ServerInterfaceWrapper) ResolveMaterialReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResolveMaterialReport(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// RestoreMaterial operation middleware
+func (siw *ServerInterfaceWrapper) RestoreMaterial(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreMaterial(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// SaveDraftMaterial operation middleware
+func (siw *ServerInterfaceWrapper) SaveDraftMaterial(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// GetAllMaterials operation middleware
-func (siw *ServerInterfaceWrapper) GetAllMaterials(w http.ResponseWriter, r *http.Request) {
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SaveDraftMaterial(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// SetLike operation middleware
+func (siw *ServerInterfaceWrapper) SetLike(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetLike(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// SetReaction operation middleware
+func (siw *ServerInterfaceWrapper) SetReaction(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetReaction(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetDeletedMaterials operation middleware
+func (siw *ServerInterfaceWrapper) GetDeletedMaterials(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAllMaterialsParams
+	var params GetDeletedMaterialsParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -108,7 +1023,7 @@ func (siw *ServerInterfaceWrapper) GetAllMaterials(w http.ResponseWriter, r *htt
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAllMaterials(w, r, params)
+		siw.Handler.GetDeletedMaterials(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -118,12 +1033,41 @@ func (siw *ServerInterfaceWrapper) GetAllMaterials(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ToggleLike operation middleware
-func (siw *ServerInterfaceWrapper) ToggleLike(w http.ResponseWriter, r *http.Request) {
+// GetTrendingMaterials operation middleware
+func (siw *ServerInterfaceWrapper) GetTrendingMaterials(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTrendingMaterialsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ToggleLike(w, r)
+		siw.Handler.GetTrendingMaterials(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -133,12 +1077,12 @@ func (siw *ServerInterfaceWrapper) ToggleLike(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// EditMaterial operation middleware
-func (siw *ServerInterfaceWrapper) EditMaterial(w http.ResponseWriter, r *http.Request) {
+// UnarchiveMaterial operation middleware
+func (siw *ServerInterfaceWrapper) UnarchiveMaterial(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.EditMaterial(w, r)
+		siw.Handler.UnarchiveMaterial(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -148,12 +1092,12 @@ func (siw *ServerInterfaceWrapper) EditMaterial(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetMaterial operation middleware
-func (siw *ServerInterfaceWrapper) GetMaterial(w http.ResponseWriter, r *http.Request) {
+// UnhideMaterial operation middleware
+func (siw *ServerInterfaceWrapper) UnhideMaterial(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetMaterial(w, r)
+		siw.Handler.UnhideMaterial(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -163,12 +1107,12 @@ func (siw *ServerInterfaceWrapper) GetMaterial(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PublishMaterial operation middleware
-func (siw *ServerInterfaceWrapper) PublishMaterial(w http.ResponseWriter, r *http.Request) {
+// UploadAttachment operation middleware
+func (siw *ServerInterfaceWrapper) UploadAttachment(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PublishMaterial(w, r)
+		siw.Handler.UploadAttachment(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -178,12 +1122,12 @@ func (siw *ServerInterfaceWrapper) PublishMaterial(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// SaveDraftMaterial operation middleware
-func (siw *ServerInterfaceWrapper) SaveDraftMaterial(w http.ResponseWriter, r *http.Request) {
+// UploadCover operation middleware
+func (siw *ServerInterfaceWrapper) UploadCover(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SaveDraftMaterial(w, r)
+		siw.Handler.UploadCover(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -312,18 +1256,111 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/materials", wrapper.ToggleLike)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/archive-material", wrapper.ArchiveMaterial)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/materials/archived", wrapper.GetArchivedMaterials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/materials/attachments", wrapper.ListMaterialAttachments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/autosave-draft", wrapper.AutosaveDraft)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/bulk-archive", wrapper.BulkArchiveMaterials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/bulk-delete", wrapper.BulkDeleteMaterials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/bulk-publish", wrapper.BulkPublishMaterials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/bulk-tag", wrapper.BulkTagMaterials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/clear-reaction", wrapper.ClearReaction)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/delete-attachment", wrapper.DeleteMaterialAttachment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/delete-material", wrapper.DeleteMaterial)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/duplicate-material", wrapper.DuplicateMaterial)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/materials/edit-material", wrapper.EditMaterial)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/export-material", wrapper.ExportMaterial)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/materials/get-material", wrapper.GetMaterial)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/get-materials-by-uuids", wrapper.GetMaterialsByUUIDs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/hide-material", wrapper.HideMaterial)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/import", wrapper.ImportMaterials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/materials/likers", wrapper.ListMaterialLikers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/promote-autosave", wrapper.PromoteAutosave)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/materials/publish-material", wrapper.PublishMaterial)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/materials/related", wrapper.GetRelatedMaterials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/report-material", wrapper.ReportMaterial)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/materials/reports", wrapper.ListMaterialReports)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/resolve-report", wrapper.ResolveMaterialReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/restore-material", wrapper.RestoreMaterial)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/materials/save-draft-material", wrapper.SaveDraftMaterial)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/set-like", wrapper.SetLike)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/set-reaction", wrapper.SetReaction)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/materials/trash", wrapper.GetDeletedMaterials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/materials/trending", wrapper.GetTrendingMaterials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/unarchive-material", wrapper.UnarchiveMaterial)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/unhide-material", wrapper.UnhideMaterial)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/upload-attachment", wrapper.UploadAttachment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/materials/upload-cover", wrapper.UploadCover)
+	})
 
 	return r
 }
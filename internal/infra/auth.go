@@ -3,6 +3,8 @@ package infra
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -12,60 +14,115 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/s21platform/materials-service/internal/config"
+	"github.com/s21platform/materials-service/internal/model"
+	"github.com/s21platform/materials-service/internal/pkg/auth"
 )
 
-func AuthInterceptorGRPC(
+func AuthInterceptorGRPC(authenticator auth.Authenticator) func(
 	ctx context.Context,
 	req interface{},
-	_ *grpc.UnaryServerInfo,
-	handler grpc.UnaryHandler) (interface{}, error) {
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	return func(
+		ctx context.Context,
+		req interface{},
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx, err := authenticateGRPC(ctx, authenticator)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func AuthStreamInterceptorGRPC(authenticator auth.Authenticator) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := authenticateGRPC(stream.Context(), authenticator)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &model.ContextServerStream{ServerStream: stream, Ctx: ctx})
+	}
+}
+
+func authenticateGRPC(ctx context.Context, authenticator auth.Authenticator) (context.Context, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, "no info in metadata")
 	}
 
-	userIDs, ok := md["uuid"]
-	if !ok || len(userIDs) != 1 {
-		return nil, status.Errorf(codes.Unauthenticated, "no uuid or more than one in metadata")
+	userIDs := md.Get("uuid")
+	if len(userIDs) > 1 {
+		return nil, status.Errorf(codes.Unauthenticated, "more than one uuid in metadata")
+	}
+
+	credentials := auth.Credentials{
+		BearerToken:    auth.BearerToken(firstValue(md.Get("authorization"))),
+		UserUUID:       firstValue(userIDs),
+		Roles:          firstValue(md.Get("roles")),
+		RolesSignature: firstValue(md.Get("roles-signature")),
 	}
 
-	ctx = context.WithValue(ctx, config.KeyUUID, userIDs[0])
+	identity, err := authenticator.Authenticate(ctx, credentials)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to authenticate: %v", err)
+	}
 
-	return handler(ctx, req)
+	return auth.WithIdentity(ctx, identity), nil
 }
 
-func AuthInterceptorHTTP(next http.Handler) http.Handler {
+func AuthInterceptorHTTP(next http.Handler, authenticator auth.Authenticator, cfg *config.Config) http.Handler {
+	attachmentsPrefix := strings.TrimRight(cfg.Blob.BaseURL, "/") + "/attachments/"
+
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		path := r.URL.Path
 		method := r.Method
 
+		// вложения опубликованных материалов доступны анонимно, остальное проверяет ServeAttachment
 		isWhitelisted := (method == http.MethodGet && path == "/api/materials") ||
-			(method == http.MethodPost && path == "/api/materials/get-material")
-
-		userID := r.Header.Get("X-User-Uuid")
-		userID = strings.TrimSpace(userID)
+			(method == http.MethodPost && path == "/api/materials/get-material") ||
+			(method == http.MethodGet && strings.HasPrefix(path, attachmentsPrefix))
+
+		credentials := auth.Credentials{
+			BearerToken:    auth.BearerToken(r.Header.Get("Authorization")),
+			UserUUID:       strings.TrimSpace(r.Header.Get("X-User-Uuid")),
+			Roles:          r.Header.Get("X-User-Roles"),
+			RolesSignature: r.Header.Get("X-User-Roles-Signature"),
+		}
 
-		if isWhitelisted {
-			if userID != "" {
-				ctx := context.WithValue(r.Context(), config.KeyUUID, userID)
-				r = r.WithContext(ctx)
+		identity, err := authenticator.Authenticate(r.Context(), credentials)
+		if err != nil {
+			// анонимный доступ разрешён только к whitelisted-маршрутам и только без учётных данных
+			if isWhitelisted && errors.Is(err, auth.ErrMissingCredentials) {
+				next.ServeHTTP(w, r)
+				return
 			}
-			next.ServeHTTP(w, r)
-			return
-		}
 
-		if userID == "" {
-			writeErrorResponse(w, "missing or empty X-User-Uuid header", http.StatusUnauthorized)
+			writeErrorResponse(w, fmt.Sprintf("failed to authenticate: %v", err), http.StatusUnauthorized)
 			return
 		}
 
-		ctx := context.WithValue(r.Context(), config.KeyUUID, userID)
-		r = r.WithContext(ctx)
-
-		next.ServeHTTP(w, r)
+		next.ServeHTTP(w, r.WithContext(auth.WithIdentity(r.Context(), identity)))
 	})
 }
 
+func firstValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
 func writeErrorResponse(w http.ResponseWriter, message string, statusCode int) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
@@ -0,0 +1,273 @@
+package infra
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	logger_lib "github.com/s21platform/logger-lib"
+
+	"github.com/s21platform/materials-service/internal/config"
+	"github.com/s21platform/materials-service/internal/model"
+)
+
+const (
+	idempotencyKeyHeader   = "Idempotency-Key"
+	idempotencyKeyMetadata = "idempotency-key"
+	idempotencyKeyMaxLen   = 255
+)
+
+type IdempotencyStore interface {
+	AcquireIdempotencyKey(ctx context.Context, key, fingerprint string, lockTTL time.Duration) (*model.IdempotentResponse, error)
+	SaveIdempotentResponse(ctx context.Context, key string, response *model.IdempotentResponse, ttl time.Duration) error
+	ReleaseIdempotencyKey(ctx context.Context, key string) error
+}
+
+type idempotencySettings struct {
+	ttl     time.Duration
+	lockTTL time.Duration
+	methods map[string]struct{}
+}
+
+func newIdempotencySettings(cfg *config.Config) *idempotencySettings {
+	methods := make(map[string]struct{}, len(cfg.Idempotency.Methods))
+	for _, method := range cfg.Idempotency.Methods {
+		methods[strings.TrimSpace(method)] = struct{}{}
+	}
+
+	return &idempotencySettings{
+		ttl:     cfg.Idempotency.TTL,
+		lockTTL: cfg.Idempotency.LockTTL,
+		methods: methods,
+	}
+}
+
+func (s *idempotencySettings) enabled(method string) bool {
+	_, ok := s.methods[method]
+	return ok
+}
+
+func IdempotencyInterceptorGRPC(store IdempotencyStore, cfg *config.Config) func(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	settings := newIdempotencySettings(cfg)
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		method := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		idempotencyKey := strings.TrimSpace(firstValue(md.Get(idempotencyKeyMetadata)))
+		if idempotencyKey == "" || !settings.enabled(method) {
+			return handler(ctx, req)
+		}
+
+		if len(idempotencyKey) > idempotencyKeyMaxLen {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key is too long, max %d characters", idempotencyKeyMaxLen)
+		}
+
+		reqMessage, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		reqBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(reqMessage)
+		if err != nil {
+			return handler(ctx, req)
+		}
+
+		storeKey := idempotencyStoreKey(ctx, method, idempotencyKey)
+		fingerprint := hashFingerprint(method, reqBytes)
+
+		stored, err := store.AcquireIdempotencyKey(ctx, storeKey, fingerprint, settings.lockTTL)
+		if err != nil {
+			logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to acquire idempotency key: %v", err))
+			return handler(ctx, req)
+		}
+
+		if stored != nil {
+			if stored.Fingerprint != fingerprint {
+				return nil, status.Error(codes.InvalidArgument, "idempotency key is already used with a different request")
+			}
+			if stored.State != model.IdempotencyStateDone {
+				return nil, status.Error(codes.Aborted, "request with this idempotency key is already in progress")
+			}
+
+			var anyResp anypb.Any
+			if err = proto.Unmarshal(stored.Body, &anyResp); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to decode stored response: %v", err)
+			}
+			resp, err := anyResp.UnmarshalNew()
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to decode stored response: %v", err)
+			}
+
+			_ = grpc.SetHeader(ctx, metadata.Pairs("idempotent-replayed", "true"))
+			return resp, nil
+		}
+
+		resp, handlerErr := handler(ctx, req)
+
+		respMessage, ok := resp.(proto.Message)
+		if handlerErr != nil || !ok || resp == nil {
+			releaseIdempotencyKey(ctx, store, storeKey)
+			return resp, handlerErr
+		}
+
+		anyResp, err := anypb.New(respMessage)
+		if err == nil {
+			var body []byte
+			body, err = proto.Marshal(anyResp)
+			if err == nil {
+				err = store.SaveIdempotentResponse(ctx, storeKey, &model.IdempotentResponse{
+					Fingerprint: fingerprint,
+					Body:        body,
+				}, settings.ttl)
+			}
+		}
+		if err != nil {
+			logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to save idempotent response: %v", err))
+			releaseIdempotencyKey(ctx, store, storeKey)
+		}
+
+		return resp, nil
+	}
+}
+
+func IdempotencyInterceptorHTTP(next http.Handler, store IdempotencyStore, cfg *config.Config) http.Handler {
+	settings := newIdempotencySettings(cfg)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method := r.Method + " " + r.URL.Path
+
+		idempotencyKey := strings.TrimSpace(r.Header.Get(idempotencyKeyHeader))
+		if idempotencyKey == "" || !settings.enabled(method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if len(idempotencyKey) > idempotencyKeyMaxLen {
+			writeErrorResponse(w, fmt.Sprintf("idempotency key is too long, max %d characters", idempotencyKeyMaxLen), http.StatusBadRequest)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeErrorResponse(w, "failed to read request body", http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := r.Context()
+		storeKey := idempotencyStoreKey(ctx, method, idempotencyKey)
+		fingerprint := hashFingerprint(method, body)
+
+		stored, err := store.AcquireIdempotencyKey(ctx, storeKey, fingerprint, settings.lockTTL)
+		if err != nil {
+			logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to acquire idempotency key: %v", err))
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if stored != nil {
+			if stored.Fingerprint != fingerprint {
+				writeErrorResponse(w, "idempotency key is already used with a different request", http.StatusUnprocessableEntity)
+				return
+			}
+			if stored.State != model.IdempotencyStateDone {
+				writeErrorResponse(w, "request with this idempotency key is already in progress", http.StatusConflict)
+				return
+			}
+
+			if stored.ContentType != "" {
+				w.Header().Set("Content-Type", stored.ContentType)
+			}
+			w.Header().Set("Idempotent-Replayed", "true")
+			w.WriteHeader(stored.StatusCode)
+			_, _ = w.Write(stored.Body)
+			return
+		}
+
+		recorder := &responseRecorder{
+			ResponseWriter: w,
+			statusCode:     http.StatusOK,
+		}
+		next.ServeHTTP(recorder, r)
+
+		// сохраняем только успешные ответы, после ошибки клиент может повторить запрос с тем же ключом
+		if recorder.statusCode < http.StatusOK || recorder.statusCode >= http.StatusMultipleChoices {
+			releaseIdempotencyKey(ctx, store, storeKey)
+			return
+		}
+
+		err = store.SaveIdempotentResponse(ctx, storeKey, &model.IdempotentResponse{
+			Fingerprint: fingerprint,
+			StatusCode:  recorder.statusCode,
+			ContentType: recorder.Header().Get("Content-Type"),
+			Body:        recorder.body.Bytes(),
+		}, settings.ttl)
+		if err != nil {
+			logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to save idempotent response: %v", err))
+			releaseIdempotencyKey(ctx, store, storeKey)
+		}
+	})
+}
+
+// idempotencyStoreKey ограничивает область действия ключа пользователем и методом
+func idempotencyStoreKey(ctx context.Context, method, idempotencyKey string) string {
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		userUUID = "anonymous"
+	}
+	return fmt.Sprintf("%s:%s:%s", userUUID, method, idempotencyKey)
+}
+
+func hashFingerprint(method string, payload []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{0})
+	hash.Write(payload)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func releaseIdempotencyKey(ctx context.Context, store IdempotencyStore, key string) {
+	if err := store.ReleaseIdempotencyKey(ctx, key); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to release idempotency key: %v", err))
+	}
+}
+
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (rec *responseRecorder) WriteHeader(code int) {
+	rec.statusCode = code
+	rec.ResponseWriter.WriteHeader(code)
+}
+
+func (rec *responseRecorder) Write(b []byte) (int, error) {
+	rec.body.Write(b)
+	return rec.ResponseWriter.Write(b)
+}
@@ -0,0 +1,342 @@
+package infra
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/s21platform/materials-service/internal/config"
+	"github.com/s21platform/materials-service/internal/model"
+)
+
+const idempotentMethod = "PUT /api/materials"
+
+// testIdempotencyStore повторяет поведение redis-хранилища: захват ключа с lockTTL и ответ с ttl
+type testIdempotencyStore struct {
+	mu      sync.Mutex
+	now     time.Time
+	entries map[string]*model.IdempotentResponse
+	expires map[string]time.Time
+}
+
+func newTestIdempotencyStore() *testIdempotencyStore {
+	return &testIdempotencyStore{
+		now:     time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+		entries: make(map[string]*model.IdempotentResponse),
+		expires: make(map[string]time.Time),
+	}
+}
+
+func (s *testIdempotencyStore) AcquireIdempotencyKey(_ context.Context, key, fingerprint string, lockTTL time.Duration) (*model.IdempotentResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if entry, ok := s.entries[key]; ok && s.now.Before(s.expires[key]) {
+		stored := *entry
+		return &stored, nil
+	}
+
+	s.entries[key] = &model.IdempotentResponse{State: model.IdempotencyStateProcessing, Fingerprint: fingerprint}
+	s.expires[key] = s.now.Add(lockTTL)
+	return nil, nil
+}
+
+func (s *testIdempotencyStore) SaveIdempotentResponse(_ context.Context, key string, response *model.IdempotentResponse, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	saved := *response
+	saved.State = model.IdempotencyStateDone
+	s.entries[key] = &saved
+	s.expires[key] = s.now.Add(ttl)
+	return nil
+}
+
+func (s *testIdempotencyStore) ReleaseIdempotencyKey(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	delete(s.expires, key)
+	return nil
+}
+
+func (s *testIdempotencyStore) advance(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.now = s.now.Add(d)
+}
+
+func idempotencyConfig() *config.Config {
+	cfg := &config.Config{}
+	cfg.Idempotency.TTL = time.Hour
+	cfg.Idempotency.LockTTL = 30 * time.Second
+	cfg.Idempotency.Methods = []string{idempotentMethod, "ToggleLike"}
+	return cfg
+}
+
+// countingHandler отвечает статусом status и номером вызова, чтобы отличать повтор от нового выполнения
+type countingHandler struct {
+	mu     sync.Mutex
+	calls  int
+	status int
+}
+
+func (h *countingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mu.Lock()
+	h.calls++
+	calls := h.calls
+	h.mu.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(h.status)
+	_, _ = w.Write([]byte(`{"call":` + strconv.Itoa(calls) + `,"body":` + string(body) + `}`))
+}
+
+func idempotentRequest(handler http.Handler, userUUID, key, body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodPut, "/api/materials", strings.NewReader(body))
+	request.Header.Set(idempotencyKeyHeader, key)
+	if userUUID != "" {
+		request = request.WithContext(context.WithValue(request.Context(), config.KeyUUID, userUUID))
+	}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestIdempotencyInterceptorHTTP(t *testing.T) {
+	t.Parallel()
+
+	t.Run("replays_stored_response", func(t *testing.T) {
+		t.Parallel()
+
+		next := &countingHandler{status: http.StatusCreated}
+		handler := IdempotencyInterceptorHTTP(next, newTestIdempotencyStore(), idempotencyConfig())
+
+		first := idempotentRequest(handler, "user", "key-1", `{"title":"a"}`)
+		replayed := idempotentRequest(handler, "user", "key-1", `{"title":"a"}`)
+
+		assert.Equal(t, 1, next.calls)
+		assert.Equal(t, http.StatusCreated, replayed.Code)
+		assert.Equal(t, "application/json", replayed.Header().Get("Content-Type"))
+		assert.Equal(t, "true", replayed.Header().Get("Idempotent-Replayed"))
+		assert.Empty(t, first.Header().Get("Idempotent-Replayed"))
+		assert.Equal(t, first.Body.String(), replayed.Body.String())
+	})
+
+	t.Run("key_reused_with_different_body", func(t *testing.T) {
+		t.Parallel()
+
+		next := &countingHandler{status: http.StatusOK}
+		handler := IdempotencyInterceptorHTTP(next, newTestIdempotencyStore(), idempotencyConfig())
+
+		idempotentRequest(handler, "user", "key-1", `{"title":"a"}`)
+		rejected := idempotentRequest(handler, "user", "key-1", `{"title":"b"}`)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rejected.Code)
+		assert.Equal(t, 1, next.calls)
+	})
+
+	t.Run("concurrent_request_with_same_key", func(t *testing.T) {
+		t.Parallel()
+
+		started := make(chan struct{})
+		release := make(chan struct{})
+		var calls int
+		next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			calls++
+			close(started)
+			<-release
+			w.WriteHeader(http.StatusOK)
+		})
+		handler := IdempotencyInterceptorHTTP(next, newTestIdempotencyStore(), idempotencyConfig())
+
+		done := make(chan *httptest.ResponseRecorder)
+		go func() {
+			done <- idempotentRequest(handler, "user", "key-1", `{}`)
+		}()
+		<-started
+
+		concurrent := idempotentRequest(handler, "user", "key-1", `{}`)
+		assert.Equal(t, http.StatusConflict, concurrent.Code)
+
+		close(release)
+		assert.Equal(t, http.StatusOK, (<-done).Code)
+
+		// после завершения первого запроса тот же ключ отдаёт сохранённый ответ
+		replayed := idempotentRequest(handler, "user", "key-1", `{}`)
+		assert.Equal(t, "true", replayed.Header().Get("Idempotent-Replayed"))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("ttl_expiry", func(t *testing.T) {
+		t.Parallel()
+
+		store := newTestIdempotencyStore()
+		next := &countingHandler{status: http.StatusOK}
+		handler := IdempotencyInterceptorHTTP(next, store, idempotencyConfig())
+
+		idempotentRequest(handler, "user", "key-1", `{}`)
+		store.advance(59 * time.Minute)
+		idempotentRequest(handler, "user", "key-1", `{}`)
+		assert.Equal(t, 1, next.calls)
+
+		store.advance(time.Minute)
+		fresh := idempotentRequest(handler, "user", "key-1", `{}`)
+		assert.Equal(t, 2, next.calls)
+		assert.Empty(t, fresh.Header().Get("Idempotent-Replayed"))
+	})
+
+	t.Run("abandoned_lock_expires", func(t *testing.T) {
+		t.Parallel()
+
+		store := newTestIdempotencyStore()
+		next := &countingHandler{status: http.StatusOK}
+		handler := IdempotencyInterceptorHTTP(next, store, idempotencyConfig())
+
+		// ключ занят экземпляром, который упал, не дописав ответ
+		_, err := store.AcquireIdempotencyKey(context.Background(), idempotencyStoreKey(
+			context.WithValue(context.Background(), config.KeyUUID, "user"), idempotentMethod, "key-1",
+		), hashFingerprint(idempotentMethod, []byte(`{}`)), 30*time.Second)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusConflict, idempotentRequest(handler, "user", "key-1", `{}`).Code)
+
+		store.advance(30 * time.Second)
+		assert.Equal(t, http.StatusOK, idempotentRequest(handler, "user", "key-1", `{}`).Code)
+		assert.Equal(t, 1, next.calls)
+	})
+
+	t.Run("failed_response_releases_key", func(t *testing.T) {
+		t.Parallel()
+
+		next := &countingHandler{status: http.StatusInternalServerError}
+		handler := IdempotencyInterceptorHTTP(next, newTestIdempotencyStore(), idempotencyConfig())
+
+		idempotentRequest(handler, "user", "key-1", `{}`)
+		retried := idempotentRequest(handler, "user", "key-1", `{}`)
+
+		assert.Equal(t, 2, next.calls)
+		assert.Empty(t, retried.Header().Get("Idempotent-Replayed"))
+	})
+
+	t.Run("keys_scoped_per_user", func(t *testing.T) {
+		t.Parallel()
+
+		next := &countingHandler{status: http.StatusOK}
+		handler := IdempotencyInterceptorHTTP(next, newTestIdempotencyStore(), idempotencyConfig())
+
+		idempotentRequest(handler, "alice", "key-1", `{}`)
+		other := idempotentRequest(handler, "bob", "key-1", `{}`)
+
+		assert.Equal(t, 2, next.calls)
+		assert.Empty(t, other.Header().Get("Idempotent-Replayed"))
+	})
+
+	t.Run("too_long_key", func(t *testing.T) {
+		t.Parallel()
+
+		next := &countingHandler{status: http.StatusOK}
+		handler := IdempotencyInterceptorHTTP(next, newTestIdempotencyStore(), idempotencyConfig())
+
+		rejected := idempotentRequest(handler, "user", strings.Repeat("k", idempotencyKeyMaxLen+1), `{}`)
+		assert.Equal(t, http.StatusBadRequest, rejected.Code)
+		assert.Zero(t, next.calls)
+	})
+}
+
+func TestIdempotencyInterceptorGRPC(t *testing.T) {
+	t.Parallel()
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/MaterialsService/ToggleLike"}
+	keyContext := func(key string) context.Context {
+		ctx := context.WithValue(context.Background(), config.KeyUUID, "user")
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(idempotencyKeyMetadata, key))
+	}
+
+	t.Run("replays_stored_response", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor := IdempotencyInterceptorGRPC(newTestIdempotencyStore(), idempotencyConfig())
+
+		calls := 0
+		handler := func(context.Context, interface{}) (interface{}, error) {
+			calls++
+			return wrapperspb.Int64(int64(calls)), nil
+		}
+
+		first, err := interceptor(keyContext("key-1"), wrapperspb.String("material"), info, handler)
+		require.NoError(t, err)
+		replayed, err := interceptor(keyContext("key-1"), wrapperspb.String("material"), info, handler)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, calls)
+		assert.True(t, proto.Equal(first.(proto.Message), replayed.(proto.Message)))
+	})
+
+	t.Run("concurrent_and_mismatched_requests", func(t *testing.T) {
+		t.Parallel()
+
+		store := newTestIdempotencyStore()
+		interceptor := IdempotencyInterceptorGRPC(store, idempotencyConfig())
+
+		var inner error
+		var innerMismatch error
+		handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+			// повтор с тем же ключом, пока первый запрос ещё выполняется
+			_, inner = interceptor(keyContext("key-1"), wrapperspb.String("material"), info, func(context.Context, interface{}) (interface{}, error) {
+				t.Fatal("concurrent request must not reach the handler")
+				return nil, nil
+			})
+			_, innerMismatch = interceptor(keyContext("key-1"), wrapperspb.String("other"), info, func(context.Context, interface{}) (interface{}, error) {
+				t.Fatal("mismatched request must not reach the handler")
+				return nil, nil
+			})
+			return wrapperspb.Bool(true), nil
+		}
+
+		_, err := interceptor(keyContext("key-1"), wrapperspb.String("material"), info, handler)
+		require.NoError(t, err)
+		assert.Equal(t, codes.Aborted, status.Code(inner))
+		assert.Equal(t, codes.InvalidArgument, status.Code(innerMismatch))
+	})
+
+	t.Run("handler_error_releases_key", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor := IdempotencyInterceptorGRPC(newTestIdempotencyStore(), idempotencyConfig())
+
+		calls := 0
+		handler := func(context.Context, interface{}) (interface{}, error) {
+			calls++
+			if calls == 1 {
+				return nil, status.Error(codes.Unavailable, "db down")
+			}
+			return wrapperspb.Bool(true), nil
+		}
+
+		_, err := interceptor(keyContext("key-1"), wrapperspb.String("material"), info, handler)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		_, err = interceptor(keyContext("key-1"), wrapperspb.String("material"), info, handler)
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+}
@@ -7,6 +7,8 @@ import (
 	"google.golang.org/grpc"
 
 	logger_lib "github.com/s21platform/logger-lib"
+
+	"github.com/s21platform/materials-service/internal/model"
 )
 
 func LoggerGRPC(logger *logger_lib.Logger) func(context.Context, interface{}, *grpc.UnaryServerInfo, grpc.UnaryHandler) (interface{}, error) {
@@ -17,6 +19,14 @@ func LoggerGRPC(logger *logger_lib.Logger) func(context.Context, interface{}, *g
 	}
 }
 
+func LoggerStreamGRPC(logger *logger_lib.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := logger_lib.NewContext(stream.Context(), logger)
+		ctx = logger_lib.WithField(ctx, "method", info.FullMethod)
+		return handler(srv, &model.ContextServerStream{ServerStream: stream, Ctx: ctx})
+	}
+}
+
 func LoggerHTTP(next http.Handler, logger *logger_lib.Logger) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := logger_lib.NewContext(r.Context(), logger)
@@ -11,9 +11,10 @@ import (
 	"github.com/s21platform/metrics-lib/pkg"
 
 	"github.com/s21platform/materials-service/internal/config"
+	"github.com/s21platform/materials-service/internal/model"
 )
 
-func MetricsInterceptorGRPC(metrics *pkg.Metrics) func(
+func MetricsInterceptorGRPC(metrics pkg.MetricInterface) func(
 	ctx context.Context,
 	req interface{},
 	info *grpc.UnaryServerInfo,
@@ -42,7 +43,32 @@ func MetricsInterceptorGRPC(metrics *pkg.Metrics) func(
 	}
 }
 
-func MetricsInterceptorHTTP(next http.Handler, metrics *pkg.Metrics) http.Handler {
+// MetricsStreamInterceptorGRPC считает длительность стрима целиком, от открытия до последнего сообщения
+func MetricsStreamInterceptorGRPC(metrics pkg.MetricInterface) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		startTime := time.Now()
+		method := strings.Trim(strings.ReplaceAll(info.FullMethod, "/", "_"), "_")
+		metrics.Increment(method)
+
+		ctx := context.WithValue(stream.Context(), config.KeyMetrics, metrics)
+		err := handler(srv, &model.ContextServerStream{ServerStream: stream, Ctx: ctx})
+
+		if err != nil {
+			metrics.Increment(method + "_error")
+		}
+
+		metrics.Duration(time.Since(startTime).Milliseconds(), method)
+
+		return err
+	}
+}
+
+func MetricsInterceptorHTTP(next http.Handler, metrics pkg.MetricInterface) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		startTime := time.Now()
 
@@ -0,0 +1,147 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	logger_lib "github.com/s21platform/logger-lib"
+	"github.com/s21platform/metrics-lib/pkg"
+
+	"github.com/s21platform/materials-service/internal/config"
+	"github.com/s21platform/materials-service/internal/pkg/ratelimit"
+)
+
+func RateLimitInterceptorGRPC(limiter *ratelimit.Limiter, metrics pkg.MetricInterface) func(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := allowGRPC(ctx, limiter, metrics, info.FullMethod, func(md metadata.MD) error {
+			return grpc.SetHeader(ctx, md)
+		}); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// RateLimitStreamInterceptorGRPC списывает лимит один раз при открытии стрима
+func RateLimitStreamInterceptorGRPC(limiter *ratelimit.Limiter, metrics pkg.MetricInterface) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := allowGRPC(stream.Context(), limiter, metrics, info.FullMethod, stream.SetHeader); err != nil {
+			return err
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+func allowGRPC(ctx context.Context, limiter *ratelimit.Limiter, metrics pkg.MetricInterface, fullMethod string, setHeader func(metadata.MD) error) error {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+
+	subject := subjectFromContext(ctx)
+	if subject == "" {
+		if p, ok := peer.FromContext(ctx); ok {
+			subject = "ip:" + hostOnly(p.Addr.String())
+		}
+	}
+
+	allowed, retryAfter, err := limiter.Allow(ctx, method, subject)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to check rate limit: %v", err))
+	}
+
+	if !allowed {
+		metrics.Increment("rate_limit_rejected_" + method)
+		_ = setHeader(metadata.Pairs("retry-after", retryAfterSeconds(retryAfter)))
+		return status.Errorf(codes.ResourceExhausted, "rate limit exceeded, retry after %s seconds", retryAfterSeconds(retryAfter))
+	}
+
+	return nil
+}
+
+func RateLimitInterceptorHTTP(next http.Handler, limiter *ratelimit.Limiter, metrics pkg.MetricInterface) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method := r.Method + " " + r.URL.Path
+
+		subject := subjectFromContext(r.Context())
+		if subject == "" {
+			subject = "ip:" + clientIP(r, limiter.TrustForwarded())
+		}
+
+		allowed, retryAfter, err := limiter.Allow(r.Context(), method, subject)
+		if err != nil {
+			logger_lib.Error(logger_lib.WithError(r.Context(), err), fmt.Sprintf("failed to check rate limit: %v", err))
+		}
+
+		if !allowed {
+			path := strings.NewReplacer("/", "_", "-", "_").Replace(strings.Trim(r.URL.Path, "/"))
+			metrics.Increment("rate_limit_rejected_" + r.Method + "_" + path)
+
+			w.Header().Set("Retry-After", retryAfterSeconds(retryAfter))
+			writeErrorResponse(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// subjectFromContext возвращает ключ пользователя, если он аутентифицирован
+func subjectFromContext(ctx context.Context) string {
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		return ""
+	}
+	return "user:" + userUUID
+}
+
+func clientIP(r *http.Request, trustForwarded bool) string {
+	if trustForwarded {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			first, _, _ := strings.Cut(forwarded, ",")
+			return strings.TrimSpace(first)
+		}
+		if realIP := strings.TrimSpace(r.Header.Get("X-Real-Ip")); realIP != "" {
+			return realIP
+		}
+	}
+	return hostOnly(r.RemoteAddr)
+}
+
+func hostOnly(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
+
+func retryAfterSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,142 @@
+package infra
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/s21platform/metrics-lib/pkg"
+
+	"github.com/s21platform/materials-service/internal/config"
+	"github.com/s21platform/materials-service/internal/pkg/ratelimit"
+)
+
+func newTestLimiter(t *testing.T, store ratelimit.Store, trustForwarded bool, methods map[string]string) *ratelimit.Limiter {
+	t.Helper()
+
+	cfg := &config.Config{}
+	cfg.RateLimit.Default = "0"
+	cfg.RateLimit.Methods = methods
+	cfg.RateLimit.TrustForwarded = trustForwarded
+
+	limiter, err := ratelimit.New(store, cfg)
+	require.NoError(t, err)
+	return limiter
+}
+
+func TestRateLimitInterceptorHTTP(t *testing.T) {
+	t.Parallel()
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	t.Run("rejects_with_retry_after_and_metric", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := &testStore{ttl: 1500 * time.Millisecond}
+		metrics := pkg.NewMockMetricInterface(ctrl)
+		metrics.EXPECT().Increment("rate_limit_rejected_POST_api_materials_upload_cover").Times(1)
+
+		handler := RateLimitInterceptorHTTP(next, newTestLimiter(t, store, false, map[string]string{
+			"POST /api/materials/upload-cover": "1/1m",
+		}), metrics)
+
+		ctx := context.WithValue(context.Background(), config.KeyUUID, "user")
+		request := func() *httptest.ResponseRecorder {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/materials/upload-cover", nil).WithContext(ctx))
+			return recorder
+		}
+
+		assert.Equal(t, http.StatusNoContent, request().Code)
+
+		rejected := request()
+		assert.Equal(t, http.StatusTooManyRequests, rejected.Code)
+		// оставшиеся 1.5s округляются вверх
+		assert.Equal(t, "2", rejected.Header().Get("Retry-After"))
+		assert.JSONEq(t, `{"error":"rate limit exceeded"}`, rejected.Body.String())
+		assert.Equal(t, int64(2), store.hits["POST /api/materials/upload-cover:user:user"])
+	})
+
+	t.Run("anonymous_limited_by_remote_ip", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := &testStore{}
+		metrics := pkg.NewMockMetricInterface(ctrl)
+		handler := RateLimitInterceptorHTTP(next, newTestLimiter(t, store, false, map[string]string{
+			"GET /api/materials": "10/1m",
+		}), metrics)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/materials", nil)
+		request.RemoteAddr = "10.0.0.7:51234"
+		request.Header.Set("X-Forwarded-For", "203.0.113.9")
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+
+		// без доверия прокси заголовок игнорируется
+		assert.Equal(t, map[string]int64{"GET /api/materials:ip:10.0.0.7": 1}, store.hits)
+	})
+
+	t.Run("trusted_forwarded_for", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := &testStore{}
+		metrics := pkg.NewMockMetricInterface(ctrl)
+		handler := RateLimitInterceptorHTTP(next, newTestLimiter(t, store, true, map[string]string{
+			"GET /api/materials": "10/1m",
+		}), metrics)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/materials", nil)
+		request.Header.Set("X-Forwarded-For", "203.0.113.9, 10.0.0.7")
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+
+		assert.Equal(t, map[string]int64{"GET /api/materials:ip:203.0.113.9": 1}, store.hits)
+	})
+}
+
+func TestRateLimitInterceptorGRPC(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := &testStore{}
+	metrics := pkg.NewMockMetricInterface(ctrl)
+	metrics.EXPECT().Increment("rate_limit_rejected_ToggleLike").Times(1)
+
+	interceptor := RateLimitInterceptorGRPC(newTestLimiter(t, store, false, map[string]string{"ToggleLike": "1/30s"}), metrics)
+	info := &grpc.UnaryServerInfo{FullMethod: "/MaterialsService/ToggleLike"}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 40000}})
+
+	calls := 0
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		calls++
+		return "ok", nil
+	}
+
+	resp, err := interceptor(ctx, nil, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	_, err = interceptor(ctx, nil, info, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "retry after 30 seconds")
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, int64(2), store.hits["ToggleLike:ip:192.0.2.1"])
+}
@@ -0,0 +1,214 @@
+package infra
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	logger_lib "github.com/s21platform/logger-lib"
+	"github.com/s21platform/metrics-lib/pkg"
+
+	"github.com/s21platform/materials-service/internal/config"
+	"github.com/s21platform/materials-service/internal/pkg/auth"
+	"github.com/s21platform/materials-service/internal/pkg/ratelimit"
+)
+
+const exportMethod = "/MaterialsService/ExportMaterials"
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testServerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+type testAuthenticator struct {
+	identity *auth.Identity
+	err      error
+	got      auth.Credentials
+}
+
+func (a *testAuthenticator) Authenticate(_ context.Context, credentials auth.Credentials) (*auth.Identity, error) {
+	a.got = credentials
+	return a.identity, a.err
+}
+
+// testStore — счётчик фиксированного окна в памяти вместо redis
+type testStore struct {
+	hits map[string]int64
+	ttl  time.Duration
+}
+
+func (s *testStore) RateLimitHit(_ context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	if s.hits == nil {
+		s.hits = make(map[string]int64)
+	}
+	s.hits[key]++
+	if s.ttl > 0 {
+		return s.hits[key], s.ttl, nil
+	}
+	return s.hits[key], window, nil
+}
+
+func TestAuthStreamInterceptorGRPC(t *testing.T) {
+	t.Parallel()
+
+	t.Run("identity_in_stream_context", func(t *testing.T) {
+		t.Parallel()
+
+		authenticator := &testAuthenticator{identity: &auth.Identity{UserUUID: "user", Roles: []string{"exporter"}}}
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))
+
+		var handlerCtx context.Context
+		err := AuthStreamInterceptorGRPC(authenticator)(nil, &testServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: exportMethod},
+			func(_ interface{}, stream grpc.ServerStream) error {
+				handlerCtx = stream.Context()
+				return nil
+			})
+
+		require.NoError(t, err)
+		assert.Equal(t, "token", authenticator.got.BearerToken)
+		assert.Equal(t, "user", handlerCtx.Value(config.KeyUUID))
+		assert.True(t, auth.HasRole(handlerCtx, "exporter"))
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		t.Parallel()
+
+		authenticator := &testAuthenticator{err: auth.ErrInvalidCredentials}
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer bad"))
+
+		err := AuthStreamInterceptorGRPC(authenticator)(nil, &testServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: exportMethod},
+			func(interface{}, grpc.ServerStream) error {
+				t.Fatal("handler must not be called")
+				return nil
+			})
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("no_metadata", func(t *testing.T) {
+		t.Parallel()
+
+		err := AuthStreamInterceptorGRPC(&testAuthenticator{})(nil, &testServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: exportMethod},
+			func(interface{}, grpc.ServerStream) error {
+				t.Fatal("handler must not be called")
+				return nil
+			})
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestLoggerStreamGRPC(t *testing.T) {
+	t.Parallel()
+
+	logger := logger_lib.New("localhost", "0", "materials-service", "test")
+
+	var handlerCtx context.Context
+	err := LoggerStreamGRPC(logger)(nil, &testServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: exportMethod},
+		func(_ interface{}, stream grpc.ServerStream) error {
+			handlerCtx = stream.Context()
+			return nil
+		})
+
+	require.NoError(t, err)
+	assert.Same(t, logger, logger_lib.FromContext(handlerCtx))
+}
+
+func TestMetricsStreamInterceptorGRPC(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		metrics := pkg.NewMockMetricInterface(ctrl)
+		metrics.EXPECT().Increment("MaterialsService_ExportMaterials")
+		metrics.EXPECT().Duration(gomock.Any(), "MaterialsService_ExportMaterials")
+
+		var handlerCtx context.Context
+		err := MetricsStreamInterceptorGRPC(metrics)(nil, &testServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: exportMethod},
+			func(_ interface{}, stream grpc.ServerStream) error {
+				handlerCtx = stream.Context()
+				return nil
+			})
+
+		require.NoError(t, err)
+		assert.Equal(t, metrics, pkg.FromContext(handlerCtx, config.KeyMetrics))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		metrics := pkg.NewMockMetricInterface(ctrl)
+		metrics.EXPECT().Increment("MaterialsService_ExportMaterials")
+		metrics.EXPECT().Increment("MaterialsService_ExportMaterials_error")
+		metrics.EXPECT().Duration(gomock.Any(), "MaterialsService_ExportMaterials")
+
+		err := MetricsStreamInterceptorGRPC(metrics)(nil, &testServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: exportMethod},
+			func(interface{}, grpc.ServerStream) error {
+				return status.Error(codes.Internal, "failed to export materials")
+			})
+
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
+func TestRateLimitStreamInterceptorGRPC(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	cfg.RateLimit.Default = "0"
+	cfg.RateLimit.Methods = map[string]string{"ExportMaterials": "2/1m"}
+
+	store := &testStore{ttl: 1500 * time.Millisecond}
+	limiter, err := ratelimit.New(store, cfg)
+	require.NoError(t, err)
+
+	metrics := pkg.NewMockMetricInterface(ctrl)
+	metrics.EXPECT().Increment("rate_limit_rejected_ExportMaterials")
+
+	interceptor := RateLimitStreamInterceptorGRPC(limiter, metrics)
+	ctx := context.WithValue(context.Background(), config.KeyUUID, "user")
+
+	calls := 0
+	handler := func(interface{}, grpc.ServerStream) error {
+		calls++
+		return nil
+	}
+
+	// лимит списывается один раз на открытие стрима, а не на каждое сообщение
+	for i := 0; i < 2; i++ {
+		require.NoError(t, interceptor(nil, &testServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: exportMethod}, handler))
+	}
+
+	stream := &testServerStream{ctx: ctx}
+	err = interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: exportMethod}, handler)
+
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []string{"2"}, stream.header.Get("retry-after"))
+	assert.Equal(t, int64(3), store.hits["ExportMaterials:user:user"])
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/s21platform/materials-service/pkg/materials"
+)
+
+type MaterialAttachment struct {
+	UUID         string    `db:"uuid"`
+	MaterialUUID string    `db:"material_uuid"`
+	OwnerUUID    string    `db:"owner_uuid"`
+	Filename     string    `db:"filename"`
+	ContentType  string    `db:"content_type"`
+	SizeBytes    int64     `db:"size_bytes"`
+	StorageKey   string    `db:"storage_key"`
+	CreatedAt    time.Time `db:"created_at"`
+	URL          string    `db:"-"`
+}
+
+func (a *MaterialAttachment) FromDTO() *materials.MaterialAttachment {
+	return &materials.MaterialAttachment{
+		Uuid:         a.UUID,
+		MaterialUuid: a.MaterialUUID,
+		Filename:     a.Filename,
+		ContentType:  a.ContentType,
+		SizeBytes:    a.SizeBytes,
+		Url:          a.URL,
+		CreatedAt:    timestamppb.New(a.CreatedAt),
+	}
+}
+
+type MaterialAttachmentList []MaterialAttachment
+
+func (l MaterialAttachmentList) FromDTO() []*materials.MaterialAttachment {
+	result := make([]*materials.MaterialAttachment, 0, len(l))
+	for i := range l {
+		result = append(result, l[i].FromDTO())
+	}
+	return result
+}
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"github.com/s21platform/materials-service/pkg/materials"
+)
+
+type AutosaveDraft struct {
+	MaterialUUID    string
+	Title           string
+	CoverImageURL   string
+	Description     string
+	Content         string
+	ReadTimeMinutes int32
+	SavedAt         time.Time
+}
+
+func (a *AutosaveDraft) ToDTO(in *materials.AutosaveDraftIn) {
+	a.MaterialUUID = in.Uuid
+	a.Title = in.Title
+	a.CoverImageURL = in.CoverImageUrl
+	a.Description = in.Description
+	a.Content = in.Content
+	a.ReadTimeMinutes = in.ReadTimeMinutes
+}
+
+func (a *AutosaveDraft) ToEditMaterial() *EditMaterial {
+	return &EditMaterial{
+		UUID:            a.MaterialUUID,
+		Title:           a.Title,
+		CoverImageURL:   a.CoverImageURL,
+		Description:     a.Description,
+		Content:         a.Content,
+		ReadTimeMinutes: a.ReadTimeMinutes,
+	}
+}
@@ -0,0 +1,172 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/s21platform/materials-service/pkg/materials"
+)
+
+const (
+	BulkActionDelete  = "delete"
+	BulkActionArchive = "archive"
+	BulkActionPublish = "publish"
+	BulkActionTag     = "tag"
+	// отмена архивации и восстановление из корзины бывают только для одного материала,
+	// но уходят в тот же топик, что и bulk-операции
+	BulkActionUnarchive = "unarchive"
+	BulkActionRestore   = "restore"
+
+	// BulkMaxItems ограничивает количество материалов в одном bulk-запросе
+	BulkMaxItems = 100
+	// TagMaxLength ограничивает длину одного тега
+	TagMaxLength = 64
+)
+
+type MaterialState struct {
+	UUID       string     `db:"uuid"`
+	OwnerUUID  string     `db:"owner_uuid"`
+	Status     string     `db:"status"`
+	ArchivedAt *time.Time `db:"archived_at"`
+	DeletedAt  *time.Time `db:"deleted_at"`
+}
+
+type BulkItemResult struct {
+	UUID    string
+	Success bool
+	Error   string
+}
+
+type BulkResultList []BulkItemResult
+
+// CheckBulkItems проверяет владельца и состояние каждого материала для bulk-операции.
+// Возвращает UUID материалов, к которым можно применить операцию, и результаты в порядке входного списка.
+func CheckBulkItems(action, userUUID string, uuids []string, states []MaterialState) ([]string, BulkResultList) {
+	stateByUUID := make(map[string]MaterialState, len(states))
+	for _, state := range states {
+		stateByUUID[state.UUID] = state
+	}
+
+	allowed := make([]string, 0, len(uuids))
+	results := make(BulkResultList, 0, len(uuids))
+	seen := make(map[string]struct{}, len(uuids))
+
+	for _, materialUUID := range uuids {
+		if _, ok := seen[materialUUID]; ok {
+			continue
+		}
+		seen[materialUUID] = struct{}{}
+
+		state, ok := stateByUUID[materialUUID]
+		errMsg := ""
+		switch {
+		case !ok:
+			errMsg = "material not found"
+		case state.OwnerUUID != userUUID:
+			errMsg = "user is not owner"
+		case state.DeletedAt != nil:
+			errMsg = "material already deleted"
+		case action == BulkActionArchive && state.ArchivedAt != nil:
+			errMsg = "material already archived"
+		case action == BulkActionPublish && state.ArchivedAt != nil:
+			errMsg = "material is archived"
+		case action == BulkActionPublish && state.Status == "published":
+			errMsg = "material already published"
+		}
+
+		if errMsg != "" {
+			results = append(results, BulkItemResult{UUID: materialUUID, Error: errMsg})
+			continue
+		}
+
+		allowed = append(allowed, materialUUID)
+		results = append(results, BulkItemResult{UUID: materialUUID, Success: true})
+	}
+
+	return allowed, results
+}
+
+// SplitValidUUIDs отделяет корректные UUID от некорректных, для последних сразу формирует результат с ошибкой.
+func SplitValidUUIDs(uuids []string) ([]string, BulkResultList) {
+	valid := make([]string, 0, len(uuids))
+	var invalid BulkResultList
+
+	for _, materialUUID := range uuids {
+		if _, err := uuid.Parse(materialUUID); err != nil {
+			invalid = append(invalid, BulkItemResult{UUID: materialUUID, Error: "invalid uuid"})
+			continue
+		}
+		valid = append(valid, materialUUID)
+	}
+
+	return valid, invalid
+}
+
+// MergeBulkResults собирает результаты в порядке входного списка UUID.
+func MergeBulkResults(uuids []string, lists ...BulkResultList) BulkResultList {
+	byUUID := make(map[string]BulkItemResult, len(uuids))
+	for _, list := range lists {
+		for _, result := range list {
+			byUUID[result.UUID] = result
+		}
+	}
+
+	merged := make(BulkResultList, 0, len(byUUID))
+	seen := make(map[string]struct{}, len(uuids))
+	for _, materialUUID := range uuids {
+		if _, ok := seen[materialUUID]; ok {
+			continue
+		}
+		seen[materialUUID] = struct{}{}
+
+		if result, ok := byUUID[materialUUID]; ok {
+			merged = append(merged, result)
+		}
+	}
+
+	return merged
+}
+
+// NormalizeTags приводит теги к нижнему регистру, убирает пробелы, пустые значения и дубликаты.
+func NormalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		normalized = append(normalized, tag)
+	}
+
+	return normalized
+}
+
+func (l BulkResultList) SucceededUUIDs() []string {
+	uuids := make([]string, 0, len(l))
+	for _, result := range l {
+		if result.Success {
+			uuids = append(uuids, result.UUID)
+		}
+	}
+	return uuids
+}
+
+func (l BulkResultList) FromDTO() []*materials.BulkItemResult {
+	protoResults := make([]*materials.BulkItemResult, 0, len(l))
+	for _, result := range l {
+		protoResults = append(protoResults, &materials.BulkItemResult{
+			Uuid:    result.UUID,
+			Success: result.Success,
+			Error:   result.Error,
+		})
+	}
+	return protoResults
+}
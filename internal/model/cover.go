@@ -0,0 +1,75 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/s21platform/materials-service/pkg/materials"
+)
+
+type CoverThumbnail struct {
+	Width int32  `json:"width"`
+	URL   string `json:"url"`
+}
+
+// CoverThumbnailList — уменьшенные копии обложки, хранятся в materials.cover_thumbnails
+type CoverThumbnailList []CoverThumbnail
+
+func (l *CoverThumbnailList) Scan(src interface{}) error {
+	var data []byte
+	switch v := src.(type) {
+	case nil:
+		*l = nil
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported cover thumbnails type %T", src)
+	}
+
+	var thumbnails CoverThumbnailList
+	if err := json.Unmarshal(data, &thumbnails); err != nil {
+		return fmt.Errorf("failed to parse cover thumbnails: %w", err)
+	}
+	*l = thumbnails
+	return nil
+}
+
+func (l CoverThumbnailList) Value() (driver.Value, error) {
+	if l == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(l)
+}
+
+func (l CoverThumbnailList) FromDTO() []*materials.CoverThumbnail {
+	result := make([]*materials.CoverThumbnail, 0, len(l))
+	for _, thumbnail := range l {
+		result = append(result, &materials.CoverThumbnail{
+			Width: thumbnail.Width,
+			Url:   thumbnail.URL,
+		})
+	}
+	return result
+}
+
+// Cover — результат загрузки обложки
+type Cover struct {
+	URL        string
+	Thumbnails CoverThumbnailList
+}
+
+// CoverUpload — запись о загруженных файлах обложки, по ней сборщик мусора удаляет файлы,
+// на которые больше не ссылается ни один материал
+type CoverUpload struct {
+	UUID         string         `db:"uuid"`
+	OwnerUUID    string         `db:"owner_uuid"`
+	MaterialUUID string         `db:"material_uuid"`
+	URL          string         `db:"url"`
+	StorageKeys  pq.StringArray `db:"storage_keys"`
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"fmt"
+)
+
+// ErrorKind — категория доменной ошибки, по ней транспорт выбирает код ответа
+type ErrorKind string
+
+const (
+	ErrorKindNotFound   ErrorKind = "NOT_FOUND"
+	ErrorKindForbidden  ErrorKind = "FORBIDDEN"
+	ErrorKindConflict   ErrorKind = "CONFLICT"
+	ErrorKindValidation ErrorKind = "VALIDATION"
+	// ErrorKindPrecondition — запрос корректен, но материал в состоянии, в котором операция невозможна
+	ErrorKindPrecondition ErrorKind = "FAILED_PRECONDITION"
+)
+
+// Сравниваются с ошибками через errors.Is по категории: errors.Is(err, model.ErrNotFound)
+var (
+	ErrNotFound     = &Error{Kind: ErrorKindNotFound}
+	ErrForbidden    = &Error{Kind: ErrorKindForbidden}
+	ErrConflict     = &Error{Kind: ErrorKindConflict}
+	ErrValidation   = &Error{Kind: ErrorKindValidation}
+	ErrPrecondition = &Error{Kind: ErrorKindPrecondition}
+)
+
+// Error — доменная ошибка. Message показывается клиенту, поэтому не должно содержать внутренних деталей.
+type Error struct {
+	Kind    ErrorKind
+	Message string
+	// Field — поле запроса, не прошедшее проверку, только для ErrorKindValidation
+	Field string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Message == "" && t.Kind == e.Kind
+}
+
+func NotFoundError(format string, args ...interface{}) error {
+	return &Error{Kind: ErrorKindNotFound, Message: fmt.Sprintf(format, args...)}
+}
+
+func ForbiddenError(format string, args ...interface{}) error {
+	return &Error{Kind: ErrorKindForbidden, Message: fmt.Sprintf(format, args...)}
+}
+
+func ConflictError(format string, args ...interface{}) error {
+	return &Error{Kind: ErrorKindConflict, Message: fmt.Sprintf(format, args...)}
+}
+
+func ValidationError(field, format string, args ...interface{}) error {
+	return &Error{Kind: ErrorKindValidation, Message: fmt.Sprintf(format, args...), Field: field}
+}
+
+func PreconditionError(format string, args ...interface{}) error {
+	return &Error{Kind: ErrorKindPrecondition, Message: fmt.Sprintf(format, args...)}
+}
@@ -0,0 +1,56 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/s21platform/materials-service/pkg/materials"
+)
+
+// ExportFilter — условия выгрузки материалов, пустые поля выборку не ограничивают
+type ExportFilter struct {
+	OwnerUUID      string
+	Status         string
+	UpdatedSince   *time.Time
+	IncludeDeleted bool
+}
+
+func (f *ExportFilter) ToDTO(in *materials.ExportMaterialsIn) {
+	f.OwnerUUID = in.OwnerUuid
+	f.Status = in.Status
+	f.IncludeDeleted = in.IncludeDeleted
+	if in.UpdatedSince != nil {
+		updatedSince := in.UpdatedSince.AsTime()
+		f.UpdatedSince = &updatedSince
+	}
+}
+
+func (f *ExportFilter) Validate() error {
+	if f.OwnerUUID != "" {
+		if _, err := uuid.Parse(f.OwnerUUID); err != nil {
+			return ValidationError("owner_uuid", "invalid owner uuid")
+		}
+	}
+
+	switch f.Status {
+	case "", "draft", "published", "archived":
+	default:
+		return ValidationError("status", "unknown status %q", f.Status)
+	}
+
+	return nil
+}
+
+const (
+	ExportFormatMarkdown = "markdown"
+	ExportFormatHTML     = "html"
+	ExportFormatZip      = "zip"
+)
+
+// MaterialExport — готовый к скачиванию файл с материалом
+type MaterialExport struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
@@ -0,0 +1,11 @@
+package model
+
+// FrontMatter — YAML-шапка Markdown-файла материала, один формат для импорта и экспорта
+type FrontMatter struct {
+	Title           string   `yaml:"title"`
+	Description     string   `yaml:"description,omitempty"`
+	Cover           string   `yaml:"cover,omitempty"`
+	Tags            []string `yaml:"tags,omitempty"`
+	Status          string   `yaml:"status,omitempty"`
+	ReadTimeMinutes int32    `yaml:"read_time_minutes,omitempty"`
+}
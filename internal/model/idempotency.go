@@ -0,0 +1,15 @@
+package model
+
+const (
+	IdempotencyStateProcessing = "processing"
+	IdempotencyStateDone       = "done"
+)
+
+// IdempotentResponse — сохранённый ответ на первый запрос с данным Idempotency-Key
+type IdempotentResponse struct {
+	State       string
+	Fingerprint string
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
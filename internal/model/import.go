@@ -0,0 +1,37 @@
+package model
+
+const (
+	ImportStatusDraft     = "draft"
+	ImportStatusPublished = "published"
+)
+
+// ImportFile — загруженный файл: Markdown или zip-архив с Markdown-файлами
+type ImportFile struct {
+	Name string
+	Data []byte
+}
+
+// ImportedMaterial — материал, разобранный из одного Markdown-файла
+type ImportedMaterial struct {
+	Filename string
+	Draft    SaveDraftMaterial
+	Tags     []string
+	Status   string
+}
+
+type ImportResult struct {
+	Filename     string
+	Title        string
+	Status       string
+	MaterialUUID string
+	Success      bool
+	Error        string
+}
+
+// ImportReport — результат импорта по каждому файлу. Committed выставляется, только если
+// все файлы прошли проверку и материалы записаны.
+type ImportReport struct {
+	DryRun    bool
+	Committed bool
+	Results   []ImportResult
+}
@@ -8,23 +8,33 @@ import (
 	"github.com/s21platform/materials-service/pkg/materials"
 )
 
+// BatchMaxItems ограничивает количество материалов в одном запросе GetMaterialsByUUIDs
+const BatchMaxItems = 100
+
 type MaterialList []Material
 
 type Material struct {
-	UUID            string     `db:"uuid"`
-	OwnerUUID       string     `db:"owner_uuid"`
-	Title           string     `db:"title"`
-	CoverImageURL   string     `db:"cover_image_url"`
-	Description     string     `db:"description"`
-	Content         *string    `db:"content"`
-	ReadTimeMinutes int32      `db:"read_time_minutes"`
-	Status          string     `db:"status"`
-	CreatedAt       time.Time  `db:"created_at"`
-	EditedAt        *time.Time `db:"edited_at"`
-	PublishedAt     *time.Time `db:"published_at"`
-	ArchivedAt      *time.Time `db:"archived_at"`
-	DeletedAt       *time.Time `db:"deleted_at"`
-	LikesCount      int32      `db:"likes_count"`
+	UUID            string             `db:"uuid"`
+	OwnerUUID       string             `db:"owner_uuid"`
+	Title           string             `db:"title"`
+	CoverImageURL   string             `db:"cover_image_url"`
+	Description     string             `db:"description"`
+	Content         *string            `db:"content"`
+	ReadTimeMinutes int32              `db:"read_time_minutes"`
+	Status          string             `db:"status"`
+	CreatedAt       time.Time          `db:"created_at"`
+	EditedAt        *time.Time         `db:"edited_at"`
+	PublishedAt     *time.Time         `db:"published_at"`
+	ArchivedAt      *time.Time         `db:"archived_at"`
+	DeletedAt       *time.Time         `db:"deleted_at"`
+	LikesCount      int32              `db:"likes_count"`
+	ForkedFromUUID  *string            `db:"forked_from_uuid"`
+	ForksCount      int32              `db:"forks_count"`
+	ReactionCounts  ReactionCounts     `db:"reaction_counts"`
+	HiddenAt        *time.Time         `db:"hidden_at"`
+	CoverThumbnails CoverThumbnailList `db:"cover_thumbnails"`
+	UpdatedAt       *time.Time         `db:"updated_at"`
+	OwnerDeletedAt  *time.Time         `db:"owner_deleted_at"`
 }
 
 func (m *Material) FromDTO() *materials.Material {
@@ -32,16 +42,22 @@ func (m *Material) FromDTO() *materials.Material {
 		Uuid:            m.UUID,
 		OwnerUuid:       m.OwnerUUID,
 		Title:           m.Title,
+		CoverImageUrl:   m.CoverImageURL,
 		Description:     m.Description,
 		ReadTimeMinutes: m.ReadTimeMinutes,
 		Status:          m.Status,
 		CreatedAt:       timestamppb.New(m.CreatedAt),
 		LikesCount:      m.LikesCount,
+		ForksCount:      m.ForksCount,
+		CoverThumbnails: m.CoverThumbnails.FromDTO(),
 	}
 
 	if m.Content != nil {
 		protoMaterial.Content = *m.Content
 	}
+	if m.ForkedFromUUID != nil {
+		protoMaterial.ForkedFromUuid = *m.ForkedFromUUID
+	}
 	if m.EditedAt != nil {
 		protoMaterial.EditedAt = timestamppb.New(*m.EditedAt)
 	}
@@ -54,6 +70,12 @@ func (m *Material) FromDTO() *materials.Material {
 	if m.DeletedAt != nil {
 		protoMaterial.DeletedAt = timestamppb.New(*m.DeletedAt)
 	}
+	if m.UpdatedAt != nil {
+		protoMaterial.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	if m.OwnerDeletedAt != nil {
+		protoMaterial.OwnerDeletedAt = timestamppb.New(*m.OwnerDeletedAt)
+	}
 
 	return protoMaterial
 }
@@ -71,11 +93,15 @@ func (a *MaterialList) ListFromDTO() []*materials.Material {
 			ReadTimeMinutes: material.ReadTimeMinutes,
 			Status:          material.Status,
 			LikesCount:      material.LikesCount,
+			ForksCount:      material.ForksCount,
 		}
 
 		if material.Content != nil {
 			m.Content = *material.Content
 		}
+		if material.ForkedFromUUID != nil {
+			m.ForkedFromUuid = *material.ForkedFromUUID
+		}
 		if material.EditedAt != nil {
 			m.EditedAt = timestamppb.New(*material.EditedAt)
 		}
@@ -95,6 +121,43 @@ func (a *MaterialList) ListFromDTO() []*materials.Material {
 	return result
 }
 
+// VisibleTo сообщает, можно ли показать материал пользователю: удалённые не видны никому,
+// скрытые модератором — только владельцу и модераторам, черновики и архивные — только владельцу
+func (m *Material) VisibleTo(userUUID string, isModerator bool) bool {
+	if m.DeletedAt != nil {
+		return false
+	}
+	if m.OwnerUUID == userUUID {
+		return true
+	}
+	if m.HiddenAt != nil && !isModerator {
+		return false
+	}
+	return m.Status == "published" && m.ArchivedAt == nil
+}
+
+// SortByUUIDs возвращает материалы в порядке uuids, отсутствующие в списке uuid пропускаются
+func (a MaterialList) SortByUUIDs(uuids []string) MaterialList {
+	byUUID := make(map[string]Material, len(a))
+	for _, material := range a {
+		byUUID[material.UUID] = material
+	}
+
+	result := make(MaterialList, 0, len(uuids))
+	for _, uuid := range uuids {
+		if material, ok := byUUID[uuid]; ok {
+			result = append(result, material)
+		}
+	}
+	return result
+}
+
+// MaterialView — материал вместе с реакциями пользователя, который его читает
+type MaterialView struct {
+	Material    *Material
+	MyReactions []string
+}
+
 type PaginatedMaterialList struct {
 	Materials *MaterialList
 }
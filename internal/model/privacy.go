@@ -0,0 +1,76 @@
+package model
+
+import "github.com/s21platform/materials-service/pkg/materials"
+
+const (
+	// ErasurePolicyReassign — материалы удалённого пользователя переходят другому владельцу, по умолчанию DeletedUserUUID
+	ErasurePolicyReassign = "reassign"
+	// ErasurePolicyMark — материалы остаются за удалённым пользователем с отметкой owner_deleted_at
+	ErasurePolicyMark = "mark"
+	// ErasurePolicyDelete — материалы удалённого пользователя удаляются окончательно вместе с файлами
+	ErasurePolicyDelete = "delete"
+
+	// DeletedUserUUID — анонимный владелец материалов удалённых пользователей, строка в users создаётся миграцией
+	DeletedUserUUID = "00000000-0000-0000-0000-000000000000"
+	// DeletedUserNickname — ник, который остаётся у помеченного удалённым пользователя
+	DeletedUserNickname = "deleted"
+
+	UserDataContentType = "application/json"
+)
+
+func IsErasurePolicy(policy string) bool {
+	return policy == ErasurePolicyReassign || policy == ErasurePolicyMark || policy == ErasurePolicyDelete
+}
+
+// UserDeletedMessage — событие удаления пользователя
+type UserDeletedMessage struct {
+	UserUuid string `json:"user_uuid"`
+}
+
+// UserErasure — что осталось сделать после коммита удаления данных пользователя
+type UserErasure struct {
+	MaterialUUIDs []string // материалы, чей кэш нужно сбросить
+	PurgedUUIDs   []string // окончательно удалённые материалы, у них нужно удалить и автосохранения
+}
+
+// UserData — всё, что сервис хранит о пользователе, для выгрузки по запросу
+type UserData struct {
+	UserUUID    string
+	Profile     *User // nil, если зеркала профиля нет
+	Materials   MaterialList
+	Tags        []MaterialTag
+	Reactions   UserReactionList
+	Reports     MaterialReportList
+	Attachments MaterialAttachmentList
+}
+
+func (d *UserData) Empty() bool {
+	return d.Profile == nil && len(d.Materials) == 0 && len(d.Reactions) == 0 && len(d.Reports) == 0 && len(d.Attachments) == 0
+}
+
+func (d *UserData) FromDTO(reactionTypes []string) *materials.UserDataArchive {
+	archive := &materials.UserDataArchive{
+		UserUuid:    d.UserUUID,
+		Reactions:   d.Reactions.FromDTO(),
+		Reports:     d.Reports.FromDTO(),
+		Attachments: d.Attachments.FromDTO(),
+	}
+	if d.Profile != nil {
+		archive.Profile = d.Profile.FromDTO()
+	}
+
+	tags := make(map[string][]string, len(d.Materials))
+	for _, tag := range d.Tags {
+		tags[tag.MaterialUUID] = append(tags[tag.MaterialUUID], tag.Tag)
+	}
+	for i := range d.Materials {
+		material := d.Materials[i].FromDTO()
+		material.Reactions = d.Materials[i].ReactionCounts.FromDTO(reactionTypes)
+		archive.Materials = append(archive.Materials, &materials.UserDataMaterial{
+			Material: material,
+			Tags:     tags[d.Materials[i].UUID],
+		})
+	}
+
+	return archive
+}
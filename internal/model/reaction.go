@@ -0,0 +1,97 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/s21platform/materials-service/pkg/materials"
+)
+
+const ReactionLike = "like"
+
+// ReactionCounts — счётчики реакций по типам, хранятся в materials.reaction_counts
+type ReactionCounts map[string]int32
+
+func (c *ReactionCounts) Scan(src interface{}) error {
+	var data []byte
+	switch v := src.(type) {
+	case nil:
+		*c = ReactionCounts{}
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported reaction counts type %T", src)
+	}
+
+	counts := ReactionCounts{}
+	if err := json.Unmarshal(data, &counts); err != nil {
+		return fmt.Errorf("failed to parse reaction counts: %w", err)
+	}
+	*c = counts
+	return nil
+}
+
+// FromDTO возвращает счётчики в порядке настроенных типов, включая нулевые.
+// Типы, убранные из конфигурации, в ответ не попадают.
+func (c ReactionCounts) FromDTO(types []string) []*materials.ReactionCount {
+	result := make([]*materials.ReactionCount, 0, len(types))
+	for _, reaction := range types {
+		result = append(result, &materials.ReactionCount{
+			Reaction: reaction,
+			Count:    c[reaction],
+		})
+	}
+	return result
+}
+
+// ReactionResult — результат атомарной постановки или снятия реакции
+type ReactionResult struct {
+	Changed    bool           `db:"changed"`
+	LikesCount int32          `db:"likes_count"`
+	Counts     ReactionCounts `db:"reaction_counts"`
+}
+
+// ReactionState — счётчики реакций материала и реакции пользователя после их изменения
+type ReactionState struct {
+	Counts      ReactionCounts
+	MyReactions []string
+}
+
+// LikeResult — состояние лайка пользователя после его постановки, снятия или переключения
+type LikeResult struct {
+	IsLiked    bool
+	LikesCount int32
+	Changed    bool
+}
+
+func IsAllowedReaction(types []string, reaction string) bool {
+	for _, t := range types {
+		if t == reaction {
+			return true
+		}
+	}
+	return false
+}
+
+// ReactionTypes нормализует настроенные типы реакций: lowercase, без повторов,
+// "like" присутствует всегда, так как на нём работают ToggleLike и SetLike
+func ReactionTypes(configured []string) []string {
+	result := []string{ReactionLike}
+	seen := map[string]struct{}{ReactionLike: {}}
+	for _, reaction := range configured {
+		reaction = strings.ToLower(strings.TrimSpace(reaction))
+		if reaction == "" {
+			continue
+		}
+		if _, ok := seen[reaction]; ok {
+			continue
+		}
+		seen[reaction] = struct{}{}
+		result = append(result, reaction)
+	}
+	return result
+}
@@ -0,0 +1,22 @@
+package model
+
+// RelatedCandidate — опубликованный материал, участвующий в расчёте похожих
+type RelatedCandidate struct {
+	UUID        string `db:"uuid"`
+	Title       string `db:"title"`
+	Description string `db:"description"`
+	LikesCount  int32  `db:"likes_count"`
+}
+
+// LikeCooccurrence — число пользователей, лайкнувших оба материала
+type LikeCooccurrence struct {
+	MaterialUUID string `db:"material_uuid"`
+	RelatedUUID  string `db:"related_uuid"`
+	Count        int32  `db:"count"`
+}
+
+type RelatedMaterial struct {
+	MaterialUUID string  `db:"material_uuid"`
+	RelatedUUID  string  `db:"related_uuid"`
+	Score        float64 `db:"score"`
+}
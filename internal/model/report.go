@@ -0,0 +1,94 @@
+package model
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/s21platform/materials-service/pkg/materials"
+)
+
+const (
+	ReportStatusOpen      = "open"
+	ReportStatusActioned  = "actioned"
+	ReportStatusDismissed = "dismissed"
+
+	ModerationActionAutoHidden     = "auto_hidden"
+	ModerationActionHidden         = "hidden"
+	ModerationActionUnhidden       = "unhidden"
+	ModerationActionReportResolved = "report_resolved"
+
+	// ReportCommentMaxLength ограничивает длину комментария к жалобе
+	ReportCommentMaxLength = 1000
+)
+
+// ReportReasons — допустимые коды причин жалобы
+var ReportReasons = []string{"spam", "abuse", "copyright", "misinformation", "other"}
+
+func IsAllowedReportReason(reason string) bool {
+	for _, r := range ReportReasons {
+		if r == reason {
+			return true
+		}
+	}
+	return false
+}
+
+func IsReportResolution(resolution string) bool {
+	return resolution == ReportStatusActioned || resolution == ReportStatusDismissed
+}
+
+type MaterialReport struct {
+	UUID         string     `db:"uuid"`
+	MaterialUUID string     `db:"material_uuid"`
+	ReporterUUID string     `db:"reporter_uuid"`
+	Reason       string     `db:"reason"`
+	Comment      string     `db:"comment"`
+	Status       string     `db:"status"`
+	CreatedAt    time.Time  `db:"created_at"`
+	ResolvedAt   *time.Time `db:"resolved_at"`
+	ResolvedBy   *string    `db:"resolved_by"`
+}
+
+type MaterialReportList []MaterialReport
+
+// ReportResult — результат создания жалобы. UUID пустой, если у пользователя уже есть открытая жалоба на материал
+type ReportResult struct {
+	UUID        *string `db:"uuid"`
+	OpenReports int     `db:"open_reports"`
+}
+
+// CreatedReport — созданная жалоба и то, скрыла ли она материал
+type CreatedReport struct {
+	UUID           string
+	MaterialHidden bool
+}
+
+func (r *MaterialReport) FromDTO() *materials.MaterialReport {
+	protoReport := &materials.MaterialReport{
+		Uuid:         r.UUID,
+		MaterialUuid: r.MaterialUUID,
+		ReporterUuid: r.ReporterUUID,
+		Reason:       r.Reason,
+		Comment:      r.Comment,
+		Status:       r.Status,
+		CreatedAt:    timestamppb.New(r.CreatedAt),
+	}
+
+	if r.ResolvedAt != nil {
+		protoReport.ResolvedAt = timestamppb.New(*r.ResolvedAt)
+	}
+	if r.ResolvedBy != nil {
+		protoReport.ResolvedBy = *r.ResolvedBy
+	}
+
+	return protoReport
+}
+
+func (l *MaterialReportList) FromDTO() []*materials.MaterialReport {
+	result := make([]*materials.MaterialReport, 0, len(*l))
+	for _, report := range *l {
+		result = append(result, report.FromDTO())
+	}
+	return result
+}
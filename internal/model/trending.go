@@ -0,0 +1,12 @@
+package model
+
+// TrendingScore — вклад в рейтинг популярности материала
+type TrendingScore struct {
+	UUID  string  `db:"uuid"`
+	Score float64 `db:"score"`
+}
+
+type MaterialTag struct {
+	MaterialUUID string `db:"material_uuid"`
+	Tag          string `db:"tag"`
+}
@@ -1,5 +1,13 @@
 package model
 
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/s21platform/materials-service/pkg/materials"
+)
+
 type User struct {
 	Uuid       string `db:"uuid"`
 	Nickname   string `db:"nickname"`
@@ -7,3 +15,96 @@ type User struct {
 	Name       string `db:"name"`
 	Surname    string `db:"surname"`
 }
+
+// UserCreatedMessage — событие создания пользователя. Поля профиля необязательны:
+// если их нет в событии, они приходят позже событием изменения профиля
+type UserCreatedMessage struct {
+	UserUuid     string `json:"user_uuid"`
+	UserNickname string `json:"user_nickname"`
+	AvatarLink   string `json:"avatar_link"`
+	Name         string `json:"name"`
+	Surname      string `json:"surname"`
+}
+
+// UserProfileUpdatedMessage — событие изменения профиля, nil — поле не менялось
+type UserProfileUpdatedMessage struct {
+	UserUuid   string  `json:"user_uuid"`
+	Nickname   *string `json:"nickname"`
+	AvatarLink *string `json:"avatar_link"`
+	Name       *string `json:"name"`
+	Surname    *string `json:"surname"`
+}
+
+// UserProfileUpdate — изменение зеркала профиля, nil — поле не меняется
+type UserProfileUpdate struct {
+	Uuid       string
+	Nickname   *string
+	AvatarLink *string
+	Name       *string
+	Surname    *string
+}
+
+func (u *UserProfileUpdate) ToDTO(in *UserProfileUpdatedMessage) {
+	u.Uuid = in.UserUuid
+	u.Nickname = in.Nickname
+	u.AvatarLink = in.AvatarLink
+	u.Name = in.Name
+	u.Surname = in.Surname
+}
+
+func (u *User) FromDTO() *materials.UserProfile {
+	return &materials.UserProfile{
+		Uuid:       u.Uuid,
+		Nickname:   u.Nickname,
+		AvatarLink: u.AvatarLink,
+		Name:       u.Name,
+		Surname:    u.Surname,
+	}
+}
+
+// UserReaction — реакция пользователя на материал
+type UserReaction struct {
+	MaterialUUID string    `db:"material_uuid"`
+	Reaction     string    `db:"reaction"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+type UserReactionList []UserReaction
+
+func (l UserReactionList) FromDTO() []*materials.UserReaction {
+	result := make([]*materials.UserReaction, 0, len(l))
+	for _, reaction := range l {
+		result = append(result, &materials.UserReaction{
+			MaterialUuid: reaction.MaterialUUID,
+			Reaction:     reaction.Reaction,
+			CreatedAt:    timestamppb.New(reaction.CreatedAt),
+		})
+	}
+	return result
+}
+
+type MaterialLikerList []MaterialLiker
+
+type MaterialLiker struct {
+	Uuid       string    `db:"uuid"`
+	Nickname   string    `db:"nickname"`
+	AvatarLink string    `db:"avatar_link"`
+	Name       string    `db:"name"`
+	Surname    string    `db:"surname"`
+	LikedAt    time.Time `db:"liked_at"`
+}
+
+func (l *MaterialLikerList) FromDTO() []*materials.UserSummary {
+	result := make([]*materials.UserSummary, 0, len(*l))
+	for _, liker := range *l {
+		result = append(result, &materials.UserSummary{
+			Uuid:       liker.Uuid,
+			Nickname:   liker.Nickname,
+			AvatarLink: liker.AvatarLink,
+			Name:       liker.Name,
+			Surname:    liker.Surname,
+			LikedAt:    timestamppb.New(liker.LikedAt),
+		})
+	}
+	return result
+}
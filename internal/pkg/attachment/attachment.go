@@ -0,0 +1,218 @@
+package attachment
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	logger_lib "github.com/s21platform/logger-lib"
+
+	"github.com/s21platform/materials-service/internal/config"
+	"github.com/s21platform/materials-service/internal/model"
+	"github.com/s21platform/materials-service/internal/pkg/blob"
+)
+
+// Scheme — префикс ссылки на вложение в content материала: ![схема](attachment://<uuid>)
+const Scheme = "attachment://"
+
+const maxFilenameLength = 255
+
+var referencePattern = regexp.MustCompile(`attachment://([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})`)
+
+type DBRepo interface {
+	CreateMaterialAttachment(ctx context.Context, attachment *model.MaterialAttachment) (*model.MaterialAttachment, error)
+	GetMaterialAttachments(ctx context.Context, materialUUID string) (model.MaterialAttachmentList, error)
+	GetMaterialAttachment(ctx context.Context, materialUUID, attachmentUUID string) (*model.MaterialAttachment, error)
+	DeleteMaterialAttachment(ctx context.Context, materialUUID, attachmentUUID string) (int64, error)
+}
+
+// Manager хранит вложения материалов: файл кладётся в blob-хранилище, запись о нём — в material_attachments
+type Manager struct {
+	repo         DBRepo
+	storage      blob.Storage
+	maxBytes     int64
+	allowedTypes map[string]struct{}
+}
+
+func New(repo DBRepo, storage blob.Storage, cfg *config.Config) *Manager {
+	allowedTypes := make(map[string]struct{}, len(cfg.Attachments.AllowedTypes))
+	for _, contentType := range cfg.Attachments.AllowedTypes {
+		allowedTypes[strings.ToLower(strings.TrimSpace(contentType))] = struct{}{}
+	}
+
+	return &Manager{
+		repo:         repo,
+		storage:      storage,
+		maxBytes:     cfg.Attachments.MaxBytes,
+		allowedTypes: allowedTypes,
+	}
+}
+
+func (m *Manager) Upload(ctx context.Context, materialUUID, ownerUUID, filename string, data []byte) (*model.MaterialAttachment, error) {
+	filename = path.Base(strings.ReplaceAll(strings.TrimSpace(filename), "\\", "/"))
+	if filename == "" || filename == "." || filename == "/" {
+		return nil, model.ValidationError("filename", "invalid attachment: filename is required")
+	}
+	if len(filename) > maxFilenameLength {
+		return nil, model.ValidationError("filename", "invalid attachment: filename is longer than %d bytes", maxFilenameLength)
+	}
+	if len(data) == 0 {
+		return nil, model.ValidationError("file", "invalid attachment: file is empty")
+	}
+	if int64(len(data)) > m.maxBytes {
+		return nil, model.ValidationError("file", "invalid attachment: file is larger than %d bytes", m.maxBytes)
+	}
+
+	// тип определяется по содержимому, заголовку клиента не доверяем
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(data))
+	if _, ok := m.allowedTypes[contentType]; !ok {
+		return nil, model.ValidationError("file", "invalid attachment: content type %s is not allowed", contentType)
+	}
+
+	attachmentUUID := uuid.New().String()
+	key := fmt.Sprintf("attachments/%s/%s%s", materialUUID, attachmentUUID, extension(contentType))
+
+	if err := m.storage.Put(ctx, key, data, contentType); err != nil {
+		return nil, fmt.Errorf("failed to store attachment: %w", err)
+	}
+
+	attachment, err := m.repo.CreateMaterialAttachment(ctx, &model.MaterialAttachment{
+		UUID:         attachmentUUID,
+		MaterialUUID: materialUUID,
+		OwnerUUID:    ownerUUID,
+		Filename:     filename,
+		ContentType:  contentType,
+		SizeBytes:    int64(len(data)),
+		StorageKey:   key,
+	})
+	if err != nil {
+		if deleteErr := m.storage.Delete(ctx, key); deleteErr != nil {
+			logger_lib.Error(logger_lib.WithError(ctx, deleteErr), "failed to delete orphaned attachment file")
+		}
+		return nil, err
+	}
+
+	attachment.URL = m.storage.URL(attachment.StorageKey)
+	return attachment, nil
+}
+
+func (m *Manager) List(ctx context.Context, materialUUID string) (model.MaterialAttachmentList, error) {
+	attachments, err := m.repo.GetMaterialAttachments(ctx, materialUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range attachments {
+		attachments[i].URL = m.storage.URL(attachments[i].StorageKey)
+	}
+	return attachments, nil
+}
+
+// Open возвращает вложение вместе с содержимым файла, права доступа к материалу проверяет вызывающий
+func (m *Manager) Open(ctx context.Context, materialUUID, attachmentUUID string) (*model.MaterialAttachment, []byte, error) {
+	attachment, err := m.repo.GetMaterialAttachment(ctx, materialUUID, attachmentUUID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if attachment == nil {
+		return nil, nil, model.NotFoundError("attachment not found")
+	}
+
+	data, err := m.storage.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read attachment: %w", err)
+	}
+
+	attachment.URL = m.storage.URL(attachment.StorageKey)
+	return attachment, data, nil
+}
+
+func (m *Manager) Delete(ctx context.Context, materialUUID, attachmentUUID string) error {
+	attachment, err := m.repo.GetMaterialAttachment(ctx, materialUUID, attachmentUUID)
+	if err != nil {
+		return err
+	}
+	if attachment == nil {
+		return model.NotFoundError("attachment not found")
+	}
+
+	rowsAffected, err := m.repo.DeleteMaterialAttachment(ctx, materialUUID, attachmentUUID)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return model.NotFoundError("attachment not found")
+	}
+
+	// запись уже удалена, поэтому ошибку удаления файла только логируем
+	if err = m.storage.Delete(ctx, attachment.StorageKey); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to delete attachment file: %v", err))
+	}
+
+	return nil
+}
+
+// ResolveContent заменяет ссылки attachment://<uuid> на URL вложений материала.
+// Ссылки на чужие и удалённые вложения остаются как есть.
+func (m *Manager) ResolveContent(ctx context.Context, materialUUID, content string) (string, error) {
+	if !HasReferences(content) {
+		return content, nil
+	}
+
+	attachments, err := m.repo.GetMaterialAttachments(ctx, materialUUID)
+	if err != nil {
+		return "", err
+	}
+
+	urls := make(map[string]string, len(attachments))
+	for _, attachment := range attachments {
+		urls[attachment.UUID] = m.storage.URL(attachment.StorageKey)
+	}
+
+	return RewriteReferences(content, urls), nil
+}
+
+func HasReferences(content string) bool {
+	return strings.Contains(content, Scheme)
+}
+
+// RewriteReferences заменяет ссылки attachment://<uuid> по таблице uuid вложения -> новая ссылка,
+// ссылки на вложения не из таблицы остаются как есть
+func RewriteReferences(content string, targets map[string]string) string {
+	normalized := make(map[string]string, len(targets))
+	for attachmentUUID, target := range targets {
+		normalized[strings.ToLower(attachmentUUID)] = target
+	}
+
+	return referencePattern.ReplaceAllStringFunc(content, func(reference string) string {
+		if target, ok := normalized[strings.ToLower(strings.TrimPrefix(reference, Scheme))]; ok {
+			return target
+		}
+		return reference
+	})
+}
+
+func extension(contentType string) string {
+	switch contentType {
+	case "image/png":
+		return ".png"
+	case "image/jpeg":
+		return ".jpg"
+	case "image/gif":
+		return ".gif"
+	case "image/webp":
+		return ".webp"
+	case "application/pdf":
+		return ".pdf"
+	}
+
+	if extensions, err := mime.ExtensionsByType(contentType); err == nil && len(extensions) > 0 {
+		return extensions[0]
+	}
+	return ""
+}
@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/s21platform/materials-service/internal/config"
+)
+
+const (
+	ModeHeader = "header"
+	ModeJWT    = "jwt"
+)
+
+var (
+	ErrMissingCredentials = errors.New("missing credentials")
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Credentials — данные, извлечённые транспортом из заголовков HTTP или метаданных gRPC
+type Credentials struct {
+	UserUUID       string
+	Roles          string
+	RolesSignature string
+	BearerToken    string
+}
+
+type Identity struct {
+	UserUUID string
+	Roles    []string
+}
+
+type Authenticator interface {
+	Authenticate(ctx context.Context, credentials Credentials) (*Identity, error)
+}
+
+func New(cfg *config.Config) (Authenticator, error) {
+	switch cfg.Auth.Mode {
+	case ModeHeader:
+		// заголовки может подделать любой, кто достучится до сервиса в обход шлюза
+		if !cfg.Auth.TrustGatewayHeaders {
+			return nil, fmt.Errorf("header auth mode requires MATERIALS_AUTH_TRUST_GATEWAY_HEADERS=true")
+		}
+		return NewHeaderAuthenticator(cfg.Auth.HeaderRolesSecret), nil
+	case ModeJWT:
+		// режим jwt включён по умолчанию, поэтому ошибка подсказывает обе настройки
+		if cfg.Auth.JWKSPath == "" {
+			return nil, fmt.Errorf("jwt auth mode requires MATERIALS_AUTH_JWKS_PATH; to trust gateway headers instead " +
+				"set MATERIALS_AUTH_MODE=header and MATERIALS_AUTH_TRUST_GATEWAY_HEADERS=true")
+		}
+		return NewJWTAuthenticator(cfg)
+	default:
+		return nil, fmt.Errorf("unknown auth mode %q in MATERIALS_AUTH_MODE, allowed: %s, %s", cfg.Auth.Mode, ModeJWT, ModeHeader)
+	}
+}
+
+// WithIdentity кладёт UUID пользователя и его роли в контекст
+func WithIdentity(ctx context.Context, identity *Identity) context.Context {
+	ctx = context.WithValue(ctx, config.KeyUUID, identity.UserUUID)
+	return context.WithValue(ctx, config.KeyRoles, identity.Roles)
+}
+
+func HasRole(ctx context.Context, role string) bool {
+	roles, _ := ctx.Value(config.KeyRoles).([]string)
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// BearerToken достаёт токен из значения заголовка Authorization
+func BearerToken(authorization string) string {
+	scheme, token, ok := strings.Cut(strings.TrimSpace(authorization), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+func splitRoles(roles string) []string {
+	var result []string
+	for _, role := range strings.Split(roles, ",") {
+		role = strings.TrimSpace(role)
+		if role != "" {
+			result = append(result, role)
+		}
+	}
+	return result
+}
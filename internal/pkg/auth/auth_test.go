@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/s21platform/materials-service/internal/config"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	t.Run("header_requires_opt_in", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &config.Config{}
+		cfg.Auth.Mode = ModeHeader
+
+		_, err := New(cfg)
+		assert.ErrorContains(t, err, "MATERIALS_AUTH_TRUST_GATEWAY_HEADERS")
+	})
+
+	t.Run("header_with_opt_in", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &config.Config{}
+		cfg.Auth.Mode = ModeHeader
+		cfg.Auth.TrustGatewayHeaders = true
+
+		authenticator, err := New(cfg)
+		require.NoError(t, err)
+		assert.IsType(t, &HeaderAuthenticator{}, authenticator)
+	})
+
+	t.Run("jwt_requires_jwks", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &config.Config{}
+		cfg.Auth.Mode = ModeJWT
+
+		_, err := New(cfg)
+		assert.ErrorContains(t, err, "MATERIALS_AUTH_JWKS_PATH")
+		assert.ErrorContains(t, err, "MATERIALS_AUTH_MODE=header")
+	})
+
+	t.Run("jwt", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &config.Config{}
+		cfg.Auth.Mode = ModeJWT
+		cfg.Auth.JWKSPath = writeJWKS(t, hmacJWK("k1", []byte("secret")))
+
+		authenticator, err := New(cfg)
+		require.NoError(t, err)
+		assert.IsType(t, &JWTAuthenticator{}, authenticator)
+	})
+
+	t.Run("unknown_mode", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &config.Config{}
+		cfg.Auth.Mode = "basic"
+
+		_, err := New(cfg)
+		assert.Error(t, err)
+	})
+}
+
+func TestHasRole(t *testing.T) {
+	t.Parallel()
+
+	ctx := WithIdentity(context.Background(), &Identity{UserUUID: "user", Roles: []string{"moderator", "exporter"}})
+
+	assert.Equal(t, "user", ctx.Value(config.KeyUUID))
+	assert.True(t, HasRole(ctx, "moderator"))
+	assert.True(t, HasRole(ctx, "exporter"))
+	assert.False(t, HasRole(ctx, "privacy-admin"))
+	assert.False(t, HasRole(context.Background(), "moderator"))
+}
+
+func TestBearerToken(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"Bearer abc":     "abc",
+		"bearer  abc ":   "abc",
+		" BEARER abc":    "abc",
+		"Basic abc":      "",
+		"Bearer":         "",
+		"":               "",
+		"abc":            "",
+		"Bearer a.b.c  ": "a.b.c",
+	}
+
+	for header, want := range cases {
+		assert.Equal(t, want, BearerToken(header), header)
+	}
+}
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+)
+
+// HeaderAuthenticator доверяет UUID из заголовка, выставленного шлюзом.
+// Подходит только для внутреннего трафика за gateway. Роли из заголовка принимаются, только если шлюз
+// подписал их общим секретом: RolesSignature = base64url(HMAC-SHA256(secret, uuid + "\n" + roles)).
+// Без секрета роли игнорируются.
+type HeaderAuthenticator struct {
+	rolesSecret []byte
+}
+
+func NewHeaderAuthenticator(rolesSecret string) *HeaderAuthenticator {
+	return &HeaderAuthenticator{rolesSecret: []byte(rolesSecret)}
+}
+
+func (a *HeaderAuthenticator) Authenticate(_ context.Context, credentials Credentials) (*Identity, error) {
+	userUUID := strings.TrimSpace(credentials.UserUUID)
+	if userUUID == "" {
+		return nil, ErrMissingCredentials
+	}
+
+	identity := &Identity{UserUUID: userUUID}
+	if len(a.rolesSecret) == 0 || strings.TrimSpace(credentials.Roles) == "" {
+		return identity, nil
+	}
+
+	if !hmac.Equal([]byte(credentials.RolesSignature), []byte(SignRoles(a.rolesSecret, userUUID, credentials.Roles))) {
+		return nil, ErrInvalidCredentials
+	}
+
+	identity.Roles = splitRoles(credentials.Roles)
+	return identity, nil
+}
+
+// SignRoles считает подпись ролей, которую шлюз передаёт в X-User-Roles-Signature
+func SignRoles(secret []byte, userUUID, roles string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strings.TrimSpace(userUUID) + "\n" + roles))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeaderAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	secret := "gateway-secret"
+	userUUID := "3f1c9a52-7a4e-4b8e-9d1b-0c6f2a4e5b71"
+
+	t.Run("missing_uuid", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewHeaderAuthenticator(secret).Authenticate(context.Background(), Credentials{UserUUID: "  ", Roles: "moderator"})
+		assert.ErrorIs(t, err, ErrMissingCredentials)
+	})
+
+	t.Run("uuid_without_roles", func(t *testing.T) {
+		t.Parallel()
+
+		identity, err := NewHeaderAuthenticator(secret).Authenticate(context.Background(), Credentials{UserUUID: " " + userUUID + " "})
+		require.NoError(t, err)
+		assert.Equal(t, &Identity{UserUUID: userUUID}, identity)
+	})
+
+	t.Run("signed_roles", func(t *testing.T) {
+		t.Parallel()
+
+		roles := "moderator, exporter,,"
+		identity, err := NewHeaderAuthenticator(secret).Authenticate(context.Background(), Credentials{
+			UserUUID:       userUUID,
+			Roles:          roles,
+			RolesSignature: SignRoles([]byte(secret), userUUID, roles),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"moderator", "exporter"}, identity.Roles)
+	})
+
+	rejected := map[string]Credentials{
+		"unsigned_roles":      {UserUUID: userUUID, Roles: "moderator"},
+		"wrong_secret":        {UserUUID: userUUID, Roles: "moderator", RolesSignature: SignRoles([]byte("other"), userUUID, "moderator")},
+		"roles_of_other_user": {UserUUID: userUUID, Roles: "moderator", RolesSignature: SignRoles([]byte(secret), "another-user", "moderator")},
+		"escalated_roles":     {UserUUID: userUUID, Roles: "moderator,privacy-admin", RolesSignature: SignRoles([]byte(secret), userUUID, "moderator")},
+	}
+
+	for name, credentials := range rejected {
+		credentials := credentials
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewHeaderAuthenticator(secret).Authenticate(context.Background(), credentials)
+			assert.ErrorIs(t, err, ErrInvalidCredentials)
+		})
+	}
+
+	t.Run("roles_ignored_without_secret", func(t *testing.T) {
+		t.Parallel()
+
+		identity, err := NewHeaderAuthenticator("").Authenticate(context.Background(), Credentials{
+			UserUUID:       userUUID,
+			Roles:          "moderator",
+			RolesSignature: "anything",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, userUUID, identity.UserUUID)
+		assert.Empty(t, identity.Roles)
+	})
+}
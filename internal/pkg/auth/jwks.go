@@ -0,0 +1,180 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	algHS256 = "HS256"
+	algRS256 = "RS256"
+)
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	K   string `json:"k"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type jwkSet struct {
+	Keys []jwk `json:"keys"`
+}
+
+type verificationKey struct {
+	kid       string
+	alg       string
+	hmacKey   []byte
+	rsaPublic *rsa.PublicKey
+}
+
+// KeySet хранит ключи из локального JWKS-файла и перечитывает его при изменении.
+// Ротация: новый ключ добавляется в файл рядом со старым, старый удаляется после истечения выданных им токенов.
+type KeySet struct {
+	path           string
+	reloadInterval time.Duration
+
+	mu        sync.RWMutex
+	keys      []verificationKey
+	modTime   time.Time
+	checkedAt time.Time
+}
+
+func NewKeySet(path string, reloadInterval time.Duration) (*KeySet, error) {
+	ks := &KeySet{
+		path:           path,
+		reloadInterval: reloadInterval,
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat jwks file: %v", err)
+	}
+
+	if err = ks.load(info.ModTime()); err != nil {
+		return nil, err
+	}
+
+	return ks, nil
+}
+
+// lookup возвращает ключи, подходящие под kid и алгоритм токена
+func (ks *KeySet) lookup(kid, alg string) []verificationKey {
+	ks.refresh()
+
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	var result []verificationKey
+	for _, key := range ks.keys {
+		if key.alg != alg {
+			continue
+		}
+		if kid != "" && key.kid != kid {
+			continue
+		}
+		result = append(result, key)
+	}
+	return result
+}
+
+func (ks *KeySet) refresh() {
+	ks.mu.RLock()
+	due := time.Since(ks.checkedAt) >= ks.reloadInterval
+	ks.mu.RUnlock()
+	if !due {
+		return
+	}
+
+	ks.mu.Lock()
+	ks.checkedAt = time.Now()
+	modTime := ks.modTime
+	ks.mu.Unlock()
+
+	info, err := os.Stat(ks.path)
+	if err != nil || !info.ModTime().After(modTime) {
+		// при ошибке продолжаем работать на ранее загруженных ключах
+		return
+	}
+
+	_ = ks.load(info.ModTime())
+}
+
+func (ks *KeySet) load(modTime time.Time) error {
+	data, err := os.ReadFile(ks.path)
+	if err != nil {
+		return fmt.Errorf("failed to read jwks file: %v", err)
+	}
+
+	var set jwkSet
+	if err = json.Unmarshal(data, &set); err != nil {
+		return fmt.Errorf("failed to parse jwks file: %v", err)
+	}
+
+	keys := make([]verificationKey, 0, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		key, err := parseJWK(k)
+		if err != nil {
+			return fmt.Errorf("failed to parse key %q: %v", k.Kid, err)
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return fmt.Errorf("jwks file contains no signing keys")
+	}
+
+	ks.mu.Lock()
+	ks.keys = keys
+	ks.modTime = modTime
+	ks.checkedAt = time.Now()
+	ks.mu.Unlock()
+
+	return nil
+}
+
+func parseJWK(k jwk) (verificationKey, error) {
+	switch k.Kty {
+	case "oct":
+		if k.Alg != "" && k.Alg != algHS256 {
+			return verificationKey{}, fmt.Errorf("unsupported alg %q for oct key", k.Alg)
+		}
+		secret, err := base64.RawURLEncoding.DecodeString(k.K)
+		if err != nil || len(secret) == 0 {
+			return verificationKey{}, fmt.Errorf("invalid symmetric key")
+		}
+		return verificationKey{kid: k.Kid, alg: algHS256, hmacKey: secret}, nil
+	case "RSA":
+		if k.Alg != "" && k.Alg != algRS256 {
+			return verificationKey{}, fmt.Errorf("unsupported alg %q for RSA key", k.Alg)
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil || len(n) == 0 {
+			return verificationKey{}, fmt.Errorf("invalid RSA modulus")
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil || len(e) == 0 {
+			return verificationKey{}, fmt.Errorf("invalid RSA exponent")
+		}
+		publicKey := &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+		return verificationKey{kid: k.Kid, alg: algRS256, rsaPublic: publicKey}, nil
+	default:
+		return verificationKey{}, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
@@ -0,0 +1,147 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func hmacJWK(kid string, secret []byte) jwk {
+	return jwk{Kty: "oct", Kid: kid, Alg: algHS256, Use: "sig", K: base64.RawURLEncoding.EncodeToString(secret)}
+}
+
+func rsaJWK(kid string, key *rsa.PublicKey) jwk {
+	return jwk{
+		Kty: "RSA",
+		Kid: kid,
+		Alg: algRS256,
+		Use: "sig",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func writeJWKS(t *testing.T, keys ...jwk) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	rewriteJWKS(t, path, time.Now(), keys...)
+	return path
+}
+
+// rewriteJWKS перезаписывает файл и сдвигает время изменения, чтобы KeySet заметил ротацию
+func rewriteJWKS(t *testing.T, path string, modTime time.Time, keys ...jwk) {
+	t.Helper()
+
+	data, err := json.Marshal(jwkSet{Keys: keys})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func kids(keys []verificationKey) []string {
+	result := make([]string, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, key.kid)
+	}
+	return result
+}
+
+func TestKeySet_Load(t *testing.T) {
+	t.Parallel()
+
+	rsaKey := testRSAKey(t)
+
+	t.Run("filters_by_kid_and_alg", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeJWKS(t,
+			hmacJWK("h1", []byte("secret-1")),
+			hmacJWK("h2", []byte("secret-2")),
+			rsaJWK("r1", &rsaKey.PublicKey),
+			jwk{Kty: "RSA", Kid: "enc", Use: "enc"},
+		)
+
+		keySet, err := NewKeySet(path, time.Hour)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"h1", "h2"}, kids(keySet.lookup("", algHS256)))
+		assert.Equal(t, []string{"h2"}, kids(keySet.lookup("h2", algHS256)))
+		assert.Equal(t, []string{"r1"}, kids(keySet.lookup("r1", algRS256)))
+		assert.Empty(t, keySet.lookup("r1", algHS256))
+		assert.Empty(t, keySet.lookup("unknown", algRS256))
+	})
+
+	invalid := map[string][]jwk{
+		"no_signing_keys":   {{Kty: "oct", Kid: "enc", Use: "enc", K: "c2VjcmV0"}},
+		"unsupported_type":  {{Kty: "EC", Kid: "ec"}},
+		"alg_mismatch":      {{Kty: "oct", Kid: "h", Alg: algRS256, K: "c2VjcmV0"}},
+		"empty_hmac_secret": {{Kty: "oct", Kid: "h"}},
+		"broken_rsa":        {{Kty: "RSA", Kid: "r", N: "!!", E: "AQAB"}},
+	}
+
+	for name, keys := range invalid {
+		keys := keys
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewKeySet(writeJWKS(t, keys...), time.Hour)
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("missing_file", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewKeySet(filepath.Join(t.TempDir(), "missing.json"), time.Hour)
+		assert.Error(t, err)
+	})
+}
+
+func TestKeySet_Rotation(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now().Add(-time.Hour)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	rewriteJWKS(t, path, start, hmacJWK("old", []byte("old-secret")))
+
+	keySet, err := NewKeySet(path, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"old"}, kids(keySet.lookup("", algHS256)))
+
+	// новый ключ добавлен рядом со старым: оба принимаются
+	rewriteJWKS(t, path, start.Add(time.Minute), hmacJWK("old", []byte("old-secret")), hmacJWK("new", []byte("new-secret")))
+	assert.Equal(t, []string{"old", "new"}, kids(keySet.lookup("", algHS256)))
+
+	// старый ключ удалён после истечения его токенов
+	rewriteJWKS(t, path, start.Add(2*time.Minute), hmacJWK("new", []byte("new-secret")))
+	assert.Equal(t, []string{"new"}, kids(keySet.lookup("", algHS256)))
+
+	// битый файл не сбрасывает загруженные ключи
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+	require.NoError(t, os.Chtimes(path, start.Add(3*time.Minute), start.Add(3*time.Minute)))
+	assert.Equal(t, []string{"new"}, kids(keySet.lookup("", algHS256)))
+}
+
+func TestKeySet_ReloadInterval(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now().Add(-time.Hour)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	rewriteJWKS(t, path, start, hmacJWK("old", []byte("old-secret")))
+
+	keySet, err := NewKeySet(path, time.Hour)
+	require.NoError(t, err)
+
+	// до истечения интервала файл не перечитывается
+	rewriteJWKS(t, path, start.Add(time.Minute), hmacJWK("new", []byte("new-secret")))
+	assert.Equal(t, []string{"old"}, kids(keySet.lookup("", algHS256)))
+}
@@ -0,0 +1,202 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/s21platform/materials-service/internal/config"
+)
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// JWTAuthenticator проверяет подпись JWT (HS256/RS256) по ключам из JWKS-файла
+// и извлекает UUID пользователя и роли из claims.
+type JWTAuthenticator struct {
+	keys       *KeySet
+	issuer     string
+	audience   string
+	uuidClaim  string
+	rolesClaim string
+	leeway     time.Duration
+}
+
+func NewJWTAuthenticator(cfg *config.Config) (*JWTAuthenticator, error) {
+	if cfg.Auth.JWKSPath == "" {
+		return nil, fmt.Errorf("jwks path is required in jwt auth mode")
+	}
+
+	keys, err := NewKeySet(cfg.Auth.JWKSPath, cfg.Auth.JWKSReloadInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JWTAuthenticator{
+		keys:       keys,
+		issuer:     cfg.Auth.Issuer,
+		audience:   cfg.Auth.Audience,
+		uuidClaim:  cfg.Auth.UUIDClaim,
+		rolesClaim: cfg.Auth.RolesClaim,
+		leeway:     cfg.Auth.Leeway,
+	}, nil
+}
+
+func (a *JWTAuthenticator) Authenticate(_ context.Context, credentials Credentials) (*Identity, error) {
+	if credentials.BearerToken == "" {
+		return nil, ErrMissingCredentials
+	}
+
+	claims, err := a.verify(credentials.BearerToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+
+	if err = a.validateClaims(claims); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+
+	userUUID, _ := claims[a.uuidClaim].(string)
+	if strings.TrimSpace(userUUID) == "" {
+		return nil, fmt.Errorf("%w: claim %q is missing", ErrInvalidCredentials, a.uuidClaim)
+	}
+
+	return &Identity{
+		UserUUID: userUUID,
+		Roles:    rolesFromClaim(claims[a.rolesClaim]),
+	}, nil
+}
+
+func (a *JWTAuthenticator) verify(token string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("malformed token")
+	}
+
+	headerBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("malformed token header")
+	}
+
+	var header jwtHeader
+	if err = json.Unmarshal(headerBytes, &header); err != nil {
+		return nil, fmt.Errorf("malformed token header")
+	}
+
+	if header.Alg != algHS256 && header.Alg != algRS256 {
+		return nil, fmt.Errorf("unsupported alg %q", header.Alg)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("malformed token signature")
+	}
+
+	signingInput := []byte(parts[0] + "." + parts[1])
+	digest := sha256.Sum256(signingInput)
+
+	verified := false
+	for _, key := range a.keys.lookup(header.Kid, header.Alg) {
+		switch key.alg {
+		case algHS256:
+			mac := hmac.New(sha256.New, key.hmacKey)
+			mac.Write(signingInput)
+			verified = hmac.Equal(signature, mac.Sum(nil))
+		case algRS256:
+			verified = rsa.VerifyPKCS1v15(key.rsaPublic, crypto.SHA256, digest[:], signature) == nil
+		}
+		if verified {
+			break
+		}
+	}
+	if !verified {
+		return nil, fmt.Errorf("signature verification failed")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("malformed token payload")
+	}
+
+	var claims map[string]interface{}
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("malformed token payload")
+	}
+
+	return claims, nil
+}
+
+func (a *JWTAuthenticator) validateClaims(claims map[string]interface{}) error {
+	now := time.Now()
+
+	exp, ok := numericClaim(claims, "exp")
+	if !ok {
+		return fmt.Errorf("exp claim is required")
+	}
+	if now.After(exp.Add(a.leeway)) {
+		return fmt.Errorf("token is expired")
+	}
+
+	if nbf, ok := numericClaim(claims, "nbf"); ok && now.Add(a.leeway).Before(nbf) {
+		return fmt.Errorf("token is not valid yet")
+	}
+
+	if a.issuer != "" {
+		if iss, _ := claims["iss"].(string); iss != a.issuer {
+			return fmt.Errorf("unexpected issuer")
+		}
+	}
+
+	if a.audience != "" && !hasAudience(claims["aud"], a.audience) {
+		return fmt.Errorf("unexpected audience")
+	}
+
+	return nil
+}
+
+func numericClaim(claims map[string]interface{}, name string) (time.Time, bool) {
+	value, ok := claims[name].(float64)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(value), 0), true
+}
+
+func hasAudience(claim interface{}, audience string) bool {
+	switch aud := claim.(type) {
+	case string:
+		return aud == audience
+	case []interface{}:
+		for _, item := range aud {
+			if s, ok := item.(string); ok && s == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func rolesFromClaim(claim interface{}) []string {
+	switch roles := claim.(type) {
+	case string:
+		return splitRoles(roles)
+	case []interface{}:
+		result := make([]string, 0, len(roles))
+		for _, item := range roles {
+			if role, ok := item.(string); ok && role != "" {
+				result = append(result, role)
+			}
+		}
+		return result
+	}
+	return nil
+}
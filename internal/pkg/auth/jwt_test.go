@@ -0,0 +1,212 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/s21platform/materials-service/internal/config"
+)
+
+func testRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key
+}
+
+func encodeSegment(t *testing.T, v interface{}) string {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func signHS256(t *testing.T, kid string, secret []byte, claims map[string]interface{}) string {
+	t.Helper()
+
+	signingInput := encodeSegment(t, jwtHeader{Alg: algHS256, Kid: kid}) + "." + encodeSegment(t, claims)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signRS256(t *testing.T, kid string, key *rsa.PrivateKey, claims map[string]interface{}) string {
+	t.Helper()
+
+	signingInput := encodeSegment(t, jwtHeader{Alg: algRS256, Kid: kid}) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func newTestJWTAuthenticator(t *testing.T, keys ...jwk) *JWTAuthenticator {
+	t.Helper()
+
+	cfg := &config.Config{}
+	cfg.Auth.JWKSPath = writeJWKS(t, keys...)
+	cfg.Auth.JWKSReloadInterval = 0
+	cfg.Auth.Issuer = "s21-auth"
+	cfg.Auth.Audience = "materials"
+	cfg.Auth.UUIDClaim = "sub"
+	cfg.Auth.RolesClaim = "roles"
+	cfg.Auth.Leeway = 30 * time.Second
+
+	authenticator, err := NewJWTAuthenticator(cfg)
+	require.NoError(t, err)
+	return authenticator
+}
+
+func validClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"sub":   "3f1c9a52-7a4e-4b8e-9d1b-0c6f2a4e5b71",
+		"iss":   "s21-auth",
+		"aud":   []string{"gateway", "materials"},
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"roles": []string{"moderator"},
+	}
+}
+
+func TestJWTAuthenticator_HS256(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("hs256-secret")
+	authenticator := newTestJWTAuthenticator(t, hmacJWK("h1", secret))
+
+	identity, err := authenticator.Authenticate(context.Background(), Credentials{BearerToken: signHS256(t, "h1", secret, validClaims())})
+	require.NoError(t, err)
+	assert.Equal(t, &Identity{UserUUID: "3f1c9a52-7a4e-4b8e-9d1b-0c6f2a4e5b71", Roles: []string{"moderator"}}, identity)
+
+	// kid необязателен: перебираются все ключи алгоритма
+	_, err = authenticator.Authenticate(context.Background(), Credentials{BearerToken: signHS256(t, "", secret, validClaims())})
+	require.NoError(t, err)
+
+	_, err = authenticator.Authenticate(context.Background(), Credentials{BearerToken: signHS256(t, "h1", []byte("forged"), validClaims())})
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestJWTAuthenticator_RS256(t *testing.T) {
+	t.Parallel()
+
+	key := testRSAKey(t)
+	authenticator := newTestJWTAuthenticator(t, rsaJWK("r1", &key.PublicKey))
+
+	claims := validClaims()
+	claims["roles"] = "moderator, exporter"
+
+	identity, err := authenticator.Authenticate(context.Background(), Credentials{BearerToken: signRS256(t, "r1", key, claims)})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"moderator", "exporter"}, identity.Roles)
+
+	_, err = authenticator.Authenticate(context.Background(), Credentials{BearerToken: signRS256(t, "r1", testRSAKey(t), claims)})
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestJWTAuthenticator_Rejects(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("hs256-secret")
+	authenticator := newTestJWTAuthenticator(t, hmacJWK("h1", secret))
+
+	withClaim := func(name string, value interface{}) map[string]interface{} {
+		claims := validClaims()
+		if value == nil {
+			delete(claims, name)
+		} else {
+			claims[name] = value
+		}
+		return claims
+	}
+
+	noneToken := encodeSegment(t, jwtHeader{Alg: "none"}) + "." + encodeSegment(t, validClaims()) + "."
+	tampered := signHS256(t, "h1", secret, validClaims())
+	tampered = tampered[:len(tampered)-2] + "xx"
+
+	cases := map[string]string{
+		"expired":         signHS256(t, "h1", secret, withClaim("exp", time.Now().Add(-time.Minute).Unix())),
+		"no_exp":          signHS256(t, "h1", secret, withClaim("exp", nil)),
+		"not_yet_valid":   signHS256(t, "h1", secret, withClaim("nbf", time.Now().Add(time.Hour).Unix())),
+		"wrong_issuer":    signHS256(t, "h1", secret, withClaim("iss", "other")),
+		"wrong_audience":  signHS256(t, "h1", secret, withClaim("aud", "other")),
+		"no_subject":      signHS256(t, "h1", secret, withClaim("sub", nil)),
+		"unknown_kid":     signHS256(t, "h2", secret, validClaims()),
+		"alg_none":        noneToken,
+		"malformed":       "not-a-token",
+		"tampered":        tampered,
+		"rs256_with_hmac": encodeSegment(t, jwtHeader{Alg: algRS256, Kid: "h1"}) + "." + encodeSegment(t, validClaims()) + ".c2ln",
+	}
+
+	for name, token := range cases {
+		token := token
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := authenticator.Authenticate(context.Background(), Credentials{BearerToken: token})
+			assert.ErrorIs(t, err, ErrInvalidCredentials)
+		})
+	}
+
+	t.Run("leeway", func(t *testing.T) {
+		t.Parallel()
+
+		token := signHS256(t, "h1", secret, withClaim("exp", time.Now().Add(-10*time.Second).Unix()))
+		_, err := authenticator.Authenticate(context.Background(), Credentials{BearerToken: token})
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing_token", func(t *testing.T) {
+		t.Parallel()
+
+		// UUID из заголовка в режиме jwt не принимается
+		_, err := authenticator.Authenticate(context.Background(), Credentials{UserUUID: "3f1c9a52-7a4e-4b8e-9d1b-0c6f2a4e5b71", Roles: "moderator"})
+		assert.ErrorIs(t, err, ErrMissingCredentials)
+	})
+}
+
+func TestJWTAuthenticator_KeyRotation(t *testing.T) {
+	t.Parallel()
+
+	oldSecret, newSecret := []byte("old-secret"), []byte("new-secret")
+	start := time.Now().Add(-time.Hour)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	rewriteJWKS(t, path, start, hmacJWK("old", oldSecret))
+
+	cfg := &config.Config{}
+	cfg.Auth.JWKSPath = path
+	cfg.Auth.UUIDClaim = "sub"
+	cfg.Auth.RolesClaim = "roles"
+
+	authenticator, err := NewJWTAuthenticator(cfg)
+	require.NoError(t, err)
+
+	oldToken := signHS256(t, "old", oldSecret, validClaims())
+	newToken := signHS256(t, "new", newSecret, validClaims())
+
+	_, err = authenticator.Authenticate(context.Background(), Credentials{BearerToken: newToken})
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	rewriteJWKS(t, path, start.Add(time.Minute), hmacJWK("old", oldSecret), hmacJWK("new", newSecret))
+	_, err = authenticator.Authenticate(context.Background(), Credentials{BearerToken: oldToken})
+	assert.NoError(t, err)
+	_, err = authenticator.Authenticate(context.Background(), Credentials{BearerToken: newToken})
+	assert.NoError(t, err)
+
+	rewriteJWKS(t, path, start.Add(2*time.Minute), hmacJWK("new", newSecret))
+	_, err = authenticator.Authenticate(context.Background(), Credentials{BearerToken: oldToken})
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+}
@@ -0,0 +1,13 @@
+package blob
+
+import (
+	"context"
+)
+
+// Storage — хранилище загруженных файлов. Ключ — относительный путь вида covers/<material>/<upload>/<file>
+type Storage interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+}
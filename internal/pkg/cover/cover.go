@@ -0,0 +1,123 @@
+package cover
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"github.com/s21platform/materials-service/internal/config"
+	"github.com/s21platform/materials-service/internal/model"
+	"github.com/s21platform/materials-service/internal/pkg/blob"
+	"github.com/s21platform/materials-service/internal/pkg/imaging"
+)
+
+type DBRepo interface {
+	CreateCoverUpload(ctx context.Context, upload *model.CoverUpload) (string, error)
+	SetMaterialCover(ctx context.Context, materialUUID string, cover *model.Cover) (int64, error)
+}
+
+// Uploader проверяет изображение, сохраняет обложку с миниатюрами в хранилище и записывает их URL в материал
+type Uploader struct {
+	repo            DBRepo
+	storage         blob.Storage
+	limits          imaging.Limits
+	maxBytes        int64
+	width           int
+	thumbnailWidths []int
+}
+
+func New(repo DBRepo, storage blob.Storage, cfg *config.Config) *Uploader {
+	return &Uploader{
+		repo:    repo,
+		storage: storage,
+		limits: imaging.Limits{
+			MinWidth:  cfg.Covers.MinWidth,
+			MinHeight: cfg.Covers.MinHeight,
+			MaxWidth:  cfg.Covers.MaxWidth,
+			MaxHeight: cfg.Covers.MaxHeight,
+		},
+		maxBytes:        cfg.Covers.MaxBytes,
+		width:           cfg.Covers.Width,
+		thumbnailWidths: cfg.Covers.ThumbnailWidths,
+	}
+}
+
+type file struct {
+	key  string
+	data []byte
+}
+
+func (u *Uploader) Upload(ctx context.Context, materialUUID, ownerUUID string, data []byte) (*model.Cover, error) {
+	if int64(len(data)) > u.maxBytes {
+		return nil, model.ValidationError("file", "invalid cover image: file is larger than %d bytes", u.maxBytes)
+	}
+
+	img, format, err := imaging.Decode(data, u.limits)
+	if err != nil {
+		return nil, model.ValidationError("file", "invalid cover image: %v", err)
+	}
+
+	prefix := fmt.Sprintf("covers/%s/%s/", materialUUID, uuid.New().String())
+	ext := imaging.Extension(format)
+
+	// обложка перекодируется всегда, чтобы не хранить метаданные исходного файла
+	encoded, err := imaging.Encode(imaging.Resize(img, u.width), format)
+	if err != nil {
+		return nil, err
+	}
+	files := []file{{key: prefix + "cover." + ext, data: encoded}}
+	cover := &model.Cover{
+		URL:        u.storage.URL(files[0].key),
+		Thumbnails: make(model.CoverThumbnailList, 0, len(u.thumbnailWidths)),
+	}
+
+	for _, width := range u.thumbnailWidths {
+		thumbnail := imaging.Resize(img, width)
+		encoded, err = imaging.Encode(thumbnail, format)
+		if err != nil {
+			return nil, err
+		}
+
+		key := prefix + "w" + strconv.Itoa(width) + "." + ext
+		files = append(files, file{key: key, data: encoded})
+		cover.Thumbnails = append(cover.Thumbnails, model.CoverThumbnail{
+			Width: int32(thumbnail.Bounds().Dx()),
+			URL:   u.storage.URL(key),
+		})
+	}
+
+	keys := make([]string, 0, len(files))
+	for _, f := range files {
+		keys = append(keys, f.key)
+	}
+
+	// запись о загрузке создаётся до сохранения файлов: если что-то упадёт дальше,
+	// сборщик мусора удалит уже сохранённые файлы, так как материал на них не ссылается
+	_, err = u.repo.CreateCoverUpload(ctx, &model.CoverUpload{
+		OwnerUUID:    ownerUUID,
+		MaterialUUID: materialUUID,
+		URL:          cover.URL,
+		StorageKeys:  keys,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range files {
+		if err = u.storage.Put(ctx, f.key, f.data, imaging.ContentType(format)); err != nil {
+			return nil, fmt.Errorf("failed to store cover: %w", err)
+		}
+	}
+
+	rowsAffected, err := u.repo.SetMaterialCover(ctx, materialUUID, cover)
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		return nil, model.NotFoundError("material not found")
+	}
+
+	return cover, nil
+}
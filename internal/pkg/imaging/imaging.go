@@ -0,0 +1,150 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"math"
+)
+
+const (
+	FormatJPEG = "jpeg"
+	FormatPNG  = "png"
+
+	jpegQuality = 85
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format, allowed: jpeg, png")
+	ErrInvalidDimensions = errors.New("invalid image dimensions")
+)
+
+// Limits — допустимые размеры исходного изображения в пикселях
+type Limits struct {
+	MinWidth  int
+	MinHeight int
+	MaxWidth  int
+	MaxHeight int
+}
+
+// Decode проверяет формат и размеры по заголовку и только после этого декодирует изображение целиком,
+// чтобы не распаковывать в память слишком большие картинки
+func Decode(data []byte, limits Limits) (image.Image, string, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", ErrUnsupportedFormat
+	}
+	if format != FormatJPEG && format != FormatPNG {
+		return nil, "", ErrUnsupportedFormat
+	}
+
+	if cfg.Width < limits.MinWidth || cfg.Height < limits.MinHeight ||
+		cfg.Width > limits.MaxWidth || cfg.Height > limits.MaxHeight {
+		return nil, "", fmt.Errorf("%w: got %dx%d, allowed from %dx%d to %dx%d", ErrInvalidDimensions,
+			cfg.Width, cfg.Height, limits.MinWidth, limits.MinHeight, limits.MaxWidth, limits.MaxHeight)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+	}
+
+	return img, format, nil
+}
+
+// Resize уменьшает изображение до ширины width с сохранением пропорций, усредняя пиксели по площади.
+// Изображения уже меньше width не увеличиваются. Исходник читается построчно, поэтому сверх результата
+// в памяти держится только одна строка исходного изображения.
+func Resize(src image.Image, width int) image.Image {
+	bounds := src.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	if width >= srcW {
+		width = srcW
+	}
+	height := max(1, int(math.Round(float64(srcH)*float64(width)/float64(srcW))))
+
+	if width == srcW && height == srcH {
+		return src
+	}
+
+	// усредняем в premultiplied RGBA, чтобы прозрачные пиксели не окрашивали края
+	row := image.NewRGBA(image.Rect(0, 0, srcW, 1))
+	sums := make([]uint64, width*4)
+	out := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		y0 := y * srcH / height
+		y1 := max((y+1)*srcH/height, y0+1)
+
+		clear(sums)
+		for sy := y0; sy < y1; sy++ {
+			draw.Draw(row, row.Bounds(), src, image.Pt(bounds.Min.X, bounds.Min.Y+sy), draw.Src)
+
+			for x := 0; x < width; x++ {
+				x0 := x * srcW / width
+				x1 := max((x+1)*srcW/width, x0+1)
+
+				sum := sums[x*4 : x*4+4]
+				pix := row.Pix[x0*4 : x1*4]
+				for i := 0; i < len(pix); i += 4 {
+					sum[0] += uint64(pix[i])
+					sum[1] += uint64(pix[i+1])
+					sum[2] += uint64(pix[i+2])
+					sum[3] += uint64(pix[i+3])
+				}
+			}
+		}
+
+		for x := 0; x < width; x++ {
+			x0 := x * srcW / width
+			x1 := max((x+1)*srcW/width, x0+1)
+			n := uint64((x1 - x0) * (y1 - y0))
+
+			offset := y*out.Stride + x*4
+			out.Pix[offset] = uint8(sums[x*4] / n)
+			out.Pix[offset+1] = uint8(sums[x*4+1] / n)
+			out.Pix[offset+2] = uint8(sums[x*4+2] / n)
+			out.Pix[offset+3] = uint8(sums[x*4+3] / n)
+		}
+	}
+
+	return out
+}
+
+// Encode кодирует изображение в исходном формате, метаданные исходного файла при этом не сохраняются
+func Encode(img image.Image, format string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	var err error
+	switch format {
+	case FormatJPEG:
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	case FormatPNG:
+		err = png.Encode(&buf, img)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func ContentType(format string) string {
+	if format == FormatPNG {
+		return "image/png"
+	}
+	return "image/jpeg"
+}
+
+func Extension(format string) string {
+	if format == FormatPNG {
+		return "png"
+	}
+	return "jpg"
+}
@@ -0,0 +1,71 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResize(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no_upscale", func(t *testing.T) {
+		t.Parallel()
+
+		src := image.NewNRGBA(image.Rect(0, 0, 40, 20))
+		assert.Same(t, src, Resize(src, 100))
+	})
+
+	t.Run("box_average", func(t *testing.T) {
+		t.Parallel()
+
+		// левая половина красная, правая синяя; смещённые bounds проверяют чтение от Min
+		src := image.NewNRGBA(image.Rect(10, 5, 14, 7))
+		for y := 5; y < 7; y++ {
+			for x := 10; x < 14; x++ {
+				c := color.NRGBA{R: 255, A: 255}
+				if x >= 12 {
+					c = color.NRGBA{B: 255, A: 255}
+				}
+				src.SetNRGBA(x, y, c)
+			}
+		}
+
+		out := Resize(src, 2)
+		assert.Equal(t, image.Rect(0, 0, 2, 1), out.Bounds())
+		assert.Equal(t, color.RGBA{R: 255, A: 255}, out.At(0, 0))
+		assert.Equal(t, color.RGBA{B: 255, A: 255}, out.At(1, 0))
+	})
+
+	t.Run("transparent_pixels_do_not_bleed", func(t *testing.T) {
+		t.Parallel()
+
+		src := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+		src.SetNRGBA(0, 0, color.NRGBA{R: 255, A: 255})
+		src.SetNRGBA(1, 0, color.NRGBA{G: 255, A: 0})
+		src.SetNRGBA(0, 1, color.NRGBA{R: 255, A: 255})
+		src.SetNRGBA(1, 1, color.NRGBA{G: 255, A: 0})
+
+		out := Resize(src, 1)
+		assert.Equal(t, color.RGBA{R: 127, A: 127}, out.At(0, 0))
+	})
+
+	t.Run("ycbcr_source", func(t *testing.T) {
+		t.Parallel()
+
+		src := image.NewYCbCr(image.Rect(0, 0, 8, 4), image.YCbCrSubsampleRatio420)
+		for i := range src.Y {
+			src.Y[i] = 200
+		}
+		for i := range src.Cb {
+			src.Cb[i] = 128
+			src.Cr[i] = 128
+		}
+
+		out := Resize(src, 4)
+		assert.Equal(t, image.Rect(0, 0, 4, 2), out.Bounds())
+		assert.Equal(t, color.RGBA{R: 200, G: 200, B: 200, A: 255}, out.At(3, 1))
+	})
+}
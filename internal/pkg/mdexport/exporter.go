@@ -0,0 +1,214 @@
+// Package mdexport собирает файл для скачивания материала: Markdown с front matter, HTML-страницу или zip-архив.
+package mdexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"html"
+	"path"
+	"sort"
+	"strings"
+
+	logger_lib "github.com/s21platform/logger-lib"
+	"gopkg.in/yaml.v3"
+
+	"github.com/s21platform/materials-service/internal/model"
+	"github.com/s21platform/materials-service/internal/pkg/attachment"
+	"github.com/s21platform/materials-service/internal/pkg/blob"
+)
+
+const (
+	assetsDir        = "assets"
+	bundleMarkdown   = "material.md"
+	bundleHTML       = "index.html"
+	markdownMIMEType = "text/markdown; charset=utf-8"
+	htmlMIMEType     = "text/html; charset=utf-8"
+	zipMIMEType      = "application/zip"
+)
+
+type DBRepo interface {
+	GetMaterialAttachments(ctx context.Context, materialUUID string) (model.MaterialAttachmentList, error)
+	GetMaterialsTags(ctx context.Context, uuids []string) ([]model.MaterialTag, error)
+}
+
+type Exporter struct {
+	repo    DBRepo
+	storage blob.Storage
+}
+
+func New(repo DBRepo, storage blob.Storage) *Exporter {
+	return &Exporter{
+		repo:    repo,
+		storage: storage,
+	}
+}
+
+func (e *Exporter) Export(ctx context.Context, material *model.Material, format string) (*model.MaterialExport, error) {
+	switch format {
+	case model.ExportFormatMarkdown, model.ExportFormatHTML, model.ExportFormatZip:
+	default:
+		return nil, model.ValidationError("format", "unknown export format %q", format)
+	}
+
+	materialTags, err := e.repo.GetMaterialsTags(ctx, []string{material.UUID})
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]string, 0, len(materialTags))
+	for _, tag := range materialTags {
+		tags = append(tags, tag.Tag)
+	}
+	sort.Strings(tags)
+
+	var attachments model.MaterialAttachmentList
+	if material.Content != nil && attachment.HasReferences(*material.Content) {
+		attachments, err = e.repo.GetMaterialAttachments(ctx, material.UUID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch format {
+	case model.ExportFormatMarkdown:
+		data, err := renderMarkdown(material, tags, e.rewrite(material, attachments, e.storage.URL))
+		if err != nil {
+			return nil, err
+		}
+		return &model.MaterialExport{Filename: material.UUID + ".md", ContentType: markdownMIMEType, Data: data}, nil
+
+	case model.ExportFormatHTML:
+		data := renderDocument(material, e.rewrite(material, attachments, e.storage.URL))
+		return &model.MaterialExport{Filename: material.UUID + ".html", ContentType: htmlMIMEType, Data: data}, nil
+	}
+
+	data, err := e.bundle(ctx, material, tags, attachments)
+	if err != nil {
+		return nil, err
+	}
+	return &model.MaterialExport{Filename: material.UUID + ".zip", ContentType: zipMIMEType, Data: data}, nil
+}
+
+// bundle складывает в архив Markdown, HTML и файлы вложений, ссылки в контенте ведут на assets/.
+// Вложение, которого нет в хранилище, остаётся ссылкой на хранилище.
+func (e *Exporter) bundle(ctx context.Context, material *model.Material, tags []string, attachments model.MaterialAttachmentList) ([]byte, error) {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+
+	local := make(map[string]struct{}, len(attachments))
+	for _, item := range attachments {
+		data, err := e.storage.Get(ctx, item.StorageKey)
+		if err != nil {
+			logger_lib.Warn(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to read attachment %s for export", item.UUID))
+			continue
+		}
+		if err = writeEntry(archive, assetPath(item.StorageKey), data); err != nil {
+			return nil, err
+		}
+		local[item.StorageKey] = struct{}{}
+	}
+
+	content := e.rewrite(material, attachments, func(key string) string {
+		if _, ok := local[key]; ok {
+			return assetPath(key)
+		}
+		return e.storage.URL(key)
+	})
+
+	markdown, err := renderMarkdown(material, tags, content)
+	if err != nil {
+		return nil, err
+	}
+	if err = writeEntry(archive, bundleMarkdown, markdown); err != nil {
+		return nil, err
+	}
+	if err = writeEntry(archive, bundleHTML, renderDocument(material, content)); err != nil {
+		return nil, err
+	}
+
+	if err = archive.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close export archive: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// rewrite заменяет ссылки attachment://<uuid> в контенте материала на адреса, построенные по ключу в хранилище
+func (e *Exporter) rewrite(material *model.Material, attachments model.MaterialAttachmentList, target func(key string) string) string {
+	if material.Content == nil {
+		return ""
+	}
+
+	targets := make(map[string]string, len(attachments))
+	for _, item := range attachments {
+		targets[item.UUID] = target(item.StorageKey)
+	}
+	return attachment.RewriteReferences(*material.Content, targets)
+}
+
+func renderMarkdown(material *model.Material, tags []string, content string) ([]byte, error) {
+	meta, err := yaml.Marshal(model.FrontMatter{
+		Title:           material.Title,
+		Description:     material.Description,
+		Cover:           material.CoverImageURL,
+		Tags:            tags,
+		Status:          material.Status,
+		ReadTimeMinutes: material.ReadTimeMinutes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal front matter: %w", err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	buf.Write(meta)
+	buf.WriteString("---\n\n")
+	buf.WriteString(content)
+	if content != "" && !strings.HasSuffix(content, "\n") {
+		buf.WriteString("\n")
+	}
+	return buf.Bytes(), nil
+}
+
+// renderDocument собирает самостоятельную HTML-страницу, пригодную для печати
+func renderDocument(material *model.Material, content string) []byte {
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	b.WriteString("<meta name=\"viewport\" content=\"width=device-width, initial-scale=1\">\n")
+	b.WriteString("<title>" + html.EscapeString(material.Title) + "</title>\n")
+	if material.Description != "" {
+		b.WriteString("<meta name=\"description\" content=\"" + html.EscapeString(material.Description) + "\">\n")
+	}
+	b.WriteString("<style>\n" + documentStyle + "</style>\n</head>\n<body>\n<article>\n")
+	// заголовок добавляется, только если контент не начинается со своего
+	if !strings.HasPrefix(strings.TrimSpace(content), "# ") {
+		b.WriteString("<h1>" + html.EscapeString(material.Title) + "</h1>\n")
+	}
+	b.WriteString(renderHTML(content))
+	b.WriteString("</article>\n</body>\n</html>\n")
+	return []byte(b.String())
+}
+
+const documentStyle = `body { margin: 0 auto; max-width: 46em; padding: 2em 1em; font: 16px/1.6 Georgia, serif; color: #222; }
+h1, h2, h3, h4, h5, h6 { font-family: Helvetica, Arial, sans-serif; line-height: 1.25; }
+img { max-width: 100%; }
+pre { overflow-x: auto; padding: 1em; background: #f5f5f5; }
+code { font-family: Menlo, Consolas, monospace; font-size: 0.9em; }
+blockquote { margin-left: 0; padding-left: 1em; border-left: 3px solid #ccc; color: #555; }
+@media print { body { max-width: none; padding: 0; } pre { white-space: pre-wrap; } a { color: inherit; } }
+`
+
+func writeEntry(archive *zip.Writer, name string, data []byte) error {
+	entry, err := archive.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to add %s to export archive: %w", name, err)
+	}
+	if _, err = entry.Write(data); err != nil {
+		return fmt.Errorf("failed to write %s to export archive: %w", name, err)
+	}
+	return nil
+}
+
+func assetPath(key string) string {
+	return assetsDir + "/" + path.Base(key)
+}
@@ -0,0 +1,223 @@
+package mdexport
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// Рендерер покрывает подмножество CommonMark, которым пишутся материалы: заголовки, абзацы, списки,
+// цитаты, блоки кода, разделители, ссылки, картинки, код, жирный и курсив. Всё остальное выводится
+// экранированным текстом, сырой HTML из материала в документ не попадает.
+
+var (
+	headingPattern     = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	rulePattern        = regexp.MustCompile(`^\s{0,3}([-*_])(\s*([-*_])){2,}\s*$`)
+	bulletItemPattern  = regexp.MustCompile(`^\s{0,3}[-*+]\s+(.*)$`)
+	orderedItemPattern = regexp.MustCompile(`^\s{0,3}\d{1,9}[.)]\s+(.*)$`)
+	fencePattern       = regexp.MustCompile("^\\s{0,3}(```+|~~~+)\\s*([^`\\s]*)")
+)
+
+func renderHTML(markdown string) string {
+	var b strings.Builder
+	renderBlocks(&b, strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n"))
+	return b.String()
+}
+
+func renderBlocks(b *strings.Builder, lines []string) {
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			i++
+
+		case fencePattern.MatchString(line):
+			match := fencePattern.FindStringSubmatch(line)
+			fence, language := match[1], match[2]
+			i++
+			var code []string
+			for i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), fence) {
+				code = append(code, lines[i])
+				i++
+			}
+			i++ // закрывающий забор, если он есть
+
+			b.WriteString("<pre><code")
+			if language != "" {
+				b.WriteString(` class="language-` + html.EscapeString(language) + `"`)
+			}
+			b.WriteString(">" + html.EscapeString(strings.Join(code, "\n")) + "</code></pre>\n")
+
+		case headingPattern.MatchString(line):
+			match := headingPattern.FindStringSubmatch(line)
+			level := string(rune('0' + len(match[1])))
+			b.WriteString("<h" + level + ">" + renderInline(match[2]) + "</h" + level + ">\n")
+			i++
+
+		case rulePattern.MatchString(line):
+			b.WriteString("<hr>\n")
+			i++
+
+		case strings.HasPrefix(trimmed, ">"):
+			var quoted []string
+			for i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), ">") {
+				text := strings.TrimPrefix(strings.TrimSpace(lines[i]), ">")
+				quoted = append(quoted, strings.TrimPrefix(text, " "))
+				i++
+			}
+			b.WriteString("<blockquote>\n")
+			renderBlocks(b, quoted)
+			b.WriteString("</blockquote>\n")
+
+		case bulletItemPattern.MatchString(line):
+			i = renderList(b, lines, i, "ul", bulletItemPattern)
+
+		case orderedItemPattern.MatchString(line):
+			i = renderList(b, lines, i, "ol", orderedItemPattern)
+
+		default:
+			var paragraph []string
+			for i < len(lines) && strings.TrimSpace(lines[i]) != "" && !startsBlock(lines[i]) {
+				paragraph = append(paragraph, strings.TrimSpace(lines[i]))
+				i++
+			}
+			b.WriteString("<p>" + renderInline(strings.Join(paragraph, "\n")) + "</p>\n")
+		}
+	}
+}
+
+// renderList выводит плоский список, строки с отступом продолжают текущий пункт
+func renderList(b *strings.Builder, lines []string, i int, tag string, itemPattern *regexp.Regexp) int {
+	var items []string
+	for i < len(lines) {
+		line := lines[i]
+		if match := itemPattern.FindStringSubmatch(line); match != nil {
+			items = append(items, match[1])
+		} else if len(items) > 0 && strings.TrimSpace(line) != "" && (line[0] == ' ' || line[0] == '\t') {
+			items[len(items)-1] += "\n" + strings.TrimSpace(line)
+		} else {
+			break
+		}
+		i++
+	}
+
+	b.WriteString("<" + tag + ">\n")
+	for _, item := range items {
+		b.WriteString("<li>" + renderInline(item) + "</li>\n")
+	}
+	b.WriteString("</" + tag + ">\n")
+
+	return i
+}
+
+func startsBlock(line string) bool {
+	return headingPattern.MatchString(line) ||
+		fencePattern.MatchString(line) ||
+		rulePattern.MatchString(line) ||
+		strings.HasPrefix(strings.TrimSpace(line), ">") ||
+		bulletItemPattern.MatchString(line) ||
+		orderedItemPattern.MatchString(line)
+}
+
+// renderInline разбирает строку слева направо; незакрытая разметка выводится как текст
+func renderInline(text string) string {
+	var b strings.Builder
+
+	for i := 0; i < len(text); {
+		rest := text[i:]
+
+		switch {
+		case rest[0] == '\\' && len(rest) > 1 && strings.ContainsRune("\\`*_[]()!#>-", rune(rest[1])):
+			b.WriteString(html.EscapeString(rest[1:2]))
+			i += 2
+			continue
+
+		case rest[0] == '`':
+			ticks := len(rest) - len(strings.TrimLeft(rest, "`"))
+			if end := strings.Index(rest[ticks:], rest[:ticks]); end >= 0 {
+				code := strings.TrimSpace(rest[ticks : ticks+end])
+				b.WriteString("<code>" + html.EscapeString(code) + "</code>")
+				i += 2*ticks + end
+				continue
+			}
+
+		case strings.HasPrefix(rest, "!["):
+			if label, target, n, ok := parseLink(rest[1:]); ok {
+				b.WriteString(`<img src="` + html.EscapeString(safeURL(target)) + `" alt="` + html.EscapeString(label) + `">`)
+				i += 1 + n
+				continue
+			}
+
+		case rest[0] == '[':
+			if label, target, n, ok := parseLink(rest); ok {
+				b.WriteString(`<a href="` + html.EscapeString(safeURL(target)) + `">` + renderInline(label) + "</a>")
+				i += n
+				continue
+			}
+
+		case rest[0] == '_' && i > 0 && isWordByte(text[i-1]):
+			// подчёркивания внутри слова (snake_case) выделением не считаются
+
+		case strings.HasPrefix(rest, "**") || strings.HasPrefix(rest, "__"):
+			if end := strings.Index(rest[2:], rest[:2]); end > 0 {
+				b.WriteString("<strong>" + renderInline(rest[2:2+end]) + "</strong>")
+				i += 4 + end
+				continue
+			}
+
+		case rest[0] == '*' || rest[0] == '_':
+			if end := strings.IndexByte(rest[1:], rest[0]); end > 0 && rest[1] != ' ' {
+				b.WriteString("<em>" + renderInline(rest[1:1+end]) + "</em>")
+				i += 2 + end
+				continue
+			}
+
+		case rest[0] == '\n':
+			b.WriteString("<br>\n")
+			i++
+			continue
+		}
+
+		b.WriteString(html.EscapeString(rest[:1]))
+		i++
+	}
+
+	return b.String()
+}
+
+func isWordByte(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c >= 0x80
+}
+
+// parseLink разбирает [label](target) в начале строки и возвращает длину разобранного фрагмента
+func parseLink(text string) (string, string, int, bool) {
+	closeLabel := strings.Index(text, "](")
+	if !strings.HasPrefix(text, "[") || closeLabel < 0 {
+		return "", "", 0, false
+	}
+	closeTarget := strings.IndexByte(text[closeLabel+2:], ')')
+	if closeTarget < 0 {
+		return "", "", 0, false
+	}
+
+	target := strings.TrimSpace(text[closeLabel+2 : closeLabel+2+closeTarget])
+	// заголовок ссылки [текст](url "title") не нужен
+	if space := strings.IndexAny(target, " \t"); space >= 0 {
+		target = target[:space]
+	}
+
+	return text[1:closeLabel], target, closeLabel + 3 + closeTarget, true
+}
+
+// safeURL отбрасывает схемы, через которые в документ можно встроить скрипт
+func safeURL(target string) string {
+	lower := strings.ToLower(strings.TrimSpace(target))
+	for _, scheme := range []string{"javascript:", "vbscript:", "data:"} {
+		if strings.HasPrefix(lower, scheme) {
+			return "#"
+		}
+	}
+	return target
+}
@@ -0,0 +1,200 @@
+// Package mdimport разбирает Markdown-файлы с YAML front matter для импорта материалов.
+package mdimport
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/s21platform/materials-service/internal/model"
+)
+
+const (
+	frontMatterDelimiter = "---"
+	// средняя скорость чтения для оценки, если время не указано в front matter
+	wordsPerMinute = 200
+)
+
+// Expand раскрывает zip-архивы в список вложенных Markdown-файлов, остальные файлы оставляет как есть,
+// чтобы они попали в отчёт с ошибкой. maxBytes ограничивает суммарный размер распакованных файлов.
+func Expand(files []model.ImportFile, maxFiles int, maxBytes int64) ([]model.ImportFile, error) {
+	expanded := make([]model.ImportFile, 0, len(files))
+	var total int64
+
+	add := func(file model.ImportFile) error {
+		total += int64(len(file.Data))
+		if total > maxBytes {
+			return model.ValidationError("files", "files are larger than %d bytes", maxBytes)
+		}
+		if len(expanded) >= maxFiles {
+			return model.ValidationError("files", "too many files, max %d", maxFiles)
+		}
+		expanded = append(expanded, file)
+		return nil
+	}
+
+	for _, file := range files {
+		if !strings.EqualFold(path.Ext(file.Name), ".zip") {
+			if err := add(file); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		archive, err := zip.NewReader(bytes.NewReader(file.Data), int64(len(file.Data)))
+		if err != nil {
+			return nil, model.ValidationError("files", "%s: invalid zip archive", file.Name)
+		}
+
+		for _, entry := range archive.File {
+			if entry.FileInfo().IsDir() || !IsMarkdown(entry.Name) || isServiceEntry(entry.Name) {
+				continue
+			}
+
+			data, err := readEntry(entry, maxBytes-total)
+			if err != nil {
+				return nil, model.ValidationError("files", "%s: %v", file.Name, err)
+			}
+			if err := add(model.ImportFile{Name: file.Name + "/" + entry.Name, Data: data}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(expanded) == 0 {
+		return nil, model.ValidationError("files", "no markdown files to import")
+	}
+
+	return expanded, nil
+}
+
+// Parse разбирает Markdown-файл: front matter между строками "---" в начале файла, остальное — содержимое.
+// Заголовок без front matter берётся из первого заголовка первого уровня.
+func Parse(file model.ImportFile) (*model.ImportedMaterial, error) {
+	if !IsMarkdown(file.Name) {
+		return nil, model.ValidationError("file", "not a markdown file")
+	}
+
+	text := strings.TrimPrefix(string(file.Data), "\ufeff")
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+
+	var meta model.FrontMatter
+	body := text
+	lines := strings.Split(text, "\n")
+	if len(lines) > 0 && strings.TrimSpace(lines[0]) == frontMatterDelimiter {
+		end := -1
+		for i := 1; i < len(lines); i++ {
+			if strings.TrimSpace(lines[i]) == frontMatterDelimiter {
+				end = i
+				break
+			}
+		}
+		if end < 0 {
+			return nil, model.ValidationError("front_matter", "front matter is not closed")
+		}
+
+		if err := yaml.Unmarshal([]byte(strings.Join(lines[1:end], "\n")), &meta); err != nil {
+			return nil, model.ValidationError("front_matter", "invalid front matter: %v", err)
+		}
+		body = strings.Join(lines[end+1:], "\n")
+	}
+	body = strings.TrimLeft(body, "\n")
+
+	title := strings.TrimSpace(meta.Title)
+	if title == "" {
+		title = firstHeading(body)
+	}
+	if title == "" {
+		return nil, model.ValidationError("title", "title is required")
+	}
+
+	status := strings.ToLower(strings.TrimSpace(meta.Status))
+	switch status {
+	case "":
+		status = model.ImportStatusDraft
+	case model.ImportStatusDraft, model.ImportStatusPublished:
+	default:
+		return nil, model.ValidationError("status", "unknown status %q, expected draft or published", meta.Status)
+	}
+
+	tags := model.NormalizeTags(meta.Tags)
+	for _, tag := range tags {
+		if len([]rune(tag)) > model.TagMaxLength {
+			return nil, model.ValidationError("tags", "tag is too long, max %d characters", model.TagMaxLength)
+		}
+	}
+
+	readTime := meta.ReadTimeMinutes
+	if readTime <= 0 {
+		readTime = estimateReadTime(body)
+	}
+
+	return &model.ImportedMaterial{
+		Filename: file.Name,
+		Draft: model.SaveDraftMaterial{
+			Title:           title,
+			CoverImageURL:   strings.TrimSpace(meta.Cover),
+			Description:     strings.TrimSpace(meta.Description),
+			Content:         body,
+			ReadTimeMinutes: readTime,
+		},
+		Tags:   tags,
+		Status: status,
+	}, nil
+}
+
+func IsMarkdown(name string) bool {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".md", ".markdown":
+		return true
+	}
+	return false
+}
+
+// isServiceEntry отсекает служебные файлы архиваторов и скрытые файлы
+func isServiceEntry(name string) bool {
+	if strings.HasPrefix(name, "__MACOSX/") {
+		return true
+	}
+	return strings.HasPrefix(path.Base(name), ".")
+}
+
+func readEntry(entry *zip.File, limit int64) ([]byte, error) {
+	rc, err := entry.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", entry.Name, err)
+	}
+	defer func() {
+		_ = rc.Close()
+	}()
+
+	// размер в заголовке архива может не совпадать с реальным, поэтому ограничиваем само чтение
+	data, err := io.ReadAll(io.LimitReader(rc, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", entry.Name, err)
+	}
+	return data, nil
+}
+
+func firstHeading(body string) string {
+	for _, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(line, "# ") {
+			return strings.TrimSpace(strings.TrimPrefix(line, "# "))
+		}
+	}
+	return ""
+}
+
+func estimateReadTime(body string) int32 {
+	words := len(strings.Fields(body))
+	if words == 0 {
+		return 0
+	}
+	return int32(math.Ceil(float64(words) / wordsPerMinute))
+}
@@ -0,0 +1,107 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/s21platform/materials-service/internal/config"
+)
+
+type Store interface {
+	RateLimitHit(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error)
+}
+
+type Rule struct {
+	Limit  int64
+	Window time.Duration
+}
+
+// Limiter — лимит запросов в фиксированном окне, счётчики хранятся в Redis.
+// Правила задаются по имени gRPC-метода (ToggleLike) или HTTP-маршруту (PUT /api/materials).
+type Limiter struct {
+	store          Store
+	defaultRule    *Rule
+	rules          map[string]*Rule
+	trustForwarded bool
+}
+
+func New(store Store, cfg *config.Config) (*Limiter, error) {
+	defaultRule, err := ParseRule(cfg.RateLimit.Default)
+	if err != nil {
+		return nil, fmt.Errorf("invalid default rate limit: %v", err)
+	}
+
+	rules := make(map[string]*Rule, len(cfg.RateLimit.Methods))
+	for method, value := range cfg.RateLimit.Methods {
+		rule, err := ParseRule(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate limit for %q: %v", method, err)
+		}
+		rules[strings.TrimSpace(method)] = rule
+	}
+
+	return &Limiter{
+		store:          store,
+		defaultRule:    defaultRule,
+		rules:          rules,
+		trustForwarded: cfg.RateLimit.TrustForwarded,
+	}, nil
+}
+
+// ParseRule разбирает правило вида "60/1m". Пустое значение или "0" отключают лимит.
+func ParseRule(value string) (*Rule, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "0" {
+		return nil, nil
+	}
+
+	limitStr, windowStr, ok := strings.Cut(value, "/")
+	if !ok {
+		return nil, fmt.Errorf("expected format <limit>/<window>, got %q", value)
+	}
+
+	limit, err := strconv.ParseInt(strings.TrimSpace(limitStr), 10, 64)
+	if err != nil || limit <= 0 {
+		return nil, fmt.Errorf("invalid limit %q", limitStr)
+	}
+
+	window, err := time.ParseDuration(strings.TrimSpace(windowStr))
+	if err != nil || window <= 0 {
+		return nil, fmt.Errorf("invalid window %q", windowStr)
+	}
+
+	return &Rule{Limit: limit, Window: window}, nil
+}
+
+func (l *Limiter) TrustForwarded() bool {
+	return l.trustForwarded
+}
+
+// Allow учитывает запрос subject'а к методу и возвращает, разрешён ли он, и через сколько можно повторить
+func (l *Limiter) Allow(ctx context.Context, method, subject string) (bool, time.Duration, error) {
+	rule, ok := l.rules[method]
+	if !ok {
+		rule = l.defaultRule
+	}
+	if rule == nil {
+		return true, 0, nil
+	}
+
+	key := fmt.Sprintf("%s:%s", method, subject)
+	count, ttl, err := l.store.RateLimitHit(ctx, key, rule.Window)
+	if err != nil {
+		return true, 0, err
+	}
+
+	if count > rule.Limit {
+		if ttl <= 0 {
+			ttl = rule.Window
+		}
+		return false, ttl, nil
+	}
+
+	return true, 0, nil
+}
@@ -0,0 +1,206 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/s21platform/materials-service/internal/config"
+)
+
+// fixedWindowStore — фиксированное окно в памяти: счётчик сбрасывается, когда окно истекло
+type fixedWindowStore struct {
+	now     time.Time
+	hits    map[string]int64
+	expires map[string]time.Time
+	err     error
+	windows []time.Duration
+}
+
+func newFixedWindowStore() *fixedWindowStore {
+	return &fixedWindowStore{
+		now:     time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+		hits:    make(map[string]int64),
+		expires: make(map[string]time.Time),
+	}
+}
+
+func (s *fixedWindowStore) RateLimitHit(_ context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	s.windows = append(s.windows, window)
+	if s.err != nil {
+		return 0, 0, s.err
+	}
+
+	if expires, ok := s.expires[key]; !ok || !s.now.Before(expires) {
+		s.hits[key] = 0
+		s.expires[key] = s.now.Add(window)
+	}
+	s.hits[key]++
+
+	return s.hits[key], s.expires[key].Sub(s.now), nil
+}
+
+func newTestLimiter(t *testing.T, store Store, defaultRule string, methods map[string]string) *Limiter {
+	t.Helper()
+
+	cfg := &config.Config{}
+	cfg.RateLimit.Default = defaultRule
+	cfg.RateLimit.Methods = methods
+
+	limiter, err := New(store, cfg)
+	require.NoError(t, err)
+	return limiter
+}
+
+func TestParseRule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   string
+		want    *Rule
+		wantErr bool
+	}{
+		{name: "minute", value: "60/1m", want: &Rule{Limit: 60, Window: time.Minute}},
+		{name: "spaces", value: " 5 / 30s ", want: &Rule{Limit: 5, Window: 30 * time.Second}},
+		{name: "empty_disables", value: "", want: nil},
+		{name: "zero_disables", value: "0", want: nil},
+		{name: "no_window", value: "60", wantErr: true},
+		{name: "bad_limit", value: "x/1m", wantErr: true},
+		{name: "negative_limit", value: "-1/1m", wantErr: true},
+		{name: "bad_window", value: "60/soon", wantErr: true},
+		{name: "zero_window", value: "60/0s", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule, err := ParseRule(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, rule)
+		})
+	}
+}
+
+func TestNew_InvalidRules(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{}
+	cfg.RateLimit.Default = "often"
+	_, err := New(newFixedWindowStore(), cfg)
+	assert.Error(t, err)
+
+	cfg.RateLimit.Default = "10/1m"
+	cfg.RateLimit.Methods = map[string]string{"ToggleLike": "1/never"}
+	_, err = New(newFixedWindowStore(), cfg)
+	assert.ErrorContains(t, err, "ToggleLike")
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("fixed_window", func(t *testing.T) {
+		t.Parallel()
+
+		store := newFixedWindowStore()
+		limiter := newTestLimiter(t, store, "2/1m", nil)
+
+		for i := 0; i < 2; i++ {
+			allowed, _, err := limiter.Allow(ctx, "GetMaterial", "user:a")
+			require.NoError(t, err)
+			assert.True(t, allowed)
+		}
+
+		store.now = store.now.Add(20 * time.Second)
+		allowed, retryAfter, err := limiter.Allow(ctx, "GetMaterial", "user:a")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+		assert.Equal(t, 40*time.Second, retryAfter)
+
+		// другой пользователь считается отдельно
+		allowed, _, err = limiter.Allow(ctx, "GetMaterial", "user:b")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		// после окончания окна счётчик начинается заново
+		store.now = store.now.Add(40 * time.Second)
+		allowed, _, err = limiter.Allow(ctx, "GetMaterial", "user:a")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	})
+
+	t.Run("per_method_rules", func(t *testing.T) {
+		t.Parallel()
+
+		store := newFixedWindowStore()
+		limiter := newTestLimiter(t, store, "100/1m", map[string]string{
+			" ToggleLike ":       "1/10s",
+			"PUT /api/materials": "0",
+		})
+
+		allowed, _, err := limiter.Allow(ctx, "ToggleLike", "user:a")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		allowed, retryAfter, err := limiter.Allow(ctx, "ToggleLike", "user:a")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+		assert.Equal(t, 10*time.Second, retryAfter)
+
+		// метод без своего правила использует правило по умолчанию
+		allowed, _, err = limiter.Allow(ctx, "GetMaterial", "user:a")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+		assert.Equal(t, []time.Duration{10 * time.Second, 10 * time.Second, time.Minute}, store.windows)
+
+		// отключённое правило не обращается к хранилищу
+		for i := 0; i < 5; i++ {
+			allowed, _, err = limiter.Allow(ctx, "PUT /api/materials", "user:a")
+			require.NoError(t, err)
+			assert.True(t, allowed)
+		}
+		assert.Len(t, store.windows, 3)
+		assert.Equal(t, int64(2), store.hits["ToggleLike:user:a"])
+	})
+
+	t.Run("missing_ttl_falls_back_to_window", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := newTestLimiter(t, storeFunc(func(context.Context, string, time.Duration) (int64, time.Duration, error) {
+			return 3, -1, nil
+		}), "2/1m", nil)
+
+		allowed, retryAfter, err := limiter.Allow(ctx, "GetMaterial", "user:a")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+		assert.Equal(t, time.Minute, retryAfter)
+	})
+
+	t.Run("store_error_fails_open", func(t *testing.T) {
+		t.Parallel()
+
+		store := newFixedWindowStore()
+		store.err = errors.New("redis down")
+		limiter := newTestLimiter(t, store, "1/1m", nil)
+
+		allowed, _, err := limiter.Allow(ctx, "GetMaterial", "user:a")
+		assert.Error(t, err)
+		assert.True(t, allowed)
+	})
+}
+
+type storeFunc func(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error)
+
+func (f storeFunc) RateLimitHit(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	return f(ctx, key, window)
+}
@@ -5,6 +5,8 @@ import (
 	"net/http"
 
 	"google.golang.org/grpc"
+
+	"github.com/s21platform/materials-service/internal/model"
 )
 
 type key string
@@ -27,6 +29,16 @@ func TxMiddleWareGRPC(db DBRepo) func(ctx context.Context, req interface{}, info
 	}
 }
 
+func TxMiddleWareStreamGRPC(db DBRepo) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		return handler(srv, &model.ContextServerStream{ServerStream: stream, Ctx: WithDBRepoContext(stream.Context(), db)})
+	}
+}
+
 func TxMiddlewareHTTP(db DBRepo) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -10,12 +10,15 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
-	_ "github.com/lib/pq" // Импорт драйвера PostgreSQL
+	"github.com/lib/pq" // Импорт драйвера PostgreSQL
 
 	"github.com/s21platform/materials-service/internal/config"
 	"github.com/s21platform/materials-service/internal/model"
 )
 
+// ограничение на число строк в одном INSERT, чтобы не упереться в лимит параметров запроса
+const relatedInsertBatchSize = 1000
+
 type Repository struct {
 	*sqlx.DB
 }
@@ -79,6 +82,12 @@ func (r *Repository) GetMaterial(ctx context.Context, uuid string) (*model.Mater
 		"archived_at",
 		"deleted_at",
 		"likes_count",
+		"forked_from_uuid",
+		"forks_count",
+		"reaction_counts",
+		"hidden_at",
+		"cover_thumbnails",
+		"owner_deleted_at",
 	).
 		From("materials").
 		Where(sq.Eq{"uuid": uuid}).
@@ -91,7 +100,7 @@ func (r *Repository) GetMaterial(ctx context.Context, uuid string) (*model.Mater
 	err = r.Chk(ctx).GetContext(ctx, &material, query, args...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.New("material doesn't exist")
+			return nil, model.NotFoundError("material doesn't exist")
 		}
 		return nil, fmt.Errorf("failed to get material: %v", err)
 	}
@@ -99,9 +108,131 @@ func (r *Repository) GetMaterial(ctx context.Context, uuid string) (*model.Mater
 	return &material, nil
 }
 
-func (r *Repository) GetAllMaterials(ctx context.Context, offset, limit int) (*model.MaterialList, error) {
+// GetMaterialsByUUIDs достаёт материалы одним запросом, удалённые и отсутствующие пропускаются, порядок не гарантирован
+func (r *Repository) GetMaterialsByUUIDs(ctx context.Context, uuids []string) (model.MaterialList, error) {
+	var materials model.MaterialList
+
+	query, args, err := sq.Select(
+		"uuid",
+		"owner_uuid",
+		"title",
+		"cover_image_url",
+		"description",
+		"content",
+		"read_time_minutes",
+		"status",
+		"created_at",
+		"edited_at",
+		"published_at",
+		"archived_at",
+		"deleted_at",
+		"likes_count",
+		"forked_from_uuid",
+		"forks_count",
+		"reaction_counts",
+		"hidden_at",
+		"cover_thumbnails",
+		"owner_deleted_at",
+	).
+		From("materials").
+		Where("uuid = ANY(?)", pq.Array(uuids)).
+		Where(sq.Expr("deleted_at IS NULL")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).SelectContext(ctx, &materials, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch materials: %w", err)
+	}
+
+	return materials, nil
+}
+
+// ExportMaterials проходит по таблице в порядке (updated_at, uuid) и отдаёт материалы в fn пачками
+// по batchSize. Каждая пачка читается отдельным запросом после ключа последней отданной строки, поэтому
+// медленный получатель не держит ни выборку в памяти, ни транзакцию. Материал, изменённый во время
+// выгрузки, уходит в конец порядка и может прийти повторно в новой версии.
+// updated_at триггер ставит по clock_timestamp(), но строка видна только после коммита, поэтому при
+// инкрементальной выгрузке updated_since берётся с запасом на длительность пишущих транзакций.
+func (r *Repository) ExportMaterials(ctx context.Context, filter model.ExportFilter, batchSize int, fn func(model.MaterialList) error) error {
+	selectBuilder := sq.Select(
+		"uuid",
+		"owner_uuid",
+		"title",
+		"cover_image_url",
+		"description",
+		"content",
+		"read_time_minutes",
+		"status",
+		"created_at",
+		"edited_at",
+		"published_at",
+		"archived_at",
+		"deleted_at",
+		"likes_count",
+		"forked_from_uuid",
+		"forks_count",
+		"reaction_counts",
+		"hidden_at",
+		"cover_thumbnails",
+		"owner_deleted_at",
+		"updated_at",
+	).
+		From("materials").
+		OrderBy("updated_at", "uuid").
+		Limit(uint64(batchSize))
+
+	if filter.OwnerUUID != "" {
+		selectBuilder = selectBuilder.Where(sq.Eq{"owner_uuid": filter.OwnerUUID})
+	}
+	if filter.Status != "" {
+		selectBuilder = selectBuilder.Where(sq.Eq{"status": filter.Status})
+	}
+	if filter.UpdatedSince != nil {
+		selectBuilder = selectBuilder.Where(sq.GtOrEq{"updated_at": *filter.UpdatedSince})
+	}
+	if !filter.IncludeDeleted {
+		selectBuilder = selectBuilder.Where(sq.Expr("deleted_at IS NULL"))
+	}
+
+	query, args, err := selectBuilder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	for {
+		var batch model.MaterialList
+		if err := r.Chk(ctx).SelectContext(ctx, &batch, query, args...); err != nil {
+			return fmt.Errorf("failed to fetch materials: %w", err)
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+
+		if err := fn(batch); err != nil {
+			return err
+		}
+		if len(batch) < batchSize {
+			return nil
+		}
+
+		last := batch[len(batch)-1]
+		query, args, err = selectBuilder.
+			Where(sq.Expr("(updated_at, uuid) > (?, ?)", last.UpdatedAt, last.UUID)).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build sql query: %w", err)
+		}
+	}
+}
+
+func (r *Repository) GetAllMaterials(ctx context.Context, offset, limit int, includeArchived bool) (*model.MaterialList, error) {
 	var materials model.MaterialList
-	selectQuery, selectArgs, err := sq.
+	selectBuilder := sq.
 		Select(
 			"uuid",
 			"owner_uuid",
@@ -116,9 +247,17 @@ func (r *Repository) GetAllMaterials(ctx context.Context, offset, limit int) (*m
 			"archived_at",
 			"deleted_at",
 			"likes_count",
+			"forked_from_uuid",
+			"forks_count",
 		).
 		From("materials").
 		Where(sq.Expr("deleted_at IS NULL")).
+		Where(sq.Expr("hidden_at IS NULL"))
+	if !includeArchived {
+		selectBuilder = selectBuilder.Where(sq.Expr("archived_at IS NULL"))
+	}
+
+	selectQuery, selectArgs, err := selectBuilder.
 		OrderBy("created_at DESC").
 		Limit(uint64(limit)).
 		Offset(uint64(offset)).
@@ -142,13 +281,15 @@ func (r *Repository) EditMaterial(ctx context.Context, material *model.EditMater
 		Update("materials").
 		Set("title", material.Title).
 		Set("cover_image_url", material.CoverImageURL).
+		// миниатюры относятся к загруженной обложке, при смене обложки они больше не актуальны
+		Set("cover_thumbnails", sq.Expr("CASE WHEN cover_image_url = ? THEN cover_thumbnails ELSE '[]'::jsonb END", material.CoverImageURL)).
 		Set("description", material.Description).
 		Set("content", material.Content).
 		Set("read_time_minutes", material.ReadTimeMinutes).
 		Set("edited_at", time.Now()).
 		Where(sq.Eq{"uuid": material.UUID}).
 		PlaceholderFormat(sq.Dollar).
-		Suffix("RETURNING uuid, owner_uuid, title, cover_image_url, description, content, read_time_minutes, status, created_at, edited_at, published_at, archived_at, deleted_at, likes_count").
+		Suffix("RETURNING uuid, owner_uuid, title, cover_image_url, description, content, read_time_minutes, status, created_at, edited_at, published_at, archived_at, deleted_at, likes_count, forked_from_uuid, forks_count").
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build update query: %v", err)
@@ -156,6 +297,9 @@ func (r *Repository) EditMaterial(ctx context.Context, material *model.EditMater
 
 	err = r.Chk(ctx).GetContext(ctx, &updatedMaterial, query, args...)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.NotFoundError("material doesn't exist")
+		}
 		return nil, fmt.Errorf("failed to update material: %v", err)
 	}
 
@@ -178,7 +322,7 @@ func (r *Repository) GetMaterialOwnerUUID(ctx context.Context, uuid string) (str
 	err = r.Chk(ctx).GetContext(ctx, &ownerUUID, query, args...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return "", errors.New("material doesn't exist")
+			return "", model.NotFoundError("material doesn't exist")
 		}
 		return "", fmt.Errorf("failed to get owner uuid: %v", err)
 	}
@@ -219,7 +363,7 @@ func (r *Repository) PublishMaterial(ctx context.Context, uuid string) (*model.M
 		Set("published_at", time.Now()).
 		Where(sq.Eq{"uuid": uuid}).
 		PlaceholderFormat(sq.Dollar).
-		Suffix("RETURNING uuid, owner_uuid, title, cover_image_url, description, content, read_time_minutes, status, created_at, edited_at, published_at, archived_at, deleted_at, likes_count").
+		Suffix("RETURNING uuid, owner_uuid, title, cover_image_url, description, content, read_time_minutes, status, created_at, edited_at, published_at, archived_at, deleted_at, likes_count, forked_from_uuid, forks_count").
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build update query: %v", err)
@@ -227,6 +371,9 @@ func (r *Repository) PublishMaterial(ctx context.Context, uuid string) (*model.M
 
 	err = r.Chk(ctx).GetContext(ctx, &updatedMaterial, query, args...)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.NotFoundError("material doesn't exist")
+		}
 		return nil, fmt.Errorf("failed to execute update query: %v", err)
 	}
 
@@ -263,6 +410,8 @@ func (r *Repository) MaterialExists(ctx context.Context, materialUUID string) (b
 func (r *Repository) ArchivedMaterial(ctx context.Context, uuid string) (int64, error) {
 	query, args, err := sq.
 		Update("materials").
+		Set("status_before_archive", sq.Expr("status")).
+		Set("status", "archived").
 		Set("archived_at", time.Now()).
 		Where(sq.Eq{"uuid": uuid, "archived_at": nil}).
 		PlaceholderFormat(sq.Dollar).
@@ -284,153 +433,1609 @@ func (r *Repository) ArchivedMaterial(ctx context.Context, uuid string) (int64,
 	return rowsAffected, nil
 }
 
-func (r *Repository) CheckLike(ctx context.Context, materialUUID string, userUUID string) (bool, error) {
-	var exists bool
-
-	query, _, err := sq.
-		Select("EXISTS (SELECT 1 FROM material_likes WHERE material_uuid = ? AND user_uuid = ?)").
+func (r *Repository) UnarchiveMaterial(ctx context.Context, uuid string) (int64, error) {
+	query, args, err := sq.
+		Update("materials").
+		Set("status", sq.Expr("COALESCE(status_before_archive, status)")).
+		Set("status_before_archive", nil).
+		Set("archived_at", nil).
+		Where(sq.Eq{"uuid": uuid, "deleted_at": nil}).
+		Where(sq.NotEq{"archived_at": nil}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
-		return false, fmt.Errorf("failed to build sql query: %w", err)
+		return 0, fmt.Errorf("failed to build sql query: %v", err)
 	}
 
-	err = r.Chk(ctx).GetContext(ctx, &exists, query, materialUUID, userUUID)
+	res, err := r.Chk(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
-		return false, fmt.Errorf("failed to check like: %w", err)
+		return 0, fmt.Errorf("failed to execute query: %v", err)
 	}
 
-	return exists, nil
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to check rows affected: %v", err)
+	}
+
+	return rowsAffected, nil
 }
 
-func (r *Repository) AddLike(ctx context.Context, materialUUID string, userUUID string) error {
+func (r *Repository) GetArchivedMaterials(ctx context.Context, ownerUUID string, offset, limit int) (*model.MaterialList, error) {
+	var materials model.MaterialList
+
 	query, args, err := sq.
-		Insert("material_likes").
-		Columns("material_uuid", "user_uuid").
-		Values(materialUUID, userUUID).
+		Select(
+			"uuid",
+			"owner_uuid",
+			"title",
+			"cover_image_url",
+			"description",
+			"read_time_minutes",
+			"status",
+			"created_at",
+			"edited_at",
+			"published_at",
+			"archived_at",
+			"deleted_at",
+			"likes_count",
+			"forked_from_uuid",
+			"forks_count",
+		).
+		From("materials").
+		Where(sq.Eq{"owner_uuid": ownerUUID, "deleted_at": nil}).
+		Where(sq.NotEq{"archived_at": nil}).
+		OrderBy("archived_at DESC").
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
-		return fmt.Errorf("failed to build sql query: %w", err)
+		return nil, fmt.Errorf("failed to build select query: %w", err)
 	}
 
-	_, err = r.Chk(ctx).ExecContext(ctx, query, args...)
+	err = r.Chk(ctx).SelectContext(ctx, &materials, query, args...)
 	if err != nil {
-		return fmt.Errorf("failed to add like: %w", err)
+		return nil, fmt.Errorf("failed to fetch archived materials: %w", err)
 	}
 
-	return nil
+	return &materials, nil
+}
+
+// AddReaction ставит реакцию и увеличивает её счётчик одним запросом, для лайка — ещё и likes_count.
+// Повторная постановка не меняет счётчики, Changed в этом случае false.
+func (r *Repository) AddReaction(ctx context.Context, materialUUID, userUUID, reaction string) (*model.ReactionResult, error) {
+	var result model.ReactionResult
+
+	query := `
+		WITH inserted AS (
+			INSERT INTO material_reactions (material_uuid, user_uuid, reaction)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (material_uuid, user_uuid, reaction) DO NOTHING
+			RETURNING material_uuid
+		), updated AS (
+			UPDATE materials
+			SET reaction_counts = jsonb_set(
+					reaction_counts,
+					ARRAY[$3::text],
+					to_jsonb(COALESCE((reaction_counts ->> $3::text)::int, 0) + 1)
+				),
+				likes_count = COALESCE(likes_count, 0) + CASE WHEN $3::text = 'like' THEN 1 ELSE 0 END
+			FROM inserted
+			WHERE materials.uuid = inserted.material_uuid
+			RETURNING materials.likes_count, materials.reaction_counts
+		)
+		SELECT
+			EXISTS (SELECT 1 FROM inserted) AS changed,
+			COALESCE(
+				(SELECT likes_count FROM updated),
+				(SELECT likes_count FROM materials WHERE uuid = $1),
+				0
+			) AS likes_count,
+			COALESCE(
+				(SELECT reaction_counts FROM updated),
+				(SELECT reaction_counts FROM materials WHERE uuid = $1),
+				'{}'::jsonb
+			) AS reaction_counts`
+
+	err := r.Chk(ctx).GetContext(ctx, &result, query, materialUUID, userUUID, reaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add reaction: %w", err)
+	}
+
+	return &result, nil
+}
+
+// RemoveReaction снимает реакцию и уменьшает её счётчик одним запросом, для лайка — ещё и likes_count.
+// Если реакции не было, счётчики не меняются, Changed в этом случае false.
+func (r *Repository) RemoveReaction(ctx context.Context, materialUUID, userUUID, reaction string) (*model.ReactionResult, error) {
+	var result model.ReactionResult
+
+	// обнулённый счётчик удаляем из jsonb, чтобы он совпадал с результатом сверки
+	query := `
+		WITH deleted AS (
+			DELETE FROM material_reactions
+			WHERE material_uuid = $1 AND user_uuid = $2 AND reaction = $3
+			RETURNING material_uuid
+		), updated AS (
+			UPDATE materials
+			SET reaction_counts = CASE
+					WHEN COALESCE((reaction_counts ->> $3::text)::int, 0) <= 1 THEN reaction_counts - $3::text
+					ELSE jsonb_set(reaction_counts, ARRAY[$3::text], to_jsonb((reaction_counts ->> $3::text)::int - 1))
+				END,
+				likes_count = GREATEST(COALESCE(likes_count, 0) - CASE WHEN $3::text = 'like' THEN 1 ELSE 0 END, 0)
+			FROM deleted
+			WHERE materials.uuid = deleted.material_uuid
+			RETURNING materials.likes_count, materials.reaction_counts
+		)
+		SELECT
+			EXISTS (SELECT 1 FROM deleted) AS changed,
+			COALESCE(
+				(SELECT likes_count FROM updated),
+				(SELECT likes_count FROM materials WHERE uuid = $1),
+				0
+			) AS likes_count,
+			COALESCE(
+				(SELECT reaction_counts FROM updated),
+				(SELECT reaction_counts FROM materials WHERE uuid = $1),
+				'{}'::jsonb
+			) AS reaction_counts`
+
+	err := r.Chk(ctx).GetContext(ctx, &result, query, materialUUID, userUUID, reaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove reaction: %w", err)
+	}
+
+	return &result, nil
 }
 
-func (r *Repository) RemoveLike(ctx context.Context, materialUUID string, userUUID string) error {
+func (r *Repository) GetUserReactions(ctx context.Context, materialUUID, userUUID string) ([]string, error) {
+	reactions := []string{}
+
 	query, args, err := sq.
-		Delete("material_likes").
+		Select("reaction").
+		From("material_reactions").
 		Where(sq.Eq{"material_uuid": materialUUID, "user_uuid": userUUID}).
+		OrderBy("created_at", "reaction").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
-		return fmt.Errorf("failed to build sql query: %w", err)
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
 	}
 
-	_, err = r.Chk(ctx).ExecContext(ctx, query, args...)
+	err = r.Chk(ctx).SelectContext(ctx, &reactions, query, args...)
 	if err != nil {
-		return fmt.Errorf("failed to remove like: %w", err)
+		return nil, fmt.Errorf("failed to get user reactions: %w", err)
 	}
 
-	return nil
+	return reactions, nil
 }
 
-func (r *Repository) GetLikesCount(ctx context.Context, materialUUID string) (int32, error) {
-	var likesCount int32
+// LockMaterialsForReconcile блокирует очередную пачку материалов по порядку uuid.
+// Заблокированные конкурентными лайками строки пропускаются и будут проверены в следующий проход.
+func (r *Repository) LockMaterialsForReconcile(ctx context.Context, afterUUID string, limit int) ([]string, error) {
+	var uuids []string
 
 	query, args, err := sq.
-		Select("COUNT(material_uuid)").
-		From("material_likes").
-		Where(sq.Eq{"material_uuid": materialUUID}).
+		Select("uuid").
+		From("materials").
+		Where(sq.Gt{"uuid": afterUUID}).
+		OrderBy("uuid").
+		Limit(uint64(limit)).
+		Suffix("FOR NO KEY UPDATE SKIP LOCKED").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
-		return 0, fmt.Errorf("failed to build sql query: %w", err)
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).SelectContext(ctx, &uuids, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock materials: %w", err)
+	}
+
+	return uuids, nil
+}
+
+// ReconcileLikesCounts пересчитывает likes_count и reaction_counts по material_reactions
+// для заблокированных материалов и возвращает uuid исправленных
+func (r *Repository) ReconcileLikesCounts(ctx context.Context, uuids []string) ([]string, error) {
+	var fixed []string
+
+	perReaction, perReactionArgs, err := sq.
+		Select("material_uuid", "reaction", "COUNT(*)::int AS cnt").
+		From("material_reactions").
+		Where(sq.Eq{"material_uuid": uuids}).
+		GroupBy("material_uuid", "reaction").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	actual := sq.
+		Select(
+			"m2.uuid",
+			fmt.Sprintf("COALESCE(SUM(rc.cnt) FILTER (WHERE rc.reaction = '%s'), 0)::int AS likes_count", model.ReactionLike),
+			"COALESCE(jsonb_object_agg(rc.reaction, rc.cnt) FILTER (WHERE rc.reaction IS NOT NULL), '{}'::jsonb) AS reaction_counts",
+		).
+		From("materials m2").
+		LeftJoin("("+perReaction+") rc ON rc.material_uuid = m2.uuid", perReactionArgs...).
+		Where(sq.Eq{"m2.uuid": uuids}).
+		GroupBy("m2.uuid")
+
+	query, args, err := sq.
+		Update("materials m").
+		Set("likes_count", sq.Expr("actual.likes_count")).
+		Set("reaction_counts", sq.Expr("actual.reaction_counts")).
+		FromSelect(actual, "actual").
+		Where("m.uuid = actual.uuid").
+		Where("(m.likes_count IS DISTINCT FROM actual.likes_count OR m.reaction_counts IS DISTINCT FROM actual.reaction_counts)").
+		Suffix("RETURNING m.uuid").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).SelectContext(ctx, &fixed, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconcile likes counts: %w", err)
+	}
+
+	return fixed, nil
+}
+
+func (r *Repository) GetMaterialLikers(ctx context.Context, materialUUID string, offset, limit int) (*model.MaterialLikerList, error) {
+	var likers model.MaterialLikerList
+
+	query, args, err := sq.
+		Select(
+			"u.uuid",
+			"u.nickname",
+			"u.avatar_link",
+			"COALESCE(u.name, '') AS name",
+			"COALESCE(u.surname, '') AS surname",
+			"ml.created_at AS liked_at",
+		).
+		From("material_reactions ml").
+		Join("users u ON u.uuid = ml.user_uuid").
+		Where(sq.Eq{"ml.material_uuid": materialUUID, "ml.reaction": model.ReactionLike}).
+		OrderBy("ml.created_at DESC", "u.uuid").
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).SelectContext(ctx, &likers, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch material likers: %w", err)
+	}
+
+	return &likers, nil
+}
+
+func (r *Repository) DuplicateMaterial(ctx context.Context, sourceUUID, ownerUUID string) (*model.Material, error) {
+	var material model.Material
+
+	query, args, err := sq.
+		Insert("materials").
+		Columns("owner_uuid", "title", "cover_image_url", "cover_thumbnails", "description", "content", "read_time_minutes", "forked_from_uuid").
+		Select(
+			sq.Select().
+				Column(sq.Expr("?::uuid", ownerUUID)).
+				Columns("title", "cover_image_url", "cover_thumbnails", "description", "content", "read_time_minutes", "uuid").
+				From("materials").
+				Where(sq.Eq{"uuid": sourceUUID}),
+		).
+		Suffix("RETURNING uuid, owner_uuid, title, cover_image_url, description, content, read_time_minutes, status, created_at, edited_at, published_at, archived_at, deleted_at, likes_count, forked_from_uuid, forks_count").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
 	}
 
-	err = r.Chk(ctx).GetContext(ctx, &likesCount, query, args...)
+	err = r.Chk(ctx).GetContext(ctx, &material, query, args...)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get likes count: %w", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.NotFoundError("material doesn't exist")
+		}
+		return nil, fmt.Errorf("failed to duplicate material: %w", err)
 	}
 
-	return likesCount, nil
+	return &material, nil
 }
 
-func (r *Repository) UpdateLikesCount(ctx context.Context, materialUUID string, likesCount int32) error {
+func (r *Repository) IncrementForksCount(ctx context.Context, materialUUID string) error {
 	query, args, err := sq.
 		Update("materials").
-		Set("likes_count", likesCount).
+		Set("forks_count", sq.Expr("forks_count + 1")).
 		Where(sq.Eq{"uuid": materialUUID}).
-		Suffix("RETURNING likes_count").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("failed to build sql query: %w", err)
 	}
 
-	var updatedLikesCount int32
-	err = r.Chk(ctx).GetContext(ctx, &updatedLikesCount, query, args...)
+	_, err = r.Chk(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("failed to update likes count: %w", err)
+		return fmt.Errorf("failed to increment forks count: %w", err)
 	}
 
 	return nil
 }
 
-func (r *Repository) UpdateUserNickname(ctx context.Context, userUUID, newNickname string) error {
-	query, args, err := sq.Update("users").
-		Set("nickname", newNickname).
-		Where(sq.Eq{"uuid": userUUID}).
+func (r *Repository) GetDeletedMaterials(ctx context.Context, ownerUUID string, deletedAfter time.Time, offset, limit int) (*model.MaterialList, error) {
+	var materials model.MaterialList
+
+	query, args, err := sq.
+		Select(
+			"uuid",
+			"owner_uuid",
+			"title",
+			"cover_image_url",
+			"description",
+			"read_time_minutes",
+			"status",
+			"created_at",
+			"edited_at",
+			"published_at",
+			"archived_at",
+			"deleted_at",
+			"likes_count",
+			"forked_from_uuid",
+			"forks_count",
+		).
+		From("materials").
+		Where(sq.Eq{"owner_uuid": ownerUUID}).
+		Where(sq.Gt{"deleted_at": deletedAfter}).
+		OrderBy("deleted_at DESC").
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
-		return fmt.Errorf("failed to build sql query: %v", err)
+		return nil, fmt.Errorf("failed to build select query: %w", err)
 	}
 
-	_, err = r.Chk(ctx).ExecContext(ctx, query, args...)
+	err = r.Chk(ctx).SelectContext(ctx, &materials, query, args...)
 	if err != nil {
-		return fmt.Errorf("failed to update user nickname: %v", err)
+		return nil, fmt.Errorf("failed to fetch deleted materials: %w", err)
 	}
 
-	return nil
+	return &materials, nil
 }
 
-func (r *Repository) AvatarLinkUpdate(ctx context.Context, userUUID, avatarLink string) error {
-	query, args, err := sq.Update("users").
-		Where(sq.Eq{"uuid": userUUID}).
-		Set("avatar_link", avatarLink).
+func (r *Repository) RestoreMaterial(ctx context.Context, uuid string, deletedAfter time.Time) (int64, error) {
+	query, args, err := sq.
+		Update("materials").
+		Set("deleted_at", nil).
+		Where(sq.Eq{"uuid": uuid}).
+		Where(sq.Gt{"deleted_at": deletedAfter}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
-		return fmt.Errorf("failed to build sql query: %v", err)
+		return 0, fmt.Errorf("failed to build sql query: %v", err)
 	}
 
-	_, err = r.Chk(ctx).ExecContext(ctx, query, args...)
+	res, err := r.Chk(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("failed to update avatar link: %v", err)
+		return 0, fmt.Errorf("failed to execute query: %v", err)
 	}
 
-	return nil
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to check rows affected: %v", err)
+	}
+
+	return rowsAffected, nil
+}
+
+func (r *Repository) GetExpiredDeletedMaterials(ctx context.Context, deletedBefore time.Time, limit int) ([]string, error) {
+	var uuids []string
+
+	query, args, err := sq.
+		Select("uuid").
+		From("materials").
+		Where(sq.Lt{"deleted_at": deletedBefore}).
+		OrderBy("deleted_at").
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).SelectContext(ctx, &uuids, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch expired materials: %w", err)
+	}
+
+	return uuids, nil
 }
 
-func (r *Repository) CreateUser(ctx context.Context, user model.User) error {
-	query, args, err := sq.Insert("users").
-		Columns("uuid", "nickname", "avatar_link", "name", "surname").
-		Values(user.Uuid, user.Nickname, user.AvatarLink, user.Name, user.Surname).
+func (r *Repository) PurgeMaterials(ctx context.Context, uuids []string) error {
+	likesQuery, likesArgs, err := sq.
+		Delete("material_reactions").
+		Where(sq.Eq{"material_uuid": uuids}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
-		return fmt.Errorf("failed to build sql query: %w", err)
+		return fmt.Errorf("failed to build likes delete query: %w", err)
 	}
 
-	_, err = r.Chk(ctx).ExecContext(ctx, query, args...)
+	tagsQuery, tagsArgs, err := sq.
+		Delete("material_tags").
+		Where(sq.Eq{"material_uuid": uuids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build tags delete query: %w", err)
+	}
+
+	forksQuery, forksArgs, err := sq.
+		Update("materials").
+		Set("forked_from_uuid", nil).
+		Where(sq.Eq{"forked_from_uuid": uuids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build forks update query: %w", err)
+	}
+
+	attachmentsQuery, attachmentsArgs, err := sq.
+		Delete("material_attachments").
+		Where(sq.Eq{"material_uuid": uuids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build attachments delete query: %w", err)
+	}
+
+	materialsQuery, materialsArgs, err := sq.
+		Delete("materials").
+		Where(sq.Eq{"uuid": uuids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build materials delete query: %w", err)
+	}
+
+	if _, err = r.Chk(ctx).ExecContext(ctx, likesQuery, likesArgs...); err != nil {
+		return fmt.Errorf("failed to delete likes: %w", err)
+	}
+
+	if _, err = r.Chk(ctx).ExecContext(ctx, tagsQuery, tagsArgs...); err != nil {
+		return fmt.Errorf("failed to delete tags: %w", err)
+	}
+
+	if _, err = r.Chk(ctx).ExecContext(ctx, forksQuery, forksArgs...); err != nil {
+		return fmt.Errorf("failed to unlink forks: %w", err)
+	}
+
+	if _, err = r.Chk(ctx).ExecContext(ctx, attachmentsQuery, attachmentsArgs...); err != nil {
+		return fmt.Errorf("failed to delete attachments: %w", err)
+	}
+
+	if _, err = r.Chk(ctx).ExecContext(ctx, materialsQuery, materialsArgs...); err != nil {
+		return fmt.Errorf("failed to delete materials: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetMaterialsStateForUpdate(ctx context.Context, uuids []string) ([]model.MaterialState, error) {
+	var states []model.MaterialState
+
+	query, args, err := sq.
+		Select("uuid", "owner_uuid", "status", "archived_at", "deleted_at").
+		From("materials").
+		Where(sq.Eq{"uuid": uuids}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).SelectContext(ctx, &states, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch materials state: %w", err)
+	}
+
+	return states, nil
+}
+
+func (r *Repository) BulkDeleteMaterials(ctx context.Context, uuids []string) error {
+	query, args, err := sq.
+		Update("materials").
+		Set("deleted_at", time.Now()).
+		Where(sq.Eq{"uuid": uuids, "deleted_at": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	if _, err = r.Chk(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to delete materials: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) BulkArchiveMaterials(ctx context.Context, uuids []string) error {
+	query, args, err := sq.
+		Update("materials").
+		Set("status_before_archive", sq.Expr("status")).
+		Set("status", "archived").
+		Set("archived_at", time.Now()).
+		Where(sq.Eq{"uuid": uuids, "archived_at": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	if _, err = r.Chk(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to archive materials: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) BulkPublishMaterials(ctx context.Context, uuids []string) error {
+	query, args, err := sq.
+		Update("materials").
+		Set("status", "published").
+		Set("published_at", time.Now()).
+		Where(sq.Eq{"uuid": uuids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	if _, err = r.Chk(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to publish materials: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) AddMaterialsTags(ctx context.Context, uuids []string, tags []string) error {
+	builder := sq.
+		Insert("material_tags").
+		Columns("material_uuid", "tag")
+	for _, materialUUID := range uuids {
+		for _, tag := range tags {
+			builder = builder.Values(materialUUID, tag)
+		}
+	}
+
+	query, args, err := builder.
+		Suffix("ON CONFLICT (material_uuid, tag) DO NOTHING").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	if _, err = r.Chk(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to add tags: %w", err)
+	}
+
+	return nil
+}
+
+// GetLikeScores возвращает сумму лайков за окно с экспоненциальным затуханием по возрасту лайка
+// для опубликованных материалов, не находящихся в архиве или корзине
+func (r *Repository) GetLikeScores(ctx context.Context, window, halfLife time.Duration) ([]model.TrendingScore, error) {
+	var scores []model.TrendingScore
+
+	query, args, err := sq.
+		Select("mr.material_uuid AS uuid").
+		Column(sq.Expr("SUM(power(0.5, EXTRACT(EPOCH FROM (LOCALTIMESTAMP - mr.created_at)) / ?)) AS score", halfLife.Seconds())).
+		From("material_reactions mr").
+		Join("materials m ON m.uuid = mr.material_uuid").
+		Where(sq.Eq{"mr.reaction": model.ReactionLike, "m.status": "published"}).
+		Where(sq.Expr("mr.created_at >= LOCALTIMESTAMP - make_interval(secs => ?)", window.Seconds())).
+		Where(sq.Expr("m.deleted_at IS NULL")).
+		Where(sq.Expr("m.archived_at IS NULL")).
+		Where(sq.Expr("m.hidden_at IS NULL")).
+		GroupBy("mr.material_uuid").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).SelectContext(ctx, &scores, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get like scores: %w", err)
+	}
+
+	return scores, nil
+}
+
+// FilterPublishedMaterials оставляет из списка только опубликованные материалы вне архива и корзины
+func (r *Repository) FilterPublishedMaterials(ctx context.Context, uuids []string) ([]string, error) {
+	var published []string
+
+	query, args, err := sq.
+		Select("uuid").
+		From("materials").
+		Where(sq.Eq{"uuid": uuids, "status": "published"}).
+		Where(sq.Expr("deleted_at IS NULL")).
+		Where(sq.Expr("archived_at IS NULL")).
+		Where(sq.Expr("hidden_at IS NULL")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).SelectContext(ctx, &published, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter published materials: %w", err)
+	}
+
+	return published, nil
+}
+
+func (r *Repository) GetMaterialsTags(ctx context.Context, uuids []string) ([]model.MaterialTag, error) {
+	var tags []model.MaterialTag
+
+	query, args, err := sq.
+		Select("material_uuid", "tag").
+		From("material_tags").
+		Where(sq.Eq{"material_uuid": uuids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).SelectContext(ctx, &tags, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get materials tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// GetPublishedMaterialsByUUIDs возвращает опубликованные материалы вне архива и корзины, порядок не гарантируется
+func (r *Repository) GetPublishedMaterialsByUUIDs(ctx context.Context, uuids []string) (*model.MaterialList, error) {
+	var materials model.MaterialList
+
+	query, args, err := sq.
+		Select(
+			"uuid",
+			"owner_uuid",
+			"title",
+			"cover_image_url",
+			"description",
+			"read_time_minutes",
+			"status",
+			"created_at",
+			"edited_at",
+			"published_at",
+			"archived_at",
+			"deleted_at",
+			"likes_count",
+			"forked_from_uuid",
+			"forks_count",
+			"reaction_counts",
+		).
+		From("materials").
+		Where(sq.Eq{"uuid": uuids, "status": "published"}).
+		Where(sq.Expr("deleted_at IS NULL")).
+		Where(sq.Expr("archived_at IS NULL")).
+		Where(sq.Expr("hidden_at IS NULL")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).SelectContext(ctx, &materials, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch materials: %w", err)
+	}
+
+	return &materials, nil
+}
+
+func (r *Repository) GetRelatedCandidates(ctx context.Context) ([]model.RelatedCandidate, error) {
+	var candidates []model.RelatedCandidate
+
+	query, args, err := sq.
+		Select("uuid", "title", "COALESCE(description, '') AS description", "COALESCE(likes_count, 0) AS likes_count").
+		From("materials").
+		Where(sq.Eq{"status": "published"}).
+		Where(sq.Expr("deleted_at IS NULL")).
+		Where(sq.Expr("archived_at IS NULL")).
+		Where(sq.Expr("hidden_at IS NULL")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).SelectContext(ctx, &candidates, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get related candidates: %w", err)
+	}
+
+	return candidates, nil
+}
+
+// GetLikeCooccurrences возвращает для каждого опубликованного материала не более limit материалов
+// с наибольшим числом общих лайкнувших
+func (r *Repository) GetLikeCooccurrences(ctx context.Context, limit int) ([]model.LikeCooccurrence, error) {
+	var pairs []model.LikeCooccurrence
+
+	ranked := sq.
+		Select(
+			"a.material_uuid",
+			"b.material_uuid AS related_uuid",
+			"COUNT(*) AS count",
+			"ROW_NUMBER() OVER (PARTITION BY a.material_uuid ORDER BY COUNT(*) DESC, b.material_uuid) AS rn",
+		).
+		From("material_reactions a").
+		Join("material_reactions b ON b.user_uuid = a.user_uuid AND b.material_uuid <> a.material_uuid AND b.reaction = a.reaction").
+		Join("materials ma ON ma.uuid = a.material_uuid").
+		Join("materials mb ON mb.uuid = b.material_uuid").
+		Where(sq.Eq{"a.reaction": model.ReactionLike, "ma.status": "published", "mb.status": "published"}).
+		Where(sq.Expr("ma.deleted_at IS NULL AND ma.archived_at IS NULL AND ma.hidden_at IS NULL")).
+		Where(sq.Expr("mb.deleted_at IS NULL AND mb.archived_at IS NULL AND mb.hidden_at IS NULL")).
+		GroupBy("a.material_uuid", "b.material_uuid")
+
+	query, args, err := sq.
+		Select("material_uuid", "related_uuid", "count").
+		FromSelect(ranked, "ranked").
+		Where(sq.LtOrEq{"rn": limit}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).SelectContext(ctx, &pairs, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get like co-occurrences: %w", err)
+	}
+
+	return pairs, nil
+}
+
+// ReplaceRelatedMaterials полностью заменяет содержимое related_materials, вызывается внутри транзакции.
+// Пары с материалами, удалёнными после расчёта, пропускаются, чтобы они не срывали всё обновление
+func (r *Repository) ReplaceRelatedMaterials(ctx context.Context, related []model.RelatedMaterial) error {
+	if _, err := r.Chk(ctx).ExecContext(ctx, "DELETE FROM related_materials"); err != nil {
+		return fmt.Errorf("failed to clear related materials: %w", err)
+	}
+
+	for start := 0; start < len(related); start += relatedInsertBatchSize {
+		end := min(start+relatedInsertBatchSize, len(related))
+
+		materialUUIDs := make([]string, 0, end-start)
+		relatedUUIDs := make([]string, 0, end-start)
+		scores := make([]float64, 0, end-start)
+		for _, item := range related[start:end] {
+			materialUUIDs = append(materialUUIDs, item.MaterialUUID)
+			relatedUUIDs = append(relatedUUIDs, item.RelatedUUID)
+			scores = append(scores, item.Score)
+		}
+
+		existing := sq.
+			Select("v.material_uuid", "v.related_uuid", "v.score").
+			From("materials ma").
+			Join(
+				"unnest(?::uuid[], ?::uuid[], ?::double precision[]) AS v(material_uuid, related_uuid, score) ON v.material_uuid = ma.uuid",
+				pq.Array(materialUUIDs), pq.Array(relatedUUIDs), pq.Array(scores),
+			).
+			Join("materials mb ON mb.uuid = v.related_uuid")
+
+		query, args, err := sq.
+			Insert("related_materials").
+			Columns("material_uuid", "related_uuid", "score").
+			Select(existing).
+			Suffix("ON CONFLICT (material_uuid, related_uuid) DO NOTHING").
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build sql query: %w", err)
+		}
+
+		if _, err = r.Chk(ctx).ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("failed to insert related materials: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetRelatedMaterials возвращает похожие материалы по убыванию score, исключая материалы excludeOwnerUUID
+// и те, что после расчёта попали в архив или корзину
+func (r *Repository) GetRelatedMaterials(ctx context.Context, materialUUID, excludeOwnerUUID string, offset, limit int) (*model.MaterialList, error) {
+	var materials model.MaterialList
+
+	builder := sq.
+		Select(
+			"m.uuid",
+			"m.owner_uuid",
+			"m.title",
+			"m.cover_image_url",
+			"m.description",
+			"m.read_time_minutes",
+			"m.status",
+			"m.created_at",
+			"m.edited_at",
+			"m.published_at",
+			"m.archived_at",
+			"m.deleted_at",
+			"m.likes_count",
+			"m.forked_from_uuid",
+			"m.forks_count",
+			"m.reaction_counts",
+		).
+		From("related_materials rm").
+		Join("materials m ON m.uuid = rm.related_uuid").
+		Where(sq.Eq{"rm.material_uuid": materialUUID, "m.status": "published"}).
+		Where(sq.Expr("m.deleted_at IS NULL")).
+		Where(sq.Expr("m.archived_at IS NULL")).
+		Where(sq.Expr("m.hidden_at IS NULL"))
+	if excludeOwnerUUID != "" {
+		builder = builder.Where(sq.NotEq{"m.owner_uuid": excludeOwnerUUID})
+	}
+
+	query, args, err := builder.
+		OrderBy("rm.score DESC", "m.uuid").
+		Offset(uint64(offset)).
+		Limit(uint64(limit)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).SelectContext(ctx, &materials, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch related materials: %w", err)
+	}
+
+	return &materials, nil
+}
+
+// CreateMaterialReport сохраняет жалобу, если у пользователя нет открытой жалобы на этот материал,
+// и возвращает число открытых жалоб с учётом новой
+func (r *Repository) CreateMaterialReport(ctx context.Context, materialUUID, reporterUUID, reason, comment string) (*model.ReportResult, error) {
+	var result model.ReportResult
+
+	query := `
+		WITH inserted AS (
+			INSERT INTO material_reports (material_uuid, reporter_uuid, reason, comment)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (material_uuid, reporter_uuid) WHERE status = 'open' DO NOTHING
+			RETURNING uuid
+		)
+		SELECT
+			(SELECT uuid FROM inserted) AS uuid,
+			(SELECT COUNT(*) FROM material_reports WHERE material_uuid = $1 AND status = 'open')
+				+ (SELECT COUNT(*) FROM inserted) AS open_reports`
+
+	err := r.Chk(ctx).GetContext(ctx, &result, query, materialUUID, reporterUUID, reason, comment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create material report: %w", err)
+	}
+
+	return &result, nil
+}
+
+// HideReportedMaterial скрывает материал, если число открытых жалоб на него достигло threshold.
+// Условие проверяется в том же запросе, поэтому при конкурентных жалобах материал скрывается один раз.
+func (r *Repository) HideReportedMaterial(ctx context.Context, materialUUID string, threshold int) (bool, error) {
+	query, args, err := sq.
+		Update("materials").
+		Set("hidden_at", time.Now()).
+		Where(sq.Eq{"uuid": materialUUID, "hidden_at": nil}).
+		Where(sq.Expr("(SELECT COUNT(*) FROM material_reports WHERE material_uuid = ? AND status = ?) >= ?",
+			materialUUID, model.ReportStatusOpen, threshold)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	res, err := r.Chk(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to hide reported material: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *Repository) GetOpenMaterialReports(ctx context.Context, materialUUID string, offset, limit int) (*model.MaterialReportList, error) {
+	var reports model.MaterialReportList
+
+	builder := sq.
+		Select(
+			"uuid",
+			"material_uuid",
+			"reporter_uuid",
+			"reason",
+			"comment",
+			"status",
+			"created_at",
+			"resolved_at",
+			"resolved_by",
+		).
+		From("material_reports").
+		Where(sq.Eq{"status": model.ReportStatusOpen})
+	if materialUUID != "" {
+		builder = builder.Where(sq.Eq{"material_uuid": materialUUID})
+	}
+
+	query, args, err := builder.
+		OrderBy("created_at", "uuid").
+		Offset(uint64(offset)).
+		Limit(uint64(limit)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).SelectContext(ctx, &reports, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open reports: %w", err)
+	}
+
+	return &reports, nil
+}
+
+// ResolveMaterialReport закрывает открытую жалобу. Если жалоба не найдена или уже закрыта, возвращает nil
+func (r *Repository) ResolveMaterialReport(ctx context.Context, reportUUID, moderatorUUID, resolution string) (*model.MaterialReport, error) {
+	var report model.MaterialReport
+
+	query, args, err := sq.
+		Update("material_reports").
+		Set("status", resolution).
+		Set("resolved_at", time.Now()).
+		Set("resolved_by", moderatorUUID).
+		Where(sq.Eq{"uuid": reportUUID, "status": model.ReportStatusOpen}).
+		Suffix("RETURNING uuid, material_uuid, reporter_uuid, reason, comment, status, created_at, resolved_at, resolved_by").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).GetContext(ctx, &report, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to resolve report: %w", err)
+	}
+
+	return &report, nil
+}
+
+// ResolveOpenMaterialReports закрывает все открытые жалобы на материал и возвращает их количество
+func (r *Repository) ResolveOpenMaterialReports(ctx context.Context, materialUUID, moderatorUUID, resolution string) (int64, error) {
+	query, args, err := sq.
+		Update("material_reports").
+		Set("status", resolution).
+		Set("resolved_at", time.Now()).
+		Set("resolved_by", moderatorUUID).
+		Where(sq.Eq{"material_uuid": materialUUID, "status": model.ReportStatusOpen}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	res, err := r.Chk(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to resolve reports: %w", err)
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *Repository) HideMaterial(ctx context.Context, uuid string) (int64, error) {
+	query, args, err := sq.
+		Update("materials").
+		Set("hidden_at", time.Now()).
+		Where(sq.Eq{"uuid": uuid, "hidden_at": nil, "deleted_at": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	res, err := r.Chk(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to hide material: %w", err)
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *Repository) UnhideMaterial(ctx context.Context, uuid string) (int64, error) {
+	query, args, err := sq.
+		Update("materials").
+		Set("hidden_at", nil).
+		Where(sq.Eq{"uuid": uuid}).
+		Where(sq.NotEq{"hidden_at": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	res, err := r.Chk(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to unhide material: %w", err)
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *Repository) CreateCoverUpload(ctx context.Context, upload *model.CoverUpload) (string, error) {
+	var uuid string
+
+	query, args, err := sq.
+		Insert("cover_uploads").
+		Columns("owner_uuid", "material_uuid", "url", "storage_keys").
+		Values(upload.OwnerUUID, upload.MaterialUUID, upload.URL, upload.StorageKeys).
+		Suffix("RETURNING uuid").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).GetContext(ctx, &uuid, query, args...)
+	if err != nil {
+		return "", fmt.Errorf("failed to create cover upload: %w", err)
+	}
+
+	return uuid, nil
+}
+
+func (r *Repository) SetMaterialCover(ctx context.Context, materialUUID string, cover *model.Cover) (int64, error) {
+	query, args, err := sq.
+		Update("materials").
+		Set("cover_image_url", cover.URL).
+		Set("cover_thumbnails", cover.Thumbnails).
+		Set("edited_at", time.Now()).
+		Where(sq.Eq{"uuid": materialUUID, "deleted_at": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	res, err := r.Chk(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to set material cover: %w", err)
+	}
+
+	return res.RowsAffected()
+}
+
+// GetUnreferencedCoverUploads блокирует загрузки старше createdBefore, на которые не ссылается ни один материал
+func (r *Repository) GetUnreferencedCoverUploads(ctx context.Context, createdBefore time.Time, limit int) ([]model.CoverUpload, error) {
+	query, args, err := sq.
+		Select("u.uuid", "u.owner_uuid", "COALESCE(u.material_uuid::text, '') AS material_uuid", "u.url", "u.storage_keys").
+		From("cover_uploads u").
+		Where(sq.Lt{"u.created_at": createdBefore}).
+		Where("NOT EXISTS (SELECT 1 FROM materials m WHERE m.cover_image_url = u.url)").
+		OrderBy("u.created_at").
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE OF u SKIP LOCKED").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	var uploads []model.CoverUpload
+	err = r.Chk(ctx).SelectContext(ctx, &uploads, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch unreferenced cover uploads: %w", err)
+	}
+
+	return uploads, nil
+}
+
+func (r *Repository) DeleteCoverUploads(ctx context.Context, uuids []string) error {
+	query, args, err := sq.
+		Delete("cover_uploads").
+		Where(sq.Eq{"uuid": uuids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	if _, err = r.Chk(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to delete cover uploads: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) CreateMaterialAttachment(ctx context.Context, attachment *model.MaterialAttachment) (*model.MaterialAttachment, error) {
+	var created model.MaterialAttachment
+
+	query, args, err := sq.
+		Insert("material_attachments").
+		Columns("uuid", "material_uuid", "owner_uuid", "filename", "content_type", "size_bytes", "storage_key").
+		Values(attachment.UUID, attachment.MaterialUUID, attachment.OwnerUUID, attachment.Filename,
+			attachment.ContentType, attachment.SizeBytes, attachment.StorageKey).
+		Suffix("RETURNING uuid, material_uuid, owner_uuid, filename, content_type, size_bytes, storage_key, created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).GetContext(ctx, &created, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create attachment: %w", err)
+	}
+
+	return &created, nil
+}
+
+func (r *Repository) GetMaterialAttachments(ctx context.Context, materialUUID string) (model.MaterialAttachmentList, error) {
+	var attachments model.MaterialAttachmentList
+
+	query, args, err := sq.
+		Select("uuid", "material_uuid", "owner_uuid", "filename", "content_type", "size_bytes", "storage_key", "created_at").
+		From("material_attachments").
+		Where(sq.Eq{"material_uuid": materialUUID}).
+		OrderBy("created_at", "uuid").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).SelectContext(ctx, &attachments, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch attachments: %w", err)
+	}
+
+	return attachments, nil
+}
+
+func (r *Repository) GetMaterialAttachment(ctx context.Context, materialUUID, attachmentUUID string) (*model.MaterialAttachment, error) {
+	var attachment model.MaterialAttachment
+
+	query, args, err := sq.
+		Select("uuid", "material_uuid", "owner_uuid", "filename", "content_type", "size_bytes", "storage_key", "created_at").
+		From("material_attachments").
+		Where(sq.Eq{"uuid": attachmentUUID, "material_uuid": materialUUID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).GetContext(ctx, &attachment, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get attachment: %w", err)
+	}
+
+	return &attachment, nil
+}
+
+func (r *Repository) DeleteMaterialAttachment(ctx context.Context, materialUUID, attachmentUUID string) (int64, error) {
+	query, args, err := sq.
+		Delete("material_attachments").
+		Where(sq.Eq{"uuid": attachmentUUID, "material_uuid": materialUUID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	res, err := r.Chk(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete attachment: %w", err)
+	}
+
+	return res.RowsAffected()
+}
+
+// GetAttachmentStorageKeys возвращает ключи файлов всех вложений материалов, нужны перед их окончательным удалением
+func (r *Repository) GetAttachmentStorageKeys(ctx context.Context, materialUUIDs []string) ([]string, error) {
+	var keys []string
+
+	query, args, err := sq.
+		Select("storage_key").
+		From("material_attachments").
+		Where(sq.Eq{"material_uuid": materialUUIDs}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).SelectContext(ctx, &keys, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch attachment storage keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+// EnqueueBlobDeletions ставит файлы в очередь на удаление, ключи уже в очереди пропускаются
+func (r *Repository) EnqueueBlobDeletions(ctx context.Context, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	insert := sq.Insert("blob_deletions").Columns("storage_key")
+	for _, key := range keys {
+		insert = insert.Values(key)
+	}
+
+	query, args, err := insert.
+		Suffix("ON CONFLICT (storage_key) DO NOTHING").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	if _, err = r.Chk(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to enqueue blob deletions: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetBlobDeletions(ctx context.Context, limit int) ([]string, error) {
+	var keys []string
+
+	query, args, err := sq.
+		Select("storage_key").
+		From("blob_deletions").
+		OrderBy("created_at").
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	if err = r.Chk(ctx).SelectContext(ctx, &keys, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to fetch blob deletions: %w", err)
+	}
+
+	return keys, nil
+}
+
+func (r *Repository) DeleteBlobDeletions(ctx context.Context, keys []string) error {
+	query, args, err := sq.
+		Delete("blob_deletions").
+		Where(sq.Eq{"storage_key": keys}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	if _, err = r.Chk(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to delete blob deletions: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) AvatarLinkUpdate(ctx context.Context, userUUID, avatarLink string) error {
+	query, args, err := sq.Update("users").
+		Where(sq.Eq{"uuid": userUUID}).
+		Set("avatar_link", avatarLink).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %v", err)
+	}
+
+	_, err = r.Chk(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update avatar link: %v", err)
+	}
+
+	return nil
+}
+
+// UpsertUser создаёт зеркало профиля по событию создания. Если событие изменения профиля пришло раньше
+// и строка уже есть, событие создания только заполняет пустые поля: его данные старше.
+// Помеченный удалённым пользователь не восстанавливается.
+func (r *Repository) UpsertUser(ctx context.Context, user model.User) error {
+	query := `
+		INSERT INTO users (uuid, nickname, avatar_link, name, surname)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (uuid) DO UPDATE
+		SET nickname    = CASE WHEN users.nickname = '' THEN EXCLUDED.nickname ELSE users.nickname END,
+			avatar_link = CASE WHEN users.avatar_link = '' THEN EXCLUDED.avatar_link ELSE users.avatar_link END,
+			name        = COALESCE(NULLIF(users.name, ''), EXCLUDED.name),
+			surname     = COALESCE(NULLIF(users.surname, ''), EXCLUDED.surname)
+		WHERE users.deleted_at IS NULL`
+
+	_, err := r.Chk(ctx).ExecContext(ctx, query, user.Uuid, user.Nickname, user.AvatarLink, user.Name, user.Surname)
+	if err != nil {
+		return fmt.Errorf("failed to upsert user: %w", err)
+	}
+
+	return nil
+}
+
+// UpsertUserProfile применяет изменение профиля. Если события создания ещё не было, строка создаётся
+// с пустыми остальными полями, их заполнит событие создания. Помеченный удалённым пользователь не меняется.
+func (r *Repository) UpsertUserProfile(ctx context.Context, profile *model.UserProfileUpdate) error {
+	query := `
+		INSERT INTO users (uuid, nickname, avatar_link, name, surname, updated_at)
+		VALUES ($1, COALESCE($2::text, ''), COALESCE($3::text, ''), $4::text, $5::text, CURRENT_TIMESTAMP)
+		ON CONFLICT (uuid) DO UPDATE
+		SET nickname    = COALESCE($2::text, users.nickname),
+			avatar_link = COALESCE($3::text, users.avatar_link),
+			name        = COALESCE($4::text, users.name),
+			surname     = COALESCE($5::text, users.surname),
+			updated_at  = CURRENT_TIMESTAMP
+		WHERE users.deleted_at IS NULL`
+
+	_, err := r.Chk(ctx).ExecContext(ctx, query, profile.Uuid, profile.Nickname, profile.AvatarLink, profile.Name, profile.Surname)
+	if err != nil {
+		return fmt.Errorf("failed to upsert user profile: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetUser(ctx context.Context, userUUID string) (*model.User, error) {
+	var user model.User
+
+	query, args, err := sq.
+		Select("uuid", "nickname", "avatar_link", "COALESCE(name, '') AS name", "COALESCE(surname, '') AS surname").
+		From("users").
+		Where(sq.Eq{"uuid": userUUID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).GetContext(ctx, &user, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.NotFoundError("user doesn't exist")
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return &user, nil
+}
+
+func (r *Repository) GetReactionsByUser(ctx context.Context, userUUID string) (model.UserReactionList, error) {
+	var reactions model.UserReactionList
+
+	query, args, err := sq.
+		Select("material_uuid", "reaction", "created_at").
+		From("material_reactions").
+		Where(sq.Eq{"user_uuid": userUUID}).
+		OrderBy("created_at", "material_uuid", "reaction").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).SelectContext(ctx, &reactions, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user reactions: %w", err)
+	}
+
+	return reactions, nil
+}
+
+func (r *Repository) GetReportsByReporter(ctx context.Context, reporterUUID string) (model.MaterialReportList, error) {
+	var reports model.MaterialReportList
+
+	query, args, err := sq.
+		Select("uuid", "material_uuid", "reporter_uuid", "reason", "comment", "status", "created_at", "resolved_at", "resolved_by").
+		From("material_reports").
+		Where(sq.Eq{"reporter_uuid": reporterUUID}).
+		OrderBy("created_at", "uuid").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).SelectContext(ctx, &reports, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user reports: %w", err)
+	}
+
+	return reports, nil
+}
+
+func (r *Repository) GetAttachmentsByOwner(ctx context.Context, ownerUUID string) (model.MaterialAttachmentList, error) {
+	var attachments model.MaterialAttachmentList
+
+	query, args, err := sq.
+		Select("uuid", "material_uuid", "owner_uuid", "filename", "content_type", "size_bytes", "storage_key", "created_at").
+		From("material_attachments").
+		Where(sq.Eq{"owner_uuid": ownerUUID}).
+		OrderBy("created_at", "uuid").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).SelectContext(ctx, &attachments, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch attachments: %w", err)
+	}
+
+	return attachments, nil
+}
+
+// GetOwnedMaterialUUIDs возвращает все материалы владельца, включая лежащие в корзине
+func (r *Repository) GetOwnedMaterialUUIDs(ctx context.Context, ownerUUID string) ([]string, error) {
+	var uuids []string
+
+	query, args, err := sq.
+		Select("uuid").
+		From("materials").
+		Where(sq.Eq{"owner_uuid": ownerUUID}).
+		OrderBy("uuid").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	err = r.Chk(ctx).SelectContext(ctx, &uuids, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get owned materials: %w", err)
+	}
+
+	return uuids, nil
+}
+
+// DeleteUserReactions удаляет все реакции пользователя и возвращает материалы, у которых нужно пересчитать счётчики
+func (r *Repository) DeleteUserReactions(ctx context.Context, userUUID string) ([]string, error) {
+	var uuids []string
+
+	query := `
+		WITH deleted AS (
+			DELETE FROM material_reactions
+			WHERE user_uuid = $1
+			RETURNING material_uuid
+		)
+		SELECT DISTINCT material_uuid FROM deleted ORDER BY material_uuid`
+
+	err := r.Chk(ctx).SelectContext(ctx, &uuids, query, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete user reactions: %w", err)
+	}
+
+	return uuids, nil
+}
+
+// EraseUserReports удаляет жалобы пользователя и отвязывает его от жалоб, которые он разбирал как модератор
+func (r *Repository) EraseUserReports(ctx context.Context, userUUID string) error {
+	deleteQuery, deleteArgs, err := sq.
+		Delete("material_reports").
+		Where(sq.Eq{"reporter_uuid": userUUID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build reports delete query: %w", err)
+	}
+
+	resolvedQuery, resolvedArgs, err := sq.
+		Update("material_reports").
+		Set("resolved_by", nil).
+		Where(sq.Eq{"resolved_by": userUUID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build reports update query: %w", err)
+	}
+
+	if _, err = r.Chk(ctx).ExecContext(ctx, deleteQuery, deleteArgs...); err != nil {
+		return fmt.Errorf("failed to delete user reports: %w", err)
+	}
+
+	if _, err = r.Chk(ctx).ExecContext(ctx, resolvedQuery, resolvedArgs...); err != nil {
+		return fmt.Errorf("failed to detach user from resolved reports: %w", err)
+	}
+
+	return nil
+}
+
+// ReassignUserMaterials передаёт материалы, вложения и загруженные обложки пользователя другому владельцу
+func (r *Repository) ReassignUserMaterials(ctx context.Context, fromUUID, toUUID string) error {
+	for _, table := range []string{"materials", "material_attachments", "cover_uploads"} {
+		query, args, err := sq.
+			Update(table).
+			Set("owner_uuid", toUUID).
+			Where(sq.Eq{"owner_uuid": fromUUID}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build %s update query: %w", table, err)
+		}
+
+		if _, err = r.Chk(ctx).ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("failed to reassign %s: %w", table, err)
+		}
+	}
+
+	return nil
+}
+
+// MarkUserMaterials отмечает материалы владельца как оставшиеся от удалённого пользователя
+func (r *Repository) MarkUserMaterials(ctx context.Context, ownerUUID string) error {
+	query, args, err := sq.
+		Update("materials").
+		Set("owner_deleted_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"owner_uuid": ownerUUID}).
+		Where(sq.Expr("owner_deleted_at IS NULL")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build sql query: %w", err)
+	}
+
+	_, err = r.Chk(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to mark user materials: %w", err)
+	}
+
+	return nil
+}
+
+// ScrubUser стирает данные профиля и оставляет строку с отметкой удаления. Строка создаётся, даже если
+// профиля ещё не было: по отметке UpsertUser и UpsertUserProfile не восстанавливают данные из повторных событий
+func (r *Repository) ScrubUser(ctx context.Context, userUUID string) error {
+	query := `
+		INSERT INTO users (uuid, nickname, avatar_link, name, surname, updated_at, deleted_at)
+		VALUES ($1, $2, '', NULL, NULL, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		ON CONFLICT (uuid) DO UPDATE
+		SET nickname    = EXCLUDED.nickname,
+			avatar_link = '',
+			name        = NULL,
+			surname     = NULL,
+			updated_at  = CURRENT_TIMESTAMP,
+			deleted_at  = COALESCE(users.deleted_at, CURRENT_TIMESTAMP)`
+
+	_, err := r.Chk(ctx).ExecContext(ctx, query, userUUID, model.DeletedUserNickname)
 	if err != nil {
-		return fmt.Errorf("failed to insert user: %w", err)
+		return fmt.Errorf("failed to scrub user: %w", err)
 	}
 
 	return nil
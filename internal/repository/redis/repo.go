@@ -2,8 +2,10 @@ package redis
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -13,11 +15,51 @@ import (
 )
 
 const (
-	prefix = "material:"
+	prefix            = "material:"
+	autosavePrefix    = "material_autosave:"
+	rateLimitPrefix   = "rate_limit:"
+	idempotencyPrefix = "idempotency:"
+	viewsPrefix       = "material_views:"
+	viewerPrefix      = "material_viewer:"
+	trendingKey       = "trending:materials"
+	trendingTagPrefix = "trending:tag:"
+	trendingTagsKey   = "trending:tags"
+
+	autosaveTTL = 7 * 24 * time.Hour
 )
 
+// rateLimitScript атомарно увеличивает счётчик окна и ставит TTL при первом запросе
+var rateLimitScript = redis.NewScript(`
+local count = redis.call('INCR', KEYS[1])
+if count == 1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return {count, redis.call('PTTL', KEYS[1])}
+`)
+
+// viewScript считает просмотр, только если пользователь не смотрел материал в течение окна рейтинга
+var viewScript = redis.NewScript(`
+if not redis.call('SET', KEYS[1], 1, 'NX', 'PX', ARGV[1]) then
+	return 0
+end
+redis.call('HINCRBY', KEYS[2], ARGV[2], 1)
+redis.call('PEXPIRE', KEYS[2], ARGV[3])
+return 1
+`)
+
+// acquireIdempotencyScript занимает ключ, если он свободен, иначе возвращает сохранённое состояние
+var acquireIdempotencyScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return redis.call('HGETALL', KEYS[1])
+end
+redis.call('HSET', KEYS[1], 'state', ARGV[1], 'fingerprint', ARGV[2])
+redis.call('PEXPIRE', KEYS[1], ARGV[3])
+return {}
+`)
+
 type Repository struct {
-	conn *redis.Client
+	conn       *redis.Client
+	viewWindow time.Duration
 }
 
 func New(cfg *config.Config) *Repository {
@@ -38,7 +80,7 @@ func New(cfg *config.Config) *Repository {
 		log.Fatal(err)
 	}
 
-	return &Repository{conn: rdb}
+	return &Repository{conn: rdb, viewWindow: cfg.Trending.Window}
 }
 
 func (r *Repository) Close() {
@@ -48,6 +90,50 @@ func (r *Repository) Close() {
 func (r *Repository) SetMaterial(ctx context.Context, material *model.Material, ttl time.Duration) error {
 	key := prefix + material.UUID
 
+	data, err := materialFields(material)
+	if err != nil {
+		return err
+	}
+
+	if err := r.conn.HSet(ctx, key, data).Err(); err != nil {
+		return err
+	}
+
+	if ttl > 0 {
+		r.conn.Expire(ctx, key, ttl)
+	}
+
+	return nil
+}
+
+// SetMaterials кладёт материалы в кэш одним пайплайном
+func (r *Repository) SetMaterials(ctx context.Context, materials model.MaterialList, ttl time.Duration) error {
+	if len(materials) == 0 {
+		return nil
+	}
+
+	pipe := r.conn.Pipeline()
+	for i := range materials {
+		data, err := materialFields(&materials[i])
+		if err != nil {
+			return err
+		}
+
+		key := prefix + materials[i].UUID
+		pipe.HSet(ctx, key, data)
+		if ttl > 0 {
+			pipe.Expire(ctx, key, ttl)
+		}
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to set materials: %w", err)
+	}
+
+	return nil
+}
+
+func materialFields(material *model.Material) (map[string]interface{}, error) {
 	data := map[string]interface{}{
 		"uuid":              material.UUID,
 		"owner_uuid":        material.OwnerUUID,
@@ -58,11 +144,29 @@ func (r *Repository) SetMaterial(ctx context.Context, material *model.Material,
 		"status":            material.Status,
 		"created_at":        material.CreatedAt.Format(time.RFC3339),
 		"likes_count":       material.LikesCount,
+		"forks_count":       material.ForksCount,
 	}
 
 	if material.Content != nil {
 		data["content"] = *material.Content
 	}
+	if material.ForkedFromUUID != nil {
+		data["forked_from_uuid"] = *material.ForkedFromUUID
+	}
+	if len(material.ReactionCounts) > 0 {
+		reactionCounts, err := json.Marshal(material.ReactionCounts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal reaction counts: %w", err)
+		}
+		data["reaction_counts"] = string(reactionCounts)
+	}
+	if len(material.CoverThumbnails) > 0 {
+		coverThumbnails, err := json.Marshal(material.CoverThumbnails)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal cover thumbnails: %w", err)
+		}
+		data["cover_thumbnails"] = string(coverThumbnails)
+	}
 	if material.EditedAt != nil {
 		data["edited_at"] = material.EditedAt.Format(time.RFC3339)
 	}
@@ -75,16 +179,14 @@ func (r *Repository) SetMaterial(ctx context.Context, material *model.Material,
 	if material.DeletedAt != nil {
 		data["deleted_at"] = material.DeletedAt.Format(time.RFC3339)
 	}
-
-	if err := r.conn.HSet(ctx, key, data).Err(); err != nil {
-		return err
+	if material.HiddenAt != nil {
+		data["hidden_at"] = material.HiddenAt.Format(time.RFC3339)
 	}
-
-	if ttl > 0 {
-		r.conn.Expire(ctx, key, ttl)
+	if material.OwnerDeletedAt != nil {
+		data["owner_deleted_at"] = material.OwnerDeletedAt.Format(time.RFC3339)
 	}
 
-	return nil
+	return data, nil
 }
 
 func (r *Repository) GetMaterial(ctx context.Context, uuid string) (*model.Material, error) {
@@ -98,6 +200,37 @@ func (r *Repository) GetMaterial(ctx context.Context, uuid string) (*model.Mater
 		return nil, redis.Nil
 	}
 
+	return parseMaterial(data), nil
+}
+
+// GetMaterials достаёт материалы из кэша одним пайплайном, промахи в результат не попадают
+func (r *Repository) GetMaterials(ctx context.Context, uuids []string) (model.MaterialList, error) {
+	if len(uuids) == 0 {
+		return nil, nil
+	}
+
+	pipe := r.conn.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, 0, len(uuids))
+	for _, uuid := range uuids {
+		cmds = append(cmds, pipe.HGetAll(ctx, prefix+uuid))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get materials: %w", err)
+	}
+
+	materials := make(model.MaterialList, 0, len(uuids))
+	for _, cmd := range cmds {
+		data := cmd.Val()
+		if len(data) == 0 {
+			continue
+		}
+		materials = append(materials, *parseMaterial(data))
+	}
+
+	return materials, nil
+}
+
+func parseMaterial(data map[string]string) *model.Material {
 	parseTime := func(s string) (*time.Time, error) {
 		if s == "" {
 			return nil, nil
@@ -121,11 +254,21 @@ func (r *Repository) GetMaterial(ctx context.Context, uuid string) (*model.Mater
 		Status:          data["status"],
 		CreatedAt:       createdAt,
 		LikesCount:      parseInt32(data["likes_count"]),
+		ForksCount:      parseInt32(data["forks_count"]),
 	}
 
 	if content, ok := data["content"]; ok && content != "" {
 		material.Content = &content
 	}
+	if forkedFrom, ok := data["forked_from_uuid"]; ok && forkedFrom != "" {
+		material.ForkedFromUUID = &forkedFrom
+	}
+	if reactionCounts, ok := data["reaction_counts"]; ok && reactionCounts != "" {
+		_ = material.ReactionCounts.Scan(reactionCounts)
+	}
+	if coverThumbnails, ok := data["cover_thumbnails"]; ok && coverThumbnails != "" {
+		_ = material.CoverThumbnails.Scan(coverThumbnails)
+	}
 	if editedAtStr, ok := data["edited_at"]; ok && editedAtStr != "" {
 		if t, err := parseTime(editedAtStr); err == nil && t != nil {
 			material.EditedAt = t
@@ -146,8 +289,89 @@ func (r *Repository) GetMaterial(ctx context.Context, uuid string) (*model.Mater
 			material.DeletedAt = t
 		}
 	}
+	if hiddenAtStr, ok := data["hidden_at"]; ok && hiddenAtStr != "" {
+		if t, err := parseTime(hiddenAtStr); err == nil && t != nil {
+			material.HiddenAt = t
+		}
+	}
+	if ownerDeletedAtStr, ok := data["owner_deleted_at"]; ok && ownerDeletedAtStr != "" {
+		if t, err := parseTime(ownerDeletedAtStr); err == nil && t != nil {
+			material.OwnerDeletedAt = t
+		}
+	}
+
+	return material
+}
+
+func (r *Repository) DeleteMaterial(ctx context.Context, uuid string) error {
+	return r.conn.Del(ctx, prefix+uuid).Err()
+}
+
+func (r *Repository) DeleteMaterials(ctx context.Context, uuids []string) error {
+	if len(uuids) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(uuids))
+	for _, uuid := range uuids {
+		keys = append(keys, prefix+uuid)
+	}
+
+	return r.conn.Del(ctx, keys...).Err()
+}
+
+func (r *Repository) SetAutosave(ctx context.Context, autosave *model.AutosaveDraft) error {
+	key := autosavePrefix + autosave.MaterialUUID
+
+	data := map[string]interface{}{
+		"material_uuid":     autosave.MaterialUUID,
+		"title":             autosave.Title,
+		"cover_image_url":   autosave.CoverImageURL,
+		"description":       autosave.Description,
+		"content":           autosave.Content,
+		"read_time_minutes": autosave.ReadTimeMinutes,
+		"saved_at":          autosave.SavedAt.Format(time.RFC3339Nano),
+	}
+
+	pipe := r.conn.TxPipeline()
+	pipe.HSet(ctx, key, data)
+	pipe.Expire(ctx, key, autosaveTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to set autosave: %w", err)
+	}
+
+	return nil
+}
+
+// GetAutosave возвращает nil без ошибки, если автосохранения для материала нет.
+func (r *Repository) GetAutosave(ctx context.Context, materialUUID string) (*model.AutosaveDraft, error) {
+	data, err := r.conn.HGetAll(ctx, autosavePrefix+materialUUID).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get autosave: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
 
-	return material, nil
+	savedAt, _ := time.Parse(time.RFC3339Nano, data["saved_at"])
+
+	return &model.AutosaveDraft{
+		MaterialUUID:    data["material_uuid"],
+		Title:           data["title"],
+		CoverImageURL:   data["cover_image_url"],
+		Description:     data["description"],
+		Content:         data["content"],
+		ReadTimeMinutes: parseInt32(data["read_time_minutes"]),
+		SavedAt:         savedAt,
+	}, nil
+}
+
+func (r *Repository) DeleteAutosave(ctx context.Context, materialUUID string) error {
+	if err := r.conn.Del(ctx, autosavePrefix+materialUUID).Err(); err != nil {
+		return fmt.Errorf("failed to delete autosave: %w", err)
+	}
+
+	return nil
 }
 
 func parseInt32(s string) int32 {
@@ -158,3 +382,162 @@ func parseInt32(s string) int32 {
 	}
 	return i
 }
+
+func (r *Repository) RateLimitHit(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	res, err := rateLimitScript.Run(ctx, r.conn, []string{rateLimitPrefix + key}, window.Milliseconds()).Int64Slice()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to hit rate limit counter: %v", err)
+	}
+
+	return res[0], time.Duration(res[1]) * time.Millisecond, nil
+}
+
+// AcquireIdempotencyKey возвращает nil, если ключ занят текущим запросом, иначе — ранее сохранённое состояние
+func (r *Repository) AcquireIdempotencyKey(ctx context.Context, key, fingerprint string, lockTTL time.Duration) (*model.IdempotentResponse, error) {
+	res, err := acquireIdempotencyScript.Run(ctx, r.conn,
+		[]string{idempotencyPrefix + key},
+		model.IdempotencyStateProcessing, fingerprint, lockTTL.Milliseconds(),
+	).StringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire idempotency key: %v", err)
+	}
+
+	if len(res) == 0 {
+		return nil, nil
+	}
+
+	data := make(map[string]string, len(res)/2)
+	for i := 0; i+1 < len(res); i += 2 {
+		data[res[i]] = res[i+1]
+	}
+
+	statusCode, _ := strconv.Atoi(data["status_code"])
+
+	return &model.IdempotentResponse{
+		State:       data["state"],
+		Fingerprint: data["fingerprint"],
+		StatusCode:  statusCode,
+		ContentType: data["content_type"],
+		Body:        []byte(data["body"]),
+	}, nil
+}
+
+func (r *Repository) SaveIdempotentResponse(ctx context.Context, key string, response *model.IdempotentResponse, ttl time.Duration) error {
+	redisKey := idempotencyPrefix + key
+
+	pipe := r.conn.TxPipeline()
+	pipe.HSet(ctx, redisKey, map[string]interface{}{
+		"state":        model.IdempotencyStateDone,
+		"fingerprint":  response.Fingerprint,
+		"status_code":  response.StatusCode,
+		"content_type": response.ContentType,
+		"body":         response.Body,
+	})
+	pipe.Expire(ctx, redisKey, ttl)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to save idempotent response: %v", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ReleaseIdempotencyKey(ctx context.Context, key string) error {
+	return r.conn.Del(ctx, idempotencyPrefix+key).Err()
+}
+
+func viewsKey(day time.Time) string {
+	return viewsPrefix + day.UTC().Format(time.DateOnly)
+}
+
+// IncrementMaterialViews увеличивает счётчик просмотров материала за текущий день. Повторные просмотры
+// одного пользователя в пределах окна рейтинга не считаются. Дневной счётчик живёт окно плюс сутки,
+// чтобы последний день окна не истёк раньше, чем перестанет учитываться
+func (r *Repository) IncrementMaterialViews(ctx context.Context, uuid, viewerUUID string) error {
+	err := viewScript.Run(ctx, r.conn,
+		[]string{viewerPrefix + uuid + ":" + viewerUUID, viewsKey(time.Now())},
+		r.viewWindow.Milliseconds(), uuid, (r.viewWindow + 24*time.Hour).Milliseconds(),
+	).Err()
+	if err != nil {
+		return fmt.Errorf("failed to increment material views: %w", err)
+	}
+
+	return nil
+}
+
+// GetMaterialViews возвращает просмотры материалов за день
+func (r *Repository) GetMaterialViews(ctx context.Context, day time.Time) (map[string]int64, error) {
+	data, err := r.conn.HGetAll(ctx, viewsKey(day)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get material views: %w", err)
+	}
+
+	views := make(map[string]int64, len(data))
+	for uuid, value := range data {
+		count, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+		views[uuid] = count
+	}
+
+	return views, nil
+}
+
+// SaveTrending атомарно заменяет общий рейтинг и рейтинги по тегам
+func (r *Repository) SaveTrending(ctx context.Context, ranking []model.TrendingScore, tags map[string][]string) error {
+	oldTags, err := r.conn.SMembers(ctx, trendingTagsKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get trending tags: %w", err)
+	}
+
+	scores := make(map[string]float64, len(ranking))
+	members := make([]redis.Z, 0, len(ranking))
+	for _, item := range ranking {
+		scores[item.UUID] = item.Score
+		members = append(members, redis.Z{Score: item.Score, Member: item.UUID})
+	}
+
+	pipe := r.conn.TxPipeline()
+	pipe.Del(ctx, trendingKey, trendingTagsKey)
+	for _, tag := range oldTags {
+		pipe.Del(ctx, trendingTagPrefix+tag)
+	}
+
+	if len(members) > 0 {
+		pipe.ZAdd(ctx, trendingKey, members...)
+	}
+
+	for tag, uuids := range tags {
+		tagMembers := make([]redis.Z, 0, len(uuids))
+		for _, uuid := range uuids {
+			tagMembers = append(tagMembers, redis.Z{Score: scores[uuid], Member: uuid})
+		}
+		if len(tagMembers) == 0 {
+			continue
+		}
+		pipe.ZAdd(ctx, trendingTagPrefix+tag, tagMembers...)
+		pipe.SAdd(ctx, trendingTagsKey, tag)
+	}
+
+	if _, err = pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to save trending: %w", err)
+	}
+
+	return nil
+}
+
+// GetTrending возвращает uuid материалов по убыванию рейтинга, при непустом tag — только с этим тегом
+func (r *Repository) GetTrending(ctx context.Context, tag string, offset, limit int) ([]string, error) {
+	key := trendingKey
+	if tag != "" {
+		key = trendingTagPrefix + tag
+	}
+
+	uuids, err := r.conn.ZRevRange(ctx, key, int64(offset), int64(offset+limit-1)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trending: %w", err)
+	}
+
+	return uuids, nil
+}
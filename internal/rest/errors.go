@@ -0,0 +1,81 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	logger_lib "github.com/s21platform/logger-lib"
+
+	api "github.com/s21platform/materials-service/internal/generated"
+	"github.com/s21platform/materials-service/internal/model"
+)
+
+const problemContentType = "application/problem+json"
+
+// writeProblem отвечает по ошибке сервиса: доменная ошибка отдаётся со статусом своей категории и своим сообщением,
+// остальные — 500 с message без текста исходной ошибки
+func (h *Handler) writeProblem(w http.ResponseWriter, err error, message string) {
+	var domainErr *model.Error
+	if !errors.As(err, &domainErr) {
+		if message == "" {
+			message = "internal server error"
+		}
+		h.writeError(w, message, http.StatusInternalServerError)
+		return
+	}
+
+	reason := api.ErrorReason(domainErr.Kind)
+	problem := newProblem(domainErr.Message, httpStatus(domainErr.Kind))
+	problem.Reason = &reason
+	if domainErr.Field != "" {
+		problem.InvalidParams = &[]api.InvalidParam{
+			{Name: domainErr.Field, Reason: domainErr.Message},
+		}
+	}
+	writeProblemJSON(w, problem)
+}
+
+// writeError отвечает ошибкой в формате RFC 7807, code и message дублируют status и detail для старых клиентов
+func (h *Handler) writeError(w http.ResponseWriter, message string, statusCode int) {
+	writeProblemJSON(w, newProblem(message, statusCode))
+}
+
+func newProblem(message string, statusCode int) api.Error {
+	return api.Error{
+		Type:    "about:blank",
+		Title:   http.StatusText(statusCode),
+		Status:  statusCode,
+		Detail:  message,
+		Code:    statusCode,
+		Message: message,
+	}
+}
+
+func writeProblemJSON(w http.ResponseWriter, problem api.Error) {
+	w.Header().Set("Content-Type", problemContentType)
+	w.WriteHeader(problem.Status)
+	err := json.NewEncoder(w).Encode(problem)
+	if err != nil {
+		logger_lib.Error(context.Background(), fmt.Sprintf("failed to encode error response: %v", err))
+		http.Error(w, "failed to encode error response", http.StatusInternalServerError)
+	}
+}
+
+func httpStatus(kind model.ErrorKind) int {
+	switch kind {
+	case model.ErrorKindNotFound:
+		return http.StatusNotFound
+	case model.ErrorKindForbidden:
+		return http.StatusForbidden
+	// 412 в HTTP относится к условным заголовкам запроса, поэтому состояние материала отдаётся как 409
+	case model.ErrorKindConflict, model.ErrorKindPrecondition:
+		return http.StatusConflict
+	case model.ErrorKindValidation:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
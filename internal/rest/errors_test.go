@@ -0,0 +1,77 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/s21platform/materials-service/internal/generated"
+	"github.com/s21platform/materials-service/internal/model"
+)
+
+func TestHandler_WriteProblem(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		err        error
+		message    string
+		wantStatus int
+		wantMsg    string
+		wantReason api.ErrorReason
+	}{
+		{"not_found", model.NotFoundError("material does not exist"), "failed", http.StatusNotFound, "material does not exist", api.ErrorReason(model.ErrorKindNotFound)},
+		{"forbidden", model.ForbiddenError("user is not owner"), "failed", http.StatusForbidden, "user is not owner", api.ErrorReason(model.ErrorKindForbidden)},
+		{"conflict", model.ConflictError("already reported"), "failed", http.StatusConflict, "already reported", api.ErrorReason(model.ErrorKindConflict)},
+		{"validation", model.ValidationError("uuid", "material uuid is required"), "failed", http.StatusBadRequest, "material uuid is required", api.ErrorReason(model.ErrorKindValidation)},
+		{"precondition", model.PreconditionError("autosave has empty title"), "failed", http.StatusConflict, "autosave has empty title", api.ErrorReason(model.ErrorKindPrecondition)},
+		{"wrapped", fmt.Errorf("failed to get material: %w", model.NotFoundError("material does not exist")), "failed", http.StatusNotFound, "material does not exist", api.ErrorReason(model.ErrorKindNotFound)},
+		{"internal_hides_cause", fmt.Errorf("pq: connection refused"), "failed to get material", http.StatusInternalServerError, "failed to get material", ""},
+		{"internal_default_message", fmt.Errorf("pq: connection refused"), "", http.StatusInternalServerError, "internal server error", ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			handler := &Handler{}
+
+			w := httptest.NewRecorder()
+			handler.writeProblem(w, tc.err, tc.message)
+
+			assert.Equal(t, tc.wantStatus, w.Code)
+			assert.Equal(t, problemContentType, w.Header().Get("Content-Type"))
+
+			var problem api.Error
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+			assert.Equal(t, tc.wantStatus, problem.Status)
+			assert.Equal(t, tc.wantStatus, problem.Code)
+			assert.Equal(t, http.StatusText(tc.wantStatus), problem.Title)
+			assert.Equal(t, tc.wantMsg, problem.Message)
+			assert.Equal(t, tc.wantMsg, problem.Detail)
+			assert.NotContains(t, problem.Message, "pq:")
+			if tc.wantReason == "" {
+				assert.Nil(t, problem.Reason)
+			} else {
+				require.NotNil(t, problem.Reason)
+				assert.Equal(t, tc.wantReason, *problem.Reason)
+			}
+		})
+	}
+}
+
+func TestHandler_WriteProblem_InvalidParams(t *testing.T) {
+	t.Parallel()
+	handler := &Handler{}
+
+	w := httptest.NewRecorder()
+	handler.writeProblem(w, model.ValidationError("title", "title is required"), "failed")
+
+	var problem api.Error
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	require.NotNil(t, problem.InvalidParams)
+	assert.Equal(t, []api.InvalidParam{{Name: "title", Reason: "title is required"}}, *problem.InvalidParams)
+}
@@ -1,43 +1,50 @@
 package rest
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
 	"net/http"
+	"path"
 	"strconv"
 	"strings"
-	"time"
 
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	logger_lib "github.com/s21platform/logger-lib"
-	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/s21platform/materials-service/internal/config"
 	api "github.com/s21platform/materials-service/internal/generated"
 	"github.com/s21platform/materials-service/internal/model"
-	"github.com/s21platform/materials-service/internal/pkg/tx"
-	proto "github.com/s21platform/materials-service/pkg/materials"
 )
 
 const (
 	key = "func_name"
+
+	// запас сверх размера файла на заголовки multipart и текстовые поля формы
+	multipartOverhead = 1 << 20
 )
 
 type Handler struct {
-	repository          DBRepo
-	createKafkaProducer KafkaProducer
-	likeKafkaProducer   KafkaProducer
-	editKafkaProducer   KafkaProducer
-	redis               RedisRepo
+	useCase            UseCase
+	reactionTypes      []string
+	coverMaxBytes      int64
+	attachmentMaxBytes int64
+	importMaxBytes     int64
 }
 
-func New(repo DBRepo, createKafkaProducer, likeKafkaProducer, editKafkaProducer KafkaProducer, redis RedisRepo) *Handler {
+func New(useCase UseCase, cfg *config.Config) *Handler {
 	return &Handler{
-		repository:          repo,
-		createKafkaProducer: createKafkaProducer,
-		likeKafkaProducer:   likeKafkaProducer,
-		editKafkaProducer:   editKafkaProducer,
-		redis:               redis,
+		useCase:            useCase,
+		reactionTypes:      model.ReactionTypes(cfg.Reactions.Types),
+		coverMaxBytes:      cfg.Covers.MaxBytes,
+		attachmentMaxBytes: cfg.Attachments.MaxBytes,
+		importMaxBytes:     cfg.Import.MaxBytes,
 	}
 }
 
@@ -66,10 +73,10 @@ func (h *Handler) SaveDraftMaterial(w http.ResponseWriter, r *http.Request) {
 		ReadTimeMinutes: req.ReadTimeMinutes,
 	}
 
-	respUUID, err := h.repository.SaveDraftMaterial(r.Context(), userUUID, saveReq)
+	respUUID, err := h.useCase.SaveDraftMaterial(r.Context(), userUUID, saveReq)
 	if err != nil {
 		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to save draft material: %v", err))
-		h.writeError(w, fmt.Sprintf("failed to save draft material: %v", err), http.StatusInternalServerError)
+		h.writeProblem(w, err, "failed to save draft material")
 		return
 	}
 
@@ -90,12 +97,6 @@ func (h *Handler) PublishMaterial(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if req.Uuid == "" {
-		logger_lib.Error(ctx, "material uuid is required")
-		h.writeError(w, "material uuid is required", http.StatusBadRequest)
-		return
-	}
-
 	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
 	if !ok || userUUID == "" {
 		logger_lib.Error(ctx, "failed to get user UUID")
@@ -103,36 +104,10 @@ func (h *Handler) PublishMaterial(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	materialOwnerUUID, err := h.repository.GetMaterialOwnerUUID(r.Context(), req.Uuid)
-	if err != nil {
-		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get owner uuid: %v", err))
-		h.writeError(w, fmt.Sprintf("failed to get owner uuid: %v", err), http.StatusInternalServerError)
-		return
-	}
-
-	if materialOwnerUUID != userUUID {
-		logger_lib.Error(ctx, "failed to publish: user is not owner")
-		h.writeError(w, "failed to publish: user is not owner", http.StatusForbidden)
-		return
-	}
-
-	exists, err := h.repository.MaterialExists(r.Context(), req.Uuid)
-	if err != nil {
-		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to check material existence: %v", err))
-		h.writeError(w, fmt.Sprintf("failed to check material existence: %v", err), http.StatusInternalServerError)
-		return
-	}
-
-	if !exists {
-		logger_lib.Error(ctx, "material does not exist")
-		h.writeError(w, "material does not exist", http.StatusPreconditionFailed)
-		return
-	}
-
-	publishedMaterial, err := h.repository.PublishMaterial(r.Context(), req.Uuid)
+	publishedMaterial, err := h.useCase.PublishMaterial(r.Context(), req.Uuid, userUUID)
 	if err != nil {
 		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to publish material: %v", err))
-		h.writeError(w, fmt.Sprintf("failed to publish material: %v", err), http.StatusInternalServerError)
+		h.writeProblem(w, err, "failed to publish material")
 		return
 	}
 
@@ -149,24 +124,6 @@ func (h *Handler) PublishMaterial(w http.ResponseWriter, r *http.Request) {
 		},
 	}
 
-	createMaterial := &proto.CreatedMaterial{
-		Material: &proto.Material{
-			Uuid:            publishedMaterial.UUID,
-			OwnerUuid:       publishedMaterial.OwnerUUID,
-			Title:           publishedMaterial.Title,
-			Content:         *publishedMaterial.Content,
-			Description:     publishedMaterial.Description,
-			CoverImageUrl:   publishedMaterial.CoverImageURL,
-			ReadTimeMinutes: publishedMaterial.ReadTimeMinutes,
-			Status:          publishedMaterial.Status,
-		},
-	}
-
-	err = h.createKafkaProducer.ProduceMessage(r.Context(), createMaterial, materialOwnerUUID)
-	if err != nil {
-		logger_lib.Error(logger_lib.WithError(ctx, err), "failed to produce message")
-	}
-
 	h.writeJSON(w, response, http.StatusOK)
 }
 
@@ -180,12 +137,6 @@ func (h *Handler) ToggleLike(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if req.MaterialUuid == "" {
-		logger_lib.Error(ctx, "material uuid is required")
-		h.writeError(w, "material uuid is required", http.StatusBadRequest)
-		return
-	}
-
 	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
 	if !ok || userUUID == "" {
 		logger_lib.Error(ctx, "failed to get user UUID")
@@ -193,59 +144,16 @@ func (h *Handler) ToggleLike(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var isLiked bool
-	var likesCount int32
-	err := tx.TxExecute(r.Context(), func(ctx context.Context) error {
-		var err error
-		isLiked, err = h.repository.CheckLike(ctx, req.MaterialUuid, userUUID)
-		if err != nil {
-			return fmt.Errorf("failed to check like: %v", err)
-		}
-
-		if isLiked {
-			err = h.repository.RemoveLike(ctx, req.MaterialUuid, userUUID)
-			if err != nil {
-				return fmt.Errorf("failed to remove like: %v", err)
-			}
-		} else {
-			err = h.repository.AddLike(ctx, req.MaterialUuid, userUUID)
-			if err != nil {
-				return fmt.Errorf("failed to add like: %v", err)
-			}
-		}
-
-		likesCount, err = h.repository.GetLikesCount(ctx, req.MaterialUuid)
-		if err != nil {
-			return fmt.Errorf("failed to get likes count: %v", err)
-		}
-
-		err = h.repository.UpdateLikesCount(ctx, req.MaterialUuid, likesCount)
-		if err != nil {
-			return fmt.Errorf("failed to update likes count: %v", err)
-		}
-
-		return nil
-	})
+	like, err := h.useCase.ToggleLike(r.Context(), req.MaterialUuid, userUUID)
 	if err != nil {
 		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to toggle like: %v", err))
-		h.writeError(w, fmt.Sprintf("failed to toggle like: %v", err), http.StatusInternalServerError)
+		h.writeProblem(w, err, "failed to toggle like")
 		return
 	}
 
-	likeMsg := &proto.ToggleLikeMessage{
-		MaterialUuid: req.MaterialUuid,
-		IsLiked:      !isLiked,
-		LikesCount:   likesCount,
-	}
-
-	err = h.likeKafkaProducer.ProduceMessage(r.Context(), likeMsg, req.MaterialUuid)
-	if err != nil {
-		logger_lib.Error(logger_lib.WithError(ctx, err), "failed to produce message")
-	}
-
 	response := api.ToggleLikeOut{
-		IsLiked:    !isLiked,
-		LikesCount: likesCount,
+		IsLiked:    like.IsLiked,
+		LikesCount: like.LikesCount,
 	}
 
 	h.writeJSON(w, response, http.StatusOK)
@@ -261,18 +169,6 @@ func (h *Handler) EditMaterial(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if req.Uuid == "" {
-		logger_lib.Error(ctx, "material uuid is required")
-		h.writeError(w, "material uuid is required", http.StatusBadRequest)
-		return
-	}
-
-	if strings.TrimSpace(req.Title) == "" {
-		logger_lib.Error(ctx, "title is required")
-		h.writeError(w, "title is required", http.StatusBadRequest)
-		return
-	}
-
 	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
 	if !ok || userUUID == "" {
 		logger_lib.Error(ctx, "failed to get user UUID")
@@ -280,32 +176,6 @@ func (h *Handler) EditMaterial(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	materialOwnerUUID, err := h.repository.GetMaterialOwnerUUID(r.Context(), req.Uuid)
-	if err != nil {
-		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get owner uuid: %v", err))
-		h.writeError(w, fmt.Sprintf("failed to get owner uuid: %v", err), http.StatusInternalServerError)
-		return
-	}
-
-	if materialOwnerUUID != userUUID {
-		logger_lib.Error(ctx, "failed to edit: user is not owner")
-		h.writeError(w, "failed to edit: user is not owner", http.StatusForbidden)
-		return
-	}
-
-	exists, err := h.repository.MaterialExists(r.Context(), req.Uuid)
-	if err != nil {
-		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to check material existence: %v", err))
-		h.writeError(w, fmt.Sprintf("failed to check material existence: %v", err), http.StatusInternalServerError)
-		return
-	}
-
-	if !exists {
-		logger_lib.Error(ctx, "material does not exist")
-		h.writeError(w, "material does not exist", http.StatusPreconditionFailed)
-		return
-	}
-
 	editReq := &model.EditMaterial{
 		UUID:            req.Uuid,
 		Title:           req.Title,
@@ -315,10 +185,10 @@ func (h *Handler) EditMaterial(w http.ResponseWriter, r *http.Request) {
 		ReadTimeMinutes: req.ReadTimeMinutes,
 	}
 
-	editedMaterial, err := h.repository.EditMaterial(r.Context(), editReq)
+	editedMaterial, err := h.useCase.EditMaterial(r.Context(), userUUID, editReq)
 	if err != nil {
 		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to edit material: %v", err))
-		h.writeError(w, fmt.Sprintf("failed to edit material: %v", err), http.StatusInternalServerError)
+		h.writeProblem(w, err, "failed to edit material")
 		return
 	}
 
@@ -335,18 +205,6 @@ func (h *Handler) EditMaterial(w http.ResponseWriter, r *http.Request) {
 		},
 	}
 
-	editMsg := &proto.EditMaterialMessage{
-		Uuid:      req.Uuid,
-		OwnerUuid: materialOwnerUUID,
-		Title:     req.Title,
-		EditedAt:  timestamppb.New(time.Now()),
-	}
-
-	err = h.editKafkaProducer.ProduceMessage(r.Context(), editMsg, req.Uuid)
-	if err != nil {
-		logger_lib.Error(logger_lib.WithError(ctx, err), "failed to produce message")
-	}
-
 	h.writeJSON(w, response, http.StatusOK)
 }
 
@@ -367,18 +225,19 @@ func (h *Handler) GetAllMaterials(w http.ResponseWriter, r *http.Request, params
 		limit = 10
 	}
 	offset := (page - 1) * limit
+	includeArchived := params.IncludeArchived != nil && *params.IncludeArchived
 
-	paginatedMaterials, err := h.repository.GetAllMaterials(r.Context(), offset, limit)
+	paginatedMaterials, err := h.useCase.GetAllMaterials(r.Context(), offset, limit, includeArchived)
 	if err != nil {
 		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get paginated materials: %v", err))
-		h.writeError(w, fmt.Sprintf("failed to get paginated materials: %v", err), http.StatusInternalServerError)
+		h.writeProblem(w, err, "failed to get paginated materials")
 		return
 	}
 
 	response := api.GetAllMaterialsOut{
-		MaterialList: func(materialsList *model.MaterialList) []api.Material {
+		MaterialList: func(materialsList model.MaterialList) []api.Material {
 			var apiList []api.Material
-			for _, m := range *materialsList {
+			for _, m := range materialsList {
 				apiList = append(apiList, api.Material{
 					Uuid:            m.UUID,
 					OwnerUuid:       &m.OwnerUUID,
@@ -406,84 +265,1331 @@ func (h *Handler) GetMaterial(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if req.MaterialUuid == "" {
-		logger_lib.Error(ctx, "material uuid is required")
-		h.writeError(w, "material uuid is required", http.StatusBadRequest)
+	userUUID, _ := r.Context().Value(config.KeyUUID).(string)
+
+	view, err := h.useCase.GetMaterial(r.Context(), req.MaterialUuid, userUUID)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get material: %v", err))
+		h.writeProblem(w, err, "failed to get material")
 		return
 	}
+	material := view.Material
 
-	cachedMaterial, err := h.redis.GetMaterial(ctx, req.MaterialUuid)
-	if err == nil {
-		response := api.GetMaterialOut{
-			Material: api.Material{
-				Uuid:            cachedMaterial.UUID,
-				OwnerUuid:       &cachedMaterial.OwnerUUID,
-				Title:           cachedMaterial.Title,
-				Content:         *cachedMaterial.Content,
-				Description:     cachedMaterial.Description,
-				CoverImageUrl:   cachedMaterial.CoverImageURL,
-				ReadTimeMinutes: cachedMaterial.ReadTimeMinutes,
-				Status:          cachedMaterial.Status,
-			},
-		}
-		h.writeJSON(w, response, http.StatusOK)
+	content, err := h.resolveContent(r, material)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to resolve attachments: %v", err))
+		h.writeProblem(w, err, "failed to resolve attachments")
 		return
 	}
 
-	material, err := h.repository.GetMaterial(ctx, req.MaterialUuid)
-	if err != nil {
-		logger_lib.Error(logger_lib.WithError(ctx, err), "failed to get material from repository")
+	var myReactions *[]string
+	if userUUID != "" {
+		myReactions = &view.MyReactions
+	}
 
-		if err.Error() == "material doesn't exist" {
-			h.writeError(w, "material does not exist", http.StatusNotFound)
-		} else {
-			h.writeError(w, "", http.StatusInternalServerError)
-		}
+	reactions := h.reactionsToAPI(material.ReactionCounts)
+	coverThumbnails := coverThumbnailsToAPI(material.CoverThumbnails)
+	response := api.GetMaterialOut{
+		Material: api.Material{
+			Uuid:            material.UUID,
+			OwnerUuid:       &material.OwnerUUID,
+			Title:           material.Title,
+			Content:         content,
+			Description:     material.Description,
+			CoverImageUrl:   material.CoverImageURL,
+			ReadTimeMinutes: material.ReadTimeMinutes,
+			Status:          material.Status,
+			ForkedFromUuid:  material.ForkedFromUUID,
+			ForksCount:      &material.ForksCount,
+			Reactions:       &reactions,
+			MyReactions:     myReactions,
+			CoverThumbnails: &coverThumbnails,
+		},
+	}
+
+	h.writeJSON(w, response, http.StatusOK)
+}
+
+func (h *Handler) GetMaterialsByUUIDs(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "GetMaterialsByUUIDs")
+
+	var req api.GetMaterialsByUUIDsIn
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), "failed to decode request")
+		h.writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	userUUID, _ := r.Context().Value(config.KeyUUID).(string)
+
+	found, err := h.useCase.GetMaterialsByUUIDs(r.Context(), req.Uuids, userUUID)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get materials: %v", err))
+		h.writeProblem(w, err, "failed to get materials")
 		return
 	}
 
-	go func() {
-		if err := h.redis.SetMaterial(context.Background(), material, time.Hour); err != nil {
-			logger_lib.Error(logger_lib.WithError(ctx, err), "failed to set material in cache")
+	result := make([]api.Material, 0, len(found))
+	for i := range found {
+		material := &found[i]
+
+		content, err := h.resolveContent(r, material)
+		if err != nil {
+			logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to resolve attachments: %v", err))
+			h.writeProblem(w, err, "failed to resolve attachments")
+			return
 		}
-	}()
 
-	response := api.GetMaterialOut{
-		Material: api.Material{
+		reactions := h.reactionsToAPI(material.ReactionCounts)
+		coverThumbnails := coverThumbnailsToAPI(material.CoverThumbnails)
+		result = append(result, api.Material{
 			Uuid:            material.UUID,
 			OwnerUuid:       &material.OwnerUUID,
 			Title:           material.Title,
-			Content:         *material.Content,
+			Content:         content,
 			Description:     material.Description,
 			CoverImageUrl:   material.CoverImageURL,
 			ReadTimeMinutes: material.ReadTimeMinutes,
 			Status:          material.Status,
+			ForkedFromUuid:  material.ForkedFromUUID,
+			ForksCount:      &material.ForksCount,
+			Reactions:       &reactions,
+			CoverThumbnails: &coverThumbnails,
+		})
+	}
+
+	h.writeJSON(w, api.GetMaterialsByUUIDsOut{Materials: result}, http.StatusOK)
+}
+
+func (h *Handler) AutosaveDraft(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "AutosaveDraft")
+
+	var req api.AutosaveDraftIn
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to decode request: %v", err))
+		h.writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	autosave := &model.AutosaveDraft{
+		MaterialUUID:    req.Uuid,
+		Title:           req.Title,
+		CoverImageURL:   req.CoverImageUrl,
+		Description:     req.Description,
+		Content:         req.Content,
+		ReadTimeMinutes: req.ReadTimeMinutes,
+	}
+
+	saved, err := h.useCase.AutosaveDraft(r.Context(), userUUID, autosave)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to autosave draft: %v", err))
+		h.writeProblem(w, err, "failed to autosave draft")
+		return
+	}
+
+	response := api.AutosaveDraftOut{
+		SavedAt: saved.SavedAt,
+	}
+
+	h.writeJSON(w, response, http.StatusOK)
+}
+
+func (h *Handler) PromoteAutosave(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "PromoteAutosave")
+
+	var req api.PromoteAutosaveIn
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to decode request: %v", err))
+		h.writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	editedMaterial, err := h.useCase.PromoteAutosave(r.Context(), req.Uuid, userUUID)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to promote autosave: %v", err))
+		h.writeProblem(w, err, "failed to promote autosave")
+		return
+	}
+
+	content, err := h.resolveContent(r, editedMaterial)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to resolve attachments: %v", err))
+		h.writeProblem(w, err, "failed to resolve attachments")
+		return
+	}
+
+	response := api.PromoteAutosaveOut{
+		Material: api.Material{
+			Uuid:            editedMaterial.UUID,
+			OwnerUuid:       &editedMaterial.OwnerUUID,
+			Title:           editedMaterial.Title,
+			Content:         content,
+			Description:     editedMaterial.Description,
+			CoverImageUrl:   editedMaterial.CoverImageURL,
+			ReadTimeMinutes: editedMaterial.ReadTimeMinutes,
+			Status:          editedMaterial.Status,
 		},
 	}
 
 	h.writeJSON(w, response, http.StatusOK)
 }
 
-// ----------------------------- helpers -----------------------------
+func (h *Handler) DuplicateMaterial(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "DuplicateMaterial")
 
-func (h *Handler) writeJSON(w http.ResponseWriter, data interface{}, statusCode int) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-	if err := json.NewEncoder(w).Encode(data); err != nil {
-		logger_lib.Error(context.Background(), fmt.Sprintf("failed to encode response: %v", err))
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+	var req api.DuplicateMaterialIn
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to decode request: %v", err))
+		h.writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	duplicate, err := h.useCase.DuplicateMaterial(r.Context(), req.Uuid, userUUID)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to duplicate material: %v", err))
+		h.writeProblem(w, err, "failed to duplicate material")
+		return
+	}
+
+	content, err := h.resolveContent(r, duplicate)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to resolve attachments: %v", err))
+		h.writeProblem(w, err, "failed to resolve attachments")
+		return
+	}
+
+	response := api.DuplicateMaterialOut{
+		Material: api.Material{
+			Uuid:            duplicate.UUID,
+			OwnerUuid:       &duplicate.OwnerUUID,
+			Title:           duplicate.Title,
+			Content:         content,
+			Description:     duplicate.Description,
+			CoverImageUrl:   duplicate.CoverImageURL,
+			ReadTimeMinutes: duplicate.ReadTimeMinutes,
+			Status:          duplicate.Status,
+			ForkedFromUuid:  duplicate.ForkedFromUUID,
+			ForksCount:      &duplicate.ForksCount,
+		},
 	}
+
+	h.writeJSON(w, response, http.StatusOK)
 }
 
-func (h *Handler) writeError(w http.ResponseWriter, message string, statusCode int) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-	err := json.NewEncoder(w).Encode(api.Error{
-		Code:    statusCode,
-		Message: message,
-	})
+func (h *Handler) DeleteMaterial(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "DeleteMaterial")
+
+	var req api.DeleteMaterialIn
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to decode request: %v", err))
+		h.writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	err := h.useCase.DeleteMaterial(r.Context(), req.Uuid, userUUID)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to delete material: %v", err))
+		h.writeProblem(w, err, "failed to delete material")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) ArchiveMaterial(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "ArchiveMaterial")
+
+	var req api.ArchiveMaterialIn
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to decode request: %v", err))
+		h.writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	err := h.useCase.ArchiveMaterial(r.Context(), req.Uuid, userUUID)
 	if err != nil {
-		logger_lib.Error(context.Background(), fmt.Sprintf("failed to encode error response: %v", err))
-		http.Error(w, "failed to encode error response", http.StatusInternalServerError)
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to archive material: %v", err))
+		h.writeProblem(w, err, "failed to archive material")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) GetDeletedMaterials(w http.ResponseWriter, r *http.Request, params api.GetDeletedMaterialsParams) {
+	ctx := logger_lib.WithField(r.Context(), key, "GetDeletedMaterials")
+
+	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	page := 1
+	if params.Page != nil && *params.Page >= 1 {
+		page = *params.Page
+	}
+	limit := 10
+	if params.Limit != nil && *params.Limit >= 1 && *params.Limit <= 100 {
+		limit = *params.Limit
+	}
+	offset := (page - 1) * limit
+
+	deletedMaterials, err := h.useCase.GetDeletedMaterials(r.Context(), userUUID, offset, limit)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get deleted materials: %v", err))
+		h.writeProblem(w, err, "failed to get deleted materials")
+		return
+	}
+
+	response := api.GetDeletedMaterialsOut{
+		MaterialList: make([]api.Material, 0, len(deletedMaterials)),
+	}
+	for _, m := range deletedMaterials {
+		response.MaterialList = append(response.MaterialList, api.Material{
+			Uuid:            m.UUID,
+			OwnerUuid:       &m.OwnerUUID,
+			Title:           m.Title,
+			Description:     m.Description,
+			CoverImageUrl:   m.CoverImageURL,
+			ReadTimeMinutes: m.ReadTimeMinutes,
+			Status:          m.Status,
+		})
+	}
+
+	h.writeJSON(w, response, http.StatusOK)
+}
+
+func (h *Handler) RestoreMaterial(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "RestoreMaterial")
+
+	var req api.RestoreMaterialIn
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to decode request: %v", err))
+		h.writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	restoredMaterial, err := h.useCase.RestoreMaterial(r.Context(), req.Uuid, userUUID)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to restore material: %v", err))
+		h.writeProblem(w, err, "failed to restore material")
+		return
+	}
+
+	content, err := h.resolveContent(r, restoredMaterial)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to resolve attachments: %v", err))
+		h.writeProblem(w, err, "failed to resolve attachments")
+		return
+	}
+
+	response := api.RestoreMaterialOut{
+		Material: api.Material{
+			Uuid:            restoredMaterial.UUID,
+			OwnerUuid:       &restoredMaterial.OwnerUUID,
+			Title:           restoredMaterial.Title,
+			Content:         content,
+			Description:     restoredMaterial.Description,
+			CoverImageUrl:   restoredMaterial.CoverImageURL,
+			ReadTimeMinutes: restoredMaterial.ReadTimeMinutes,
+			Status:          restoredMaterial.Status,
+		},
+	}
+
+	h.writeJSON(w, response, http.StatusOK)
+}
+
+func (h *Handler) GetArchivedMaterials(w http.ResponseWriter, r *http.Request, params api.GetArchivedMaterialsParams) {
+	ctx := logger_lib.WithField(r.Context(), key, "GetArchivedMaterials")
+
+	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	page := 1
+	if params.Page != nil && *params.Page >= 1 {
+		page = *params.Page
+	}
+	limit := 10
+	if params.Limit != nil && *params.Limit >= 1 && *params.Limit <= 100 {
+		limit = *params.Limit
+	}
+	offset := (page - 1) * limit
+
+	archivedMaterials, err := h.useCase.GetArchivedMaterials(r.Context(), userUUID, offset, limit)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get archived materials: %v", err))
+		h.writeProblem(w, err, "failed to get archived materials")
+		return
+	}
+
+	response := api.GetArchivedMaterialsOut{
+		MaterialList: make([]api.Material, 0, len(archivedMaterials)),
+	}
+	for _, m := range archivedMaterials {
+		response.MaterialList = append(response.MaterialList, api.Material{
+			Uuid:            m.UUID,
+			OwnerUuid:       &m.OwnerUUID,
+			Title:           m.Title,
+			Description:     m.Description,
+			CoverImageUrl:   m.CoverImageURL,
+			ReadTimeMinutes: m.ReadTimeMinutes,
+			Status:          m.Status,
+		})
+	}
+
+	h.writeJSON(w, response, http.StatusOK)
+}
+
+func (h *Handler) UnarchiveMaterial(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "UnarchiveMaterial")
+
+	var req api.UnarchiveMaterialIn
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to decode request: %v", err))
+		h.writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	unarchivedMaterial, err := h.useCase.UnarchiveMaterial(r.Context(), req.Uuid, userUUID)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to unarchive material: %v", err))
+		h.writeProblem(w, err, "failed to unarchive material")
+		return
+	}
+
+	content, err := h.resolveContent(r, unarchivedMaterial)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to resolve attachments: %v", err))
+		h.writeProblem(w, err, "failed to resolve attachments")
+		return
+	}
+
+	response := api.UnarchiveMaterialOut{
+		Material: api.Material{
+			Uuid:            unarchivedMaterial.UUID,
+			OwnerUuid:       &unarchivedMaterial.OwnerUUID,
+			Title:           unarchivedMaterial.Title,
+			Content:         content,
+			Description:     unarchivedMaterial.Description,
+			CoverImageUrl:   unarchivedMaterial.CoverImageURL,
+			ReadTimeMinutes: unarchivedMaterial.ReadTimeMinutes,
+			Status:          unarchivedMaterial.Status,
+		},
+	}
+
+	h.writeJSON(w, response, http.StatusOK)
+}
+
+func (h *Handler) BulkDeleteMaterials(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "BulkDeleteMaterials")
+
+	var req api.BulkMaterialsIn
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to decode request: %v", err))
+		h.writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	h.bulkOperation(ctx, w, r, model.BulkActionDelete, req.Uuids, nil)
+}
+
+func (h *Handler) BulkArchiveMaterials(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "BulkArchiveMaterials")
+
+	var req api.BulkMaterialsIn
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to decode request: %v", err))
+		h.writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	h.bulkOperation(ctx, w, r, model.BulkActionArchive, req.Uuids, nil)
+}
+
+func (h *Handler) BulkPublishMaterials(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "BulkPublishMaterials")
+
+	var req api.BulkMaterialsIn
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to decode request: %v", err))
+		h.writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	h.bulkOperation(ctx, w, r, model.BulkActionPublish, req.Uuids, nil)
+}
+
+func (h *Handler) BulkTagMaterials(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "BulkTagMaterials")
+
+	var req api.BulkTagMaterialsIn
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to decode request: %v", err))
+		h.writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	h.bulkOperation(ctx, w, r, model.BulkActionTag, req.Uuids, req.Tags)
+}
+
+func (h *Handler) bulkOperation(ctx context.Context, w http.ResponseWriter, r *http.Request, action string, uuids []string, tags []string) {
+	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	results, err := h.useCase.BulkOperation(r.Context(), action, userUUID, uuids, tags)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to %s materials: %v", action, err))
+		h.writeProblem(w, err, fmt.Sprintf("failed to %s materials", action))
+		return
+	}
+
+	response := api.BulkMaterialsOut{
+		Results: make([]api.BulkItemResult, 0, len(results)),
+	}
+	for _, result := range results {
+		item := api.BulkItemResult{
+			Uuid:    result.UUID,
+			Success: result.Success,
+		}
+		if result.Error != "" {
+			item.Error = &result.Error
+		}
+		response.Results = append(response.Results, item)
+	}
+
+	h.writeJSON(w, response, http.StatusOK)
+}
+
+func (h *Handler) SetLike(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "SetLike")
+
+	var req api.SetLikeIn
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to decode request: %v", err))
+		h.writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	like, err := h.useCase.SetLike(r.Context(), req.MaterialUuid, userUUID, req.Liked)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to set like: %v", err))
+		h.writeProblem(w, err, "failed to set like")
+		return
+	}
+
+	response := api.SetLikeOut{
+		IsLiked:    like.IsLiked,
+		LikesCount: like.LikesCount,
+	}
+
+	h.writeJSON(w, response, http.StatusOK)
+}
+
+func (h *Handler) ListMaterialLikers(w http.ResponseWriter, r *http.Request, params api.ListMaterialLikersParams) {
+	ctx := logger_lib.WithField(r.Context(), key, "ListMaterialLikers")
+
+	page := 1
+	if params.Page != nil && *params.Page >= 1 {
+		page = *params.Page
+	}
+	limit := 10
+	if params.Limit != nil && *params.Limit >= 1 && *params.Limit <= 100 {
+		limit = *params.Limit
+	}
+	offset := (page - 1) * limit
+
+	userUUID, _ := r.Context().Value(config.KeyUUID).(string)
+
+	likers, err := h.useCase.ListLikers(r.Context(), params.MaterialUuid, userUUID, offset, limit)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get material likers: %v", err))
+		h.writeProblem(w, err, "failed to get material likers")
+		return
+	}
+
+	response := api.ListMaterialLikersOut{
+		Likers: make([]api.UserSummary, 0, len(likers)),
+	}
+	for _, l := range likers {
+		response.Likers = append(response.Likers, api.UserSummary{
+			Uuid:       l.Uuid,
+			Nickname:   l.Nickname,
+			AvatarLink: l.AvatarLink,
+			Name:       &l.Name,
+			Surname:    &l.Surname,
+			LikedAt:    l.LikedAt,
+		})
+	}
+
+	h.writeJSON(w, response, http.StatusOK)
+}
+
+func (h *Handler) SetReaction(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "SetReaction")
+
+	var req api.SetReactionIn
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to decode request: %v", err))
+		h.writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	h.changeReaction(ctx, w, r, req.MaterialUuid, req.Reaction, true)
+}
+
+func (h *Handler) ClearReaction(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "ClearReaction")
+
+	var req api.ClearReactionIn
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to decode request: %v", err))
+		h.writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	h.changeReaction(ctx, w, r, req.MaterialUuid, req.Reaction, false)
+}
+
+func (h *Handler) changeReaction(ctx context.Context, w http.ResponseWriter, r *http.Request, materialUUID, reaction string, set bool) {
+	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	state, err := h.useCase.ChangeReaction(r.Context(), materialUUID, userUUID, reaction, set)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to change reaction: %v", err))
+		h.writeProblem(w, err, "failed to change reaction")
+		return
+	}
+
+	response := api.ReactionsOut{
+		Reactions:   h.reactionsToAPI(state.Counts),
+		MyReactions: state.MyReactions,
+	}
+
+	h.writeJSON(w, response, http.StatusOK)
+}
+
+func (h *Handler) GetTrendingMaterials(w http.ResponseWriter, r *http.Request, params api.GetTrendingMaterialsParams) {
+	ctx := logger_lib.WithField(r.Context(), key, "GetTrendingMaterials")
+
+	page := 1
+	if params.Page != nil && *params.Page >= 1 {
+		page = *params.Page
+	}
+	limit := 10
+	if params.Limit != nil && *params.Limit >= 1 && *params.Limit <= 100 {
+		limit = *params.Limit
+	}
+	offset := (page - 1) * limit
+
+	var tag string
+	if params.Tag != nil {
+		tag = *params.Tag
+	}
+
+	trendingMaterials, err := h.useCase.GetTrending(r.Context(), tag, offset, limit)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get trending materials: %v", err))
+		h.writeProblem(w, err, "failed to get trending materials")
+		return
+	}
+
+	response := api.GetTrendingMaterialsOut{
+		MaterialList: make([]api.Material, 0, len(trendingMaterials)),
+	}
+	for _, m := range trendingMaterials {
+		reactions := h.reactionsToAPI(m.ReactionCounts)
+		response.MaterialList = append(response.MaterialList, api.Material{
+			Uuid:            m.UUID,
+			OwnerUuid:       &m.OwnerUUID,
+			Title:           m.Title,
+			Description:     m.Description,
+			CoverImageUrl:   m.CoverImageURL,
+			ReadTimeMinutes: m.ReadTimeMinutes,
+			Status:          m.Status,
+			ForkedFromUuid:  m.ForkedFromUUID,
+			ForksCount:      &m.ForksCount,
+			Reactions:       &reactions,
+		})
+	}
+
+	h.writeJSON(w, response, http.StatusOK)
+}
+
+func (h *Handler) GetRelatedMaterials(w http.ResponseWriter, r *http.Request, params api.GetRelatedMaterialsParams) {
+	ctx := logger_lib.WithField(r.Context(), key, "GetRelatedMaterials")
+
+	page := 1
+	if params.Page != nil && *params.Page >= 1 {
+		page = *params.Page
+	}
+	limit := 10
+	if params.Limit != nil && *params.Limit >= 1 && *params.Limit <= 100 {
+		limit = *params.Limit
+	}
+	offset := (page - 1) * limit
+
+	userUUID, _ := r.Context().Value(config.KeyUUID).(string)
+
+	relatedMaterials, err := h.useCase.GetRelated(r.Context(), params.MaterialUuid, userUUID, offset, limit)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get related materials: %v", err))
+		h.writeProblem(w, err, "failed to get related materials")
+		return
+	}
+
+	response := api.GetRelatedMaterialsOut{
+		MaterialList: make([]api.Material, 0, len(relatedMaterials)),
+	}
+	for _, m := range relatedMaterials {
+		reactions := h.reactionsToAPI(m.ReactionCounts)
+		response.MaterialList = append(response.MaterialList, api.Material{
+			Uuid:            m.UUID,
+			OwnerUuid:       &m.OwnerUUID,
+			Title:           m.Title,
+			Description:     m.Description,
+			CoverImageUrl:   m.CoverImageURL,
+			ReadTimeMinutes: m.ReadTimeMinutes,
+			Status:          m.Status,
+			ForkedFromUuid:  m.ForkedFromUUID,
+			ForksCount:      &m.ForksCount,
+			Reactions:       &reactions,
+		})
+	}
+
+	h.writeJSON(w, response, http.StatusOK)
+}
+
+func (h *Handler) ReportMaterial(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "ReportMaterial")
+
+	var req api.ReportMaterialIn
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to decode request: %v", err))
+		h.writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	var comment string
+	if req.Comment != nil {
+		comment = *req.Comment
+	}
+
+	report, err := h.useCase.ReportMaterial(r.Context(), req.MaterialUuid, userUUID, req.Reason, comment)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to report material: %v", err))
+		h.writeProblem(w, err, "failed to report material")
+		return
+	}
+
+	h.writeJSON(w, api.ReportMaterialOut{
+		ReportUuid:     report.UUID,
+		MaterialHidden: report.MaterialHidden,
+	}, http.StatusOK)
+}
+
+func (h *Handler) ListMaterialReports(w http.ResponseWriter, r *http.Request, params api.ListMaterialReportsParams) {
+	ctx := logger_lib.WithField(r.Context(), key, "ListMaterialReports")
+
+	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	page := 1
+	if params.Page != nil && *params.Page >= 1 {
+		page = *params.Page
+	}
+	limit := 10
+	if params.Limit != nil && *params.Limit >= 1 && *params.Limit <= 100 {
+		limit = *params.Limit
+	}
+	offset := (page - 1) * limit
+
+	var materialUUID string
+	if params.MaterialUuid != nil {
+		materialUUID = *params.MaterialUuid
+	}
+
+	reports, err := h.useCase.ListMaterialReports(r.Context(), materialUUID, offset, limit)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get reports: %v", err))
+		h.writeProblem(w, err, "failed to get reports")
+		return
+	}
+
+	response := api.ListMaterialReportsOut{
+		Reports: make([]api.MaterialReport, 0, len(reports)),
+	}
+	for _, report := range reports {
+		response.Reports = append(response.Reports, reportToAPI(report))
+	}
+
+	h.writeJSON(w, response, http.StatusOK)
+}
+
+func (h *Handler) ResolveMaterialReport(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "ResolveMaterialReport")
+
+	var req api.ResolveMaterialReportIn
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to decode request: %v", err))
+		h.writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	moderatorUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || moderatorUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	report, err := h.useCase.ResolveMaterialReport(r.Context(), req.ReportUuid, moderatorUUID, req.Resolution)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to resolve report: %v", err))
+		h.writeProblem(w, err, "failed to resolve report")
+		return
+	}
+
+	h.writeJSON(w, api.ResolveMaterialReportOut{
+		Report: reportToAPI(*report),
+	}, http.StatusOK)
+}
+
+func (h *Handler) HideMaterial(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "HideMaterial")
+
+	var req api.HideMaterialIn
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to decode request: %v", err))
+		h.writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	var reason string
+	if req.Reason != nil {
+		reason = *req.Reason
+	}
+
+	h.moderateMaterial(ctx, w, r, req.MaterialUuid, reason, true)
+}
+
+func (h *Handler) UnhideMaterial(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "UnhideMaterial")
+
+	var req api.UnhideMaterialIn
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to decode request: %v", err))
+		h.writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	h.moderateMaterial(ctx, w, r, req.MaterialUuid, "", false)
+}
+
+func (h *Handler) moderateMaterial(ctx context.Context, w http.ResponseWriter, r *http.Request, materialUUID, reason string, hide bool) {
+	moderatorUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || moderatorUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	resolved, err := h.useCase.ModerateMaterial(r.Context(), materialUUID, moderatorUUID, reason, hide)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to moderate material: %v", err))
+		h.writeProblem(w, err, "failed to moderate material")
+		return
+	}
+
+	h.writeJSON(w, api.ModerateMaterialOut{
+		ResolvedReports: int32(resolved),
+	}, http.StatusOK)
+}
+
+func (h *Handler) UploadCover(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "UploadCover")
+
+	upload, ok := h.readUpload(ctx, w, r, h.coverMaxBytes)
+	if !ok {
+		return
+	}
+
+	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	uploaded, err := h.useCase.UploadCover(r.Context(), upload.materialUUID, userUUID, upload.data)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to upload cover: %v", err))
+		h.writeProblem(w, err, "failed to upload cover")
+		return
+	}
+
+	h.writeJSON(w, api.UploadCoverOut{
+		CoverImageUrl:   uploaded.URL,
+		CoverThumbnails: coverThumbnailsToAPI(uploaded.Thumbnails),
+	}, http.StatusOK)
+}
+
+func (h *Handler) UploadAttachment(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "UploadAttachment")
+
+	upload, ok := h.readUpload(ctx, w, r, h.attachmentMaxBytes)
+	if !ok {
+		return
+	}
+
+	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	uploaded, err := h.useCase.UploadAttachment(r.Context(), upload.materialUUID, userUUID, upload.filename, upload.data)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to upload attachment: %v", err))
+		h.writeProblem(w, err, "failed to upload attachment")
+		return
+	}
+
+	h.writeJSON(w, api.UploadAttachmentOut{
+		Attachment: attachmentToAPI(*uploaded),
+	}, http.StatusOK)
+}
+
+func (h *Handler) ListMaterialAttachments(w http.ResponseWriter, r *http.Request, params api.ListMaterialAttachmentsParams) {
+	ctx := logger_lib.WithField(r.Context(), key, "ListMaterialAttachments")
+
+	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	attachments, err := h.useCase.ListAttachments(r.Context(), params.MaterialUuid, userUUID)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to list attachments: %v", err))
+		h.writeProblem(w, err, "failed to list attachments")
+		return
+	}
+
+	result := make([]api.MaterialAttachment, 0, len(attachments))
+	for _, a := range attachments {
+		result = append(result, attachmentToAPI(a))
+	}
+
+	h.writeJSON(w, api.ListMaterialAttachmentsOut{
+		Attachments: result,
+	}, http.StatusOK)
+}
+
+func (h *Handler) DeleteMaterialAttachment(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "DeleteMaterialAttachment")
+
+	var req api.DeleteMaterialAttachmentIn
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to decode request: %v", err))
+		h.writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	err := h.useCase.DeleteAttachment(r.Context(), req.MaterialUuid, req.AttachmentUuid, userUUID)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to delete attachment: %v", err))
+		h.writeProblem(w, err, "failed to delete attachment")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ServeAttachment отдаёт файл вложения по ссылке из blob-хранилища. Маршрут не описан в OpenAPI
+// и монтируется на <BaseURL>/attachments/{material_uuid}/{file}: файл видят только те, кому виден материал
+func (h *Handler) ServeAttachment(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "ServeAttachment")
+
+	materialUUID := chi.URLParam(r, "material_uuid")
+	file := chi.URLParam(r, "file")
+	attachmentUUID := strings.TrimSuffix(file, path.Ext(file))
+	if uuid.Validate(materialUUID) != nil || uuid.Validate(attachmentUUID) != nil {
+		h.writeProblem(w, model.NotFoundError("attachment not found"), "")
+		return
+	}
+
+	userUUID, _ := r.Context().Value(config.KeyUUID).(string)
+
+	attachment, data, err := h.useCase.OpenAttachment(r.Context(), materialUUID, attachmentUUID, userUUID)
+	if err != nil {
+		if !errors.Is(err, model.ErrNotFound) {
+			logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to open attachment: %v", err))
+		}
+		h.writeProblem(w, err, "failed to get attachment")
+		return
+	}
+	if path.Base(attachment.StorageKey) != file {
+		h.writeProblem(w, model.NotFoundError("attachment not found"), "")
+		return
+	}
+
+	// видимость материала может смениться в любой момент, поэтому общие кэши файл не хранят
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": attachment.Filename}))
+	w.Header().Set("Cache-Control", "private, no-cache")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeContent(w, r, "", attachment.CreatedAt, bytes.NewReader(data))
+}
+
+// ----------------------------- helpers -----------------------------
+
+func (h *Handler) writeJSON(w http.ResponseWriter, data interface{}, statusCode int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		logger_lib.Error(context.Background(), fmt.Sprintf("failed to encode response: %v", err))
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// resolveContent заменяет ссылки на вложения в content материала на их URL
+func (h *Handler) resolveContent(r *http.Request, material *model.Material) (string, error) {
+	if material.Content == nil {
+		return "", nil
+	}
+	return h.useCase.ResolveContent(r.Context(), material.UUID, *material.Content)
+}
+
+func (h *Handler) reactionsToAPI(counts model.ReactionCounts) []api.ReactionCount {
+	result := make([]api.ReactionCount, 0, len(h.reactionTypes))
+	for _, reaction := range h.reactionTypes {
+		result = append(result, api.ReactionCount{
+			Reaction: reaction,
+			Count:    counts[reaction],
+		})
+	}
+	return result
+}
+
+func (h *Handler) ImportMaterials(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "ImportMaterials")
+
+	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.importMaxBytes+multipartOverhead)
+	if err := r.ParseMultipartForm(h.importMaxBytes); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to parse multipart form: %v", err))
+
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			h.writeError(w, fmt.Sprintf("files are larger than %d bytes", h.importMaxBytes), http.StatusRequestEntityTooLarge)
+		} else {
+			h.writeError(w, "invalid multipart form", http.StatusBadRequest)
+		}
+		return
+	}
+	defer func() {
+		_ = r.MultipartForm.RemoveAll()
+	}()
+
+	dryRun := false
+	if value := r.FormValue("dry_run"); value != "" {
+		var err error
+		dryRun, err = strconv.ParseBool(value)
+		if err != nil {
+			logger_lib.Error(logger_lib.WithError(ctx, err), "invalid dry_run value")
+			h.writeError(w, "dry_run must be a boolean", http.StatusBadRequest)
+			return
+		}
+	}
+
+	headers := r.MultipartForm.File["files"]
+	files := make([]model.ImportFile, 0, len(headers))
+	for _, header := range headers {
+		data, err := readFormFile(header)
+		if err != nil {
+			logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to read file: %v", err))
+			h.writeError(w, "failed to read file", http.StatusBadRequest)
+			return
+		}
+		files = append(files, model.ImportFile{Name: header.Filename, Data: data})
+	}
+
+	report, err := h.useCase.ImportMaterials(r.Context(), userUUID, files, dryRun)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to import materials: %v", err))
+		h.writeProblem(w, err, "failed to import materials")
+		return
+	}
+
+	response := api.ImportMaterialsOut{
+		DryRun:    report.DryRun,
+		Committed: report.Committed,
+		Results:   make([]api.ImportFileResult, 0, len(report.Results)),
+	}
+	for _, result := range report.Results {
+		item := api.ImportFileResult{
+			Filename: result.Filename,
+			Success:  result.Success,
+		}
+		if result.Title != "" {
+			item.Title = &result.Title
+		}
+		if result.Status != "" {
+			item.Status = &result.Status
+		}
+		if result.MaterialUUID != "" {
+			item.MaterialUuid = &result.MaterialUUID
+		}
+		if result.Error != "" {
+			item.Error = &result.Error
+		}
+		response.Results = append(response.Results, item)
+	}
+
+	h.writeJSON(w, response, http.StatusOK)
+}
+
+func (h *Handler) ExportMaterial(w http.ResponseWriter, r *http.Request) {
+	ctx := logger_lib.WithField(r.Context(), key, "ExportMaterial")
+
+	var req api.ExportMaterialIn
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to decode request: %v", err))
+		h.writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	userUUID, ok := r.Context().Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "failed to get user UUID")
+		h.writeError(w, "user UUID is required", http.StatusUnauthorized)
+		return
+	}
+
+	format := ""
+	if req.Format != nil {
+		format = string(*req.Format)
+	}
+
+	export, err := h.useCase.ExportMaterial(r.Context(), req.MaterialUuid, userUUID, format)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to export material: %v", err))
+		h.writeProblem(w, err, "failed to export material")
+		return
+	}
+
+	w.Header().Set("Content-Type", export.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": export.Filename}))
+	w.Header().Set("Content-Length", strconv.Itoa(len(export.Data)))
+	w.WriteHeader(http.StatusOK)
+	if _, err = w.Write(export.Data); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to write export: %v", err))
+	}
+}
+
+type upload struct {
+	materialUUID string
+	filename     string
+	data         []byte
+}
+
+// readUpload разбирает multipart-форму с полями material_uuid и file, ограничивая размер файла maxBytes
+func (h *Handler) readUpload(ctx context.Context, w http.ResponseWriter, r *http.Request, maxBytes int64) (*upload, bool) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxBytes+multipartOverhead)
+	if err := r.ParseMultipartForm(maxBytes); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to parse multipart form: %v", err))
+
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			h.writeError(w, fmt.Sprintf("file is larger than %d bytes", maxBytes), http.StatusRequestEntityTooLarge)
+		} else {
+			h.writeError(w, "invalid multipart form", http.StatusBadRequest)
+		}
+		return nil, false
+	}
+	defer func() {
+		_ = r.MultipartForm.RemoveAll()
+	}()
+
+	materialUUID := r.FormValue("material_uuid")
+	if materialUUID == "" {
+		logger_lib.Error(ctx, "material uuid is required")
+		h.writeError(w, "material uuid is required", http.StatusBadRequest)
+		return nil, false
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get file: %v", err))
+		h.writeError(w, "file is required", http.StatusBadRequest)
+		return nil, false
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	if header.Size > maxBytes {
+		logger_lib.Error(ctx, "file is too large")
+		h.writeError(w, fmt.Sprintf("file is larger than %d bytes", maxBytes), http.StatusRequestEntityTooLarge)
+		return nil, false
+	}
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to read file: %v", err))
+		h.writeError(w, "failed to read file", http.StatusBadRequest)
+		return nil, false
+	}
+
+	return &upload{
+		materialUUID: materialUUID,
+		filename:     header.Filename,
+		data:         data,
+	}, true
+}
+
+func readFormFile(header *multipart.FileHeader) ([]byte, error) {
+	file, err := header.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	return io.ReadAll(file)
+}
+
+func attachmentToAPI(a model.MaterialAttachment) api.MaterialAttachment {
+	return api.MaterialAttachment{
+		Uuid:         a.UUID,
+		MaterialUuid: a.MaterialUUID,
+		Filename:     a.Filename,
+		ContentType:  a.ContentType,
+		SizeBytes:    a.SizeBytes,
+		Url:          a.URL,
+		CreatedAt:    a.CreatedAt,
+	}
+}
+
+func coverThumbnailsToAPI(thumbnails model.CoverThumbnailList) []api.CoverThumbnail {
+	result := make([]api.CoverThumbnail, 0, len(thumbnails))
+	for _, thumbnail := range thumbnails {
+		result = append(result, api.CoverThumbnail{
+			Width: thumbnail.Width,
+			Url:   thumbnail.URL,
+		})
+	}
+	return result
+}
+
+func reportToAPI(report model.MaterialReport) api.MaterialReport {
+	return api.MaterialReport{
+		Uuid:         report.UUID,
+		MaterialUuid: report.MaterialUUID,
+		ReporterUuid: report.ReporterUUID,
+		Reason:       report.Reason,
+		Comment:      report.Comment,
+		Status:       report.Status,
+		CreatedAt:    report.CreatedAt,
+		ResolvedAt:   report.ResolvedAt,
+		ResolvedBy:   report.ResolvedBy,
 	}
 }
@@ -7,311 +7,538 @@ package rest
 import (
 	context "context"
 	reflect "reflect"
-	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/s21platform/materials-service/internal/model"
 )
 
-// MockDBRepo is a mock of DBRepo interface.
-type MockDBRepo struct {
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
 	ctrl     *gomock.Controller
-	recorder *MockDBRepoMockRecorder
+	recorder *MockUseCaseMockRecorder
 }
 
-// MockDBRepoMockRecorder is the mock recorder for MockDBRepo.
-type MockDBRepoMockRecorder struct {
-	mock *MockDBRepo
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
 }
 
-// NewMockDBRepo creates a new mock instance.
-func NewMockDBRepo(ctrl *gomock.Controller) *MockDBRepo {
-	mock := &MockDBRepo{ctrl: ctrl}
-	mock.recorder = &MockDBRepoMockRecorder{mock}
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockDBRepo) EXPECT() *MockDBRepoMockRecorder {
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
 	return m.recorder
 }
 
-// AddLike mocks base method.
-func (m *MockDBRepo) AddLike(ctx context.Context, materialUUID, userUUID string) error {
+// ArchiveMaterial mocks base method.
+func (m *MockUseCase) ArchiveMaterial(ctx context.Context, materialUUID, userUUID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddLike", ctx, materialUUID, userUUID)
+	ret := m.ctrl.Call(m, "ArchiveMaterial", ctx, materialUUID, userUUID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// AddLike indicates an expected call of AddLike.
-func (mr *MockDBRepoMockRecorder) AddLike(ctx, materialUUID, userUUID interface{}) *gomock.Call {
+// ArchiveMaterial indicates an expected call of ArchiveMaterial.
+func (mr *MockUseCaseMockRecorder) ArchiveMaterial(ctx, materialUUID, userUUID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLike", reflect.TypeOf((*MockDBRepo)(nil).AddLike), ctx, materialUUID, userUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveMaterial", reflect.TypeOf((*MockUseCase)(nil).ArchiveMaterial), ctx, materialUUID, userUUID)
 }
 
-// CheckLike mocks base method.
-func (m *MockDBRepo) CheckLike(ctx context.Context, materialUUID, userUUID string) (bool, error) {
+// AutosaveDraft mocks base method.
+func (m *MockUseCase) AutosaveDraft(ctx context.Context, userUUID string, autosave *model.AutosaveDraft) (*model.AutosaveDraft, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckLike", ctx, materialUUID, userUUID)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "AutosaveDraft", ctx, userUUID, autosave)
+	ret0, _ := ret[0].(*model.AutosaveDraft)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CheckLike indicates an expected call of CheckLike.
-func (mr *MockDBRepoMockRecorder) CheckLike(ctx, materialUUID, userUUID interface{}) *gomock.Call {
+// AutosaveDraft indicates an expected call of AutosaveDraft.
+func (mr *MockUseCaseMockRecorder) AutosaveDraft(ctx, userUUID, autosave interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLike", reflect.TypeOf((*MockDBRepo)(nil).CheckLike), ctx, materialUUID, userUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutosaveDraft", reflect.TypeOf((*MockUseCase)(nil).AutosaveDraft), ctx, userUUID, autosave)
+}
+
+// BulkOperation mocks base method.
+func (m *MockUseCase) BulkOperation(ctx context.Context, action, userUUID string, uuids, tags []string) (model.BulkResultList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkOperation", ctx, action, userUUID, uuids, tags)
+	ret0, _ := ret[0].(model.BulkResultList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkOperation indicates an expected call of BulkOperation.
+func (mr *MockUseCaseMockRecorder) BulkOperation(ctx, action, userUUID, uuids, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkOperation", reflect.TypeOf((*MockUseCase)(nil).BulkOperation), ctx, action, userUUID, uuids, tags)
+}
+
+// ChangeReaction mocks base method.
+func (m *MockUseCase) ChangeReaction(ctx context.Context, materialUUID, userUUID, reaction string, set bool) (*model.ReactionState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeReaction", ctx, materialUUID, userUUID, reaction, set)
+	ret0, _ := ret[0].(*model.ReactionState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeReaction indicates an expected call of ChangeReaction.
+func (mr *MockUseCaseMockRecorder) ChangeReaction(ctx, materialUUID, userUUID, reaction, set interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeReaction", reflect.TypeOf((*MockUseCase)(nil).ChangeReaction), ctx, materialUUID, userUUID, reaction, set)
+}
+
+// DeleteAttachment mocks base method.
+func (m *MockUseCase) DeleteAttachment(ctx context.Context, materialUUID, attachmentUUID, userUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachment", ctx, materialUUID, attachmentUUID, userUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachment indicates an expected call of DeleteAttachment.
+func (mr *MockUseCaseMockRecorder) DeleteAttachment(ctx, materialUUID, attachmentUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachment", reflect.TypeOf((*MockUseCase)(nil).DeleteAttachment), ctx, materialUUID, attachmentUUID, userUUID)
+}
+
+// DeleteMaterial mocks base method.
+func (m *MockUseCase) DeleteMaterial(ctx context.Context, materialUUID, userUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMaterial", ctx, materialUUID, userUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMaterial indicates an expected call of DeleteMaterial.
+func (mr *MockUseCaseMockRecorder) DeleteMaterial(ctx, materialUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMaterial", reflect.TypeOf((*MockUseCase)(nil).DeleteMaterial), ctx, materialUUID, userUUID)
+}
+
+// DuplicateMaterial mocks base method.
+func (m *MockUseCase) DuplicateMaterial(ctx context.Context, materialUUID, userUUID string) (*model.Material, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DuplicateMaterial", ctx, materialUUID, userUUID)
+	ret0, _ := ret[0].(*model.Material)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DuplicateMaterial indicates an expected call of DuplicateMaterial.
+func (mr *MockUseCaseMockRecorder) DuplicateMaterial(ctx, materialUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DuplicateMaterial", reflect.TypeOf((*MockUseCase)(nil).DuplicateMaterial), ctx, materialUUID, userUUID)
 }
 
 // EditMaterial mocks base method.
-func (m *MockDBRepo) EditMaterial(ctx context.Context, material *model.EditMaterial) (*model.Material, error) {
+func (m *MockUseCase) EditMaterial(ctx context.Context, userUUID string, material *model.EditMaterial) (*model.Material, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EditMaterial", ctx, material)
+	ret := m.ctrl.Call(m, "EditMaterial", ctx, userUUID, material)
 	ret0, _ := ret[0].(*model.Material)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EditMaterial indicates an expected call of EditMaterial.
-func (mr *MockDBRepoMockRecorder) EditMaterial(ctx, material interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) EditMaterial(ctx, userUUID, material interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditMaterial", reflect.TypeOf((*MockUseCase)(nil).EditMaterial), ctx, userUUID, material)
+}
+
+// ExportMaterial mocks base method.
+func (m *MockUseCase) ExportMaterial(ctx context.Context, materialUUID, userUUID, format string) (*model.MaterialExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportMaterial", ctx, materialUUID, userUUID, format)
+	ret0, _ := ret[0].(*model.MaterialExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportMaterial indicates an expected call of ExportMaterial.
+func (mr *MockUseCaseMockRecorder) ExportMaterial(ctx, materialUUID, userUUID, format interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditMaterial", reflect.TypeOf((*MockDBRepo)(nil).EditMaterial), ctx, material)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportMaterial", reflect.TypeOf((*MockUseCase)(nil).ExportMaterial), ctx, materialUUID, userUUID, format)
 }
 
 // GetAllMaterials mocks base method.
-func (m *MockDBRepo) GetAllMaterials(ctx context.Context, offset, limit int) (*model.MaterialList, error) {
+func (m *MockUseCase) GetAllMaterials(ctx context.Context, offset, limit int, includeArchived bool) (model.MaterialList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllMaterials", ctx, offset, limit)
-	ret0, _ := ret[0].(*model.MaterialList)
+	ret := m.ctrl.Call(m, "GetAllMaterials", ctx, offset, limit, includeArchived)
+	ret0, _ := ret[0].(model.MaterialList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllMaterials indicates an expected call of GetAllMaterials.
-func (mr *MockDBRepoMockRecorder) GetAllMaterials(ctx, offset, limit interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetAllMaterials(ctx, offset, limit, includeArchived interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllMaterials", reflect.TypeOf((*MockUseCase)(nil).GetAllMaterials), ctx, offset, limit, includeArchived)
+}
+
+// GetArchivedMaterials mocks base method.
+func (m *MockUseCase) GetArchivedMaterials(ctx context.Context, userUUID string, offset, limit int) (model.MaterialList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchivedMaterials", ctx, userUUID, offset, limit)
+	ret0, _ := ret[0].(model.MaterialList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchivedMaterials indicates an expected call of GetArchivedMaterials.
+func (mr *MockUseCaseMockRecorder) GetArchivedMaterials(ctx, userUUID, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllMaterials", reflect.TypeOf((*MockDBRepo)(nil).GetAllMaterials), ctx, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivedMaterials", reflect.TypeOf((*MockUseCase)(nil).GetArchivedMaterials), ctx, userUUID, offset, limit)
 }
 
-// GetLikesCount mocks base method.
-func (m *MockDBRepo) GetLikesCount(ctx context.Context, materialUUID string) (int32, error) {
+// GetDeletedMaterials mocks base method.
+func (m *MockUseCase) GetDeletedMaterials(ctx context.Context, userUUID string, offset, limit int) (model.MaterialList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLikesCount", ctx, materialUUID)
-	ret0, _ := ret[0].(int32)
+	ret := m.ctrl.Call(m, "GetDeletedMaterials", ctx, userUUID, offset, limit)
+	ret0, _ := ret[0].(model.MaterialList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLikesCount indicates an expected call of GetLikesCount.
-func (mr *MockDBRepoMockRecorder) GetLikesCount(ctx, materialUUID interface{}) *gomock.Call {
+// GetDeletedMaterials indicates an expected call of GetDeletedMaterials.
+func (mr *MockUseCaseMockRecorder) GetDeletedMaterials(ctx, userUUID, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikesCount", reflect.TypeOf((*MockDBRepo)(nil).GetLikesCount), ctx, materialUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedMaterials", reflect.TypeOf((*MockUseCase)(nil).GetDeletedMaterials), ctx, userUUID, offset, limit)
 }
 
 // GetMaterial mocks base method.
-func (m *MockDBRepo) GetMaterial(ctx context.Context, materialUUID string) (*model.Material, error) {
+func (m *MockUseCase) GetMaterial(ctx context.Context, materialUUID, userUUID string) (*model.MaterialView, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMaterial", ctx, materialUUID)
-	ret0, _ := ret[0].(*model.Material)
+	ret := m.ctrl.Call(m, "GetMaterial", ctx, materialUUID, userUUID)
+	ret0, _ := ret[0].(*model.MaterialView)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMaterial indicates an expected call of GetMaterial.
-func (mr *MockDBRepoMockRecorder) GetMaterial(ctx, materialUUID interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetMaterial(ctx, materialUUID, userUUID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaterial", reflect.TypeOf((*MockDBRepo)(nil).GetMaterial), ctx, materialUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaterial", reflect.TypeOf((*MockUseCase)(nil).GetMaterial), ctx, materialUUID, userUUID)
 }
 
-// GetMaterialOwnerUUID mocks base method.
-func (m *MockDBRepo) GetMaterialOwnerUUID(ctx context.Context, materialUUID string) (string, error) {
+// GetMaterialsByUUIDs mocks base method.
+func (m *MockUseCase) GetMaterialsByUUIDs(ctx context.Context, uuids []string, userUUID string) (model.MaterialList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMaterialOwnerUUID", ctx, materialUUID)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetMaterialsByUUIDs", ctx, uuids, userUUID)
+	ret0, _ := ret[0].(model.MaterialList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMaterialOwnerUUID indicates an expected call of GetMaterialOwnerUUID.
-func (mr *MockDBRepoMockRecorder) GetMaterialOwnerUUID(ctx, materialUUID interface{}) *gomock.Call {
+// GetMaterialsByUUIDs indicates an expected call of GetMaterialsByUUIDs.
+func (mr *MockUseCaseMockRecorder) GetMaterialsByUUIDs(ctx, uuids, userUUID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaterialOwnerUUID", reflect.TypeOf((*MockDBRepo)(nil).GetMaterialOwnerUUID), ctx, materialUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaterialsByUUIDs", reflect.TypeOf((*MockUseCase)(nil).GetMaterialsByUUIDs), ctx, uuids, userUUID)
 }
 
-// MaterialExists mocks base method.
-func (m *MockDBRepo) MaterialExists(ctx context.Context, materialUUID string) (bool, error) {
+// GetRelated mocks base method.
+func (m *MockUseCase) GetRelated(ctx context.Context, materialUUID, userUUID string, offset, limit int) (model.MaterialList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MaterialExists", ctx, materialUUID)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "GetRelated", ctx, materialUUID, userUUID, offset, limit)
+	ret0, _ := ret[0].(model.MaterialList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// MaterialExists indicates an expected call of MaterialExists.
-func (mr *MockDBRepoMockRecorder) MaterialExists(ctx, materialUUID interface{}) *gomock.Call {
+// GetRelated indicates an expected call of GetRelated.
+func (mr *MockUseCaseMockRecorder) GetRelated(ctx, materialUUID, userUUID, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaterialExists", reflect.TypeOf((*MockDBRepo)(nil).MaterialExists), ctx, materialUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelated", reflect.TypeOf((*MockUseCase)(nil).GetRelated), ctx, materialUUID, userUUID, offset, limit)
 }
 
-// PublishMaterial mocks base method.
-func (m *MockDBRepo) PublishMaterial(ctx context.Context, materialUUID string) (*model.Material, error) {
+// GetTrending mocks base method.
+func (m *MockUseCase) GetTrending(ctx context.Context, tag string, offset, limit int) (model.MaterialList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PublishMaterial", ctx, materialUUID)
-	ret0, _ := ret[0].(*model.Material)
+	ret := m.ctrl.Call(m, "GetTrending", ctx, tag, offset, limit)
+	ret0, _ := ret[0].(model.MaterialList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// PublishMaterial indicates an expected call of PublishMaterial.
-func (mr *MockDBRepoMockRecorder) PublishMaterial(ctx, materialUUID interface{}) *gomock.Call {
+// GetTrending indicates an expected call of GetTrending.
+func (mr *MockUseCaseMockRecorder) GetTrending(ctx, tag, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishMaterial", reflect.TypeOf((*MockDBRepo)(nil).PublishMaterial), ctx, materialUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrending", reflect.TypeOf((*MockUseCase)(nil).GetTrending), ctx, tag, offset, limit)
 }
 
-// RemoveLike mocks base method.
-func (m *MockDBRepo) RemoveLike(ctx context.Context, materialUUID, userUUID string) error {
+// ImportMaterials mocks base method.
+func (m *MockUseCase) ImportMaterials(ctx context.Context, ownerUUID string, files []model.ImportFile, dryRun bool) (*model.ImportReport, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveLike", ctx, materialUUID, userUUID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ImportMaterials", ctx, ownerUUID, files, dryRun)
+	ret0, _ := ret[0].(*model.ImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// RemoveLike indicates an expected call of RemoveLike.
-func (mr *MockDBRepoMockRecorder) RemoveLike(ctx, materialUUID, userUUID interface{}) *gomock.Call {
+// ImportMaterials indicates an expected call of ImportMaterials.
+func (mr *MockUseCaseMockRecorder) ImportMaterials(ctx, ownerUUID, files, dryRun interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLike", reflect.TypeOf((*MockDBRepo)(nil).RemoveLike), ctx, materialUUID, userUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportMaterials", reflect.TypeOf((*MockUseCase)(nil).ImportMaterials), ctx, ownerUUID, files, dryRun)
 }
 
-// SaveDraftMaterial mocks base method.
-func (m *MockDBRepo) SaveDraftMaterial(ctx context.Context, ownerUUID string, material *model.SaveDraftMaterial) (string, error) {
+// ListAttachments mocks base method.
+func (m *MockUseCase) ListAttachments(ctx context.Context, materialUUID, userUUID string) (model.MaterialAttachmentList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveDraftMaterial", ctx, ownerUUID, material)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ListAttachments", ctx, materialUUID, userUUID)
+	ret0, _ := ret[0].(model.MaterialAttachmentList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SaveDraftMaterial indicates an expected call of SaveDraftMaterial.
-func (mr *MockDBRepoMockRecorder) SaveDraftMaterial(ctx, ownerUUID, material interface{}) *gomock.Call {
+// ListAttachments indicates an expected call of ListAttachments.
+func (mr *MockUseCaseMockRecorder) ListAttachments(ctx, materialUUID, userUUID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDraftMaterial", reflect.TypeOf((*MockDBRepo)(nil).SaveDraftMaterial), ctx, ownerUUID, material)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttachments", reflect.TypeOf((*MockUseCase)(nil).ListAttachments), ctx, materialUUID, userUUID)
 }
 
-// UpdateLikesCount mocks base method.
-func (m *MockDBRepo) UpdateLikesCount(ctx context.Context, materialUUID string, likesCount int32) error {
+// ListLikers mocks base method.
+func (m *MockUseCase) ListLikers(ctx context.Context, materialUUID, userUUID string, offset, limit int) (model.MaterialLikerList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateLikesCount", ctx, materialUUID, likesCount)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListLikers", ctx, materialUUID, userUUID, offset, limit)
+	ret0, _ := ret[0].(model.MaterialLikerList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateLikesCount indicates an expected call of UpdateLikesCount.
-func (mr *MockDBRepoMockRecorder) UpdateLikesCount(ctx, materialUUID, likesCount interface{}) *gomock.Call {
+// ListLikers indicates an expected call of ListLikers.
+func (mr *MockUseCaseMockRecorder) ListLikers(ctx, materialUUID, userUUID, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLikesCount", reflect.TypeOf((*MockDBRepo)(nil).UpdateLikesCount), ctx, materialUUID, likesCount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLikers", reflect.TypeOf((*MockUseCase)(nil).ListLikers), ctx, materialUUID, userUUID, offset, limit)
 }
 
-// WithTx mocks base method.
-func (m *MockDBRepo) WithTx(ctx context.Context, cb func(context.Context) error) error {
+// ListMaterialReports mocks base method.
+func (m *MockUseCase) ListMaterialReports(ctx context.Context, materialUUID string, offset, limit int) (model.MaterialReportList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WithTx", ctx, cb)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListMaterialReports", ctx, materialUUID, offset, limit)
+	ret0, _ := ret[0].(model.MaterialReportList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// WithTx indicates an expected call of WithTx.
-func (mr *MockDBRepoMockRecorder) WithTx(ctx, cb interface{}) *gomock.Call {
+// ListMaterialReports indicates an expected call of ListMaterialReports.
+func (mr *MockUseCaseMockRecorder) ListMaterialReports(ctx, materialUUID, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockDBRepo)(nil).WithTx), ctx, cb)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMaterialReports", reflect.TypeOf((*MockUseCase)(nil).ListMaterialReports), ctx, materialUUID, offset, limit)
 }
 
-// MockKafkaProducer is a mock of KafkaProducer interface.
-type MockKafkaProducer struct {
-	ctrl     *gomock.Controller
-	recorder *MockKafkaProducerMockRecorder
+// ModerateMaterial mocks base method.
+func (m *MockUseCase) ModerateMaterial(ctx context.Context, materialUUID, moderatorUUID, reason string, hide bool) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModerateMaterial", ctx, materialUUID, moderatorUUID, reason, hide)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockKafkaProducerMockRecorder is the mock recorder for MockKafkaProducer.
-type MockKafkaProducerMockRecorder struct {
-	mock *MockKafkaProducer
+// ModerateMaterial indicates an expected call of ModerateMaterial.
+func (mr *MockUseCaseMockRecorder) ModerateMaterial(ctx, materialUUID, moderatorUUID, reason, hide interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateMaterial", reflect.TypeOf((*MockUseCase)(nil).ModerateMaterial), ctx, materialUUID, moderatorUUID, reason, hide)
 }
 
-// NewMockKafkaProducer creates a new mock instance.
-func NewMockKafkaProducer(ctrl *gomock.Controller) *MockKafkaProducer {
-	mock := &MockKafkaProducer{ctrl: ctrl}
-	mock.recorder = &MockKafkaProducerMockRecorder{mock}
-	return mock
+// OpenAttachment mocks base method.
+func (m *MockUseCase) OpenAttachment(ctx context.Context, materialUUID, attachmentUUID, userUUID string) (*model.MaterialAttachment, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenAttachment", ctx, materialUUID, attachmentUUID, userUUID)
+	ret0, _ := ret[0].(*model.MaterialAttachment)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockKafkaProducer) EXPECT() *MockKafkaProducerMockRecorder {
-	return m.recorder
+// OpenAttachment indicates an expected call of OpenAttachment.
+func (mr *MockUseCaseMockRecorder) OpenAttachment(ctx, materialUUID, attachmentUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenAttachment", reflect.TypeOf((*MockUseCase)(nil).OpenAttachment), ctx, materialUUID, attachmentUUID, userUUID)
 }
 
-// ProduceMessage mocks base method.
-func (m *MockKafkaProducer) ProduceMessage(ctx context.Context, message, key interface{}) error {
+// PromoteAutosave mocks base method.
+func (m *MockUseCase) PromoteAutosave(ctx context.Context, materialUUID, userUUID string) (*model.Material, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ProduceMessage", ctx, message, key)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "PromoteAutosave", ctx, materialUUID, userUUID)
+	ret0, _ := ret[0].(*model.Material)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ProduceMessage indicates an expected call of ProduceMessage.
-func (mr *MockKafkaProducerMockRecorder) ProduceMessage(ctx, message, key interface{}) *gomock.Call {
+// PromoteAutosave indicates an expected call of PromoteAutosave.
+func (mr *MockUseCaseMockRecorder) PromoteAutosave(ctx, materialUUID, userUUID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProduceMessage", reflect.TypeOf((*MockKafkaProducer)(nil).ProduceMessage), ctx, message, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteAutosave", reflect.TypeOf((*MockUseCase)(nil).PromoteAutosave), ctx, materialUUID, userUUID)
 }
 
-// MockRedisRepo is a mock of RedisRepo interface.
-type MockRedisRepo struct {
-	ctrl     *gomock.Controller
-	recorder *MockRedisRepoMockRecorder
+// PublishMaterial mocks base method.
+func (m *MockUseCase) PublishMaterial(ctx context.Context, materialUUID, userUUID string) (*model.Material, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishMaterial", ctx, materialUUID, userUUID)
+	ret0, _ := ret[0].(*model.Material)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockRedisRepoMockRecorder is the mock recorder for MockRedisRepo.
-type MockRedisRepoMockRecorder struct {
-	mock *MockRedisRepo
+// PublishMaterial indicates an expected call of PublishMaterial.
+func (mr *MockUseCaseMockRecorder) PublishMaterial(ctx, materialUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishMaterial", reflect.TypeOf((*MockUseCase)(nil).PublishMaterial), ctx, materialUUID, userUUID)
 }
 
-// NewMockRedisRepo creates a new mock instance.
-func NewMockRedisRepo(ctrl *gomock.Controller) *MockRedisRepo {
-	mock := &MockRedisRepo{ctrl: ctrl}
-	mock.recorder = &MockRedisRepoMockRecorder{mock}
-	return mock
+// ReportMaterial mocks base method.
+func (m *MockUseCase) ReportMaterial(ctx context.Context, materialUUID, userUUID, reason, comment string) (*model.CreatedReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportMaterial", ctx, materialUUID, userUUID, reason, comment)
+	ret0, _ := ret[0].(*model.CreatedReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockRedisRepo) EXPECT() *MockRedisRepoMockRecorder {
-	return m.recorder
+// ReportMaterial indicates an expected call of ReportMaterial.
+func (mr *MockUseCaseMockRecorder) ReportMaterial(ctx, materialUUID, userUUID, reason, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportMaterial", reflect.TypeOf((*MockUseCase)(nil).ReportMaterial), ctx, materialUUID, userUUID, reason, comment)
 }
 
-// GetMaterial mocks base method.
-func (m *MockRedisRepo) GetMaterial(ctx context.Context, uuid string) (*model.Material, error) {
+// ResolveContent mocks base method.
+func (m *MockUseCase) ResolveContent(ctx context.Context, materialUUID, content string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveContent", ctx, materialUUID, content)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveContent indicates an expected call of ResolveContent.
+func (mr *MockUseCaseMockRecorder) ResolveContent(ctx, materialUUID, content interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveContent", reflect.TypeOf((*MockUseCase)(nil).ResolveContent), ctx, materialUUID, content)
+}
+
+// ResolveMaterialReport mocks base method.
+func (m *MockUseCase) ResolveMaterialReport(ctx context.Context, reportUUID, moderatorUUID, resolution string) (*model.MaterialReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveMaterialReport", ctx, reportUUID, moderatorUUID, resolution)
+	ret0, _ := ret[0].(*model.MaterialReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveMaterialReport indicates an expected call of ResolveMaterialReport.
+func (mr *MockUseCaseMockRecorder) ResolveMaterialReport(ctx, reportUUID, moderatorUUID, resolution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveMaterialReport", reflect.TypeOf((*MockUseCase)(nil).ResolveMaterialReport), ctx, reportUUID, moderatorUUID, resolution)
+}
+
+// RestoreMaterial mocks base method.
+func (m *MockUseCase) RestoreMaterial(ctx context.Context, materialUUID, userUUID string) (*model.Material, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMaterial", ctx, uuid)
+	ret := m.ctrl.Call(m, "RestoreMaterial", ctx, materialUUID, userUUID)
 	ret0, _ := ret[0].(*model.Material)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMaterial indicates an expected call of GetMaterial.
-func (mr *MockRedisRepoMockRecorder) GetMaterial(ctx, uuid interface{}) *gomock.Call {
+// RestoreMaterial indicates an expected call of RestoreMaterial.
+func (mr *MockUseCaseMockRecorder) RestoreMaterial(ctx, materialUUID, userUUID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaterial", reflect.TypeOf((*MockRedisRepo)(nil).GetMaterial), ctx, uuid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreMaterial", reflect.TypeOf((*MockUseCase)(nil).RestoreMaterial), ctx, materialUUID, userUUID)
 }
 
-// SetMaterial mocks base method.
-func (m *MockRedisRepo) SetMaterial(ctx context.Context, material *model.Material, ttl time.Duration) error {
+// SaveDraftMaterial mocks base method.
+func (m *MockUseCase) SaveDraftMaterial(ctx context.Context, ownerUUID string, material *model.SaveDraftMaterial) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetMaterial", ctx, material, ttl)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "SaveDraftMaterial", ctx, ownerUUID, material)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveDraftMaterial indicates an expected call of SaveDraftMaterial.
+func (mr *MockUseCaseMockRecorder) SaveDraftMaterial(ctx, ownerUUID, material interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDraftMaterial", reflect.TypeOf((*MockUseCase)(nil).SaveDraftMaterial), ctx, ownerUUID, material)
+}
+
+// SetLike mocks base method.
+func (m *MockUseCase) SetLike(ctx context.Context, materialUUID, userUUID string, liked bool) (*model.LikeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLike", ctx, materialUUID, userUUID, liked)
+	ret0, _ := ret[0].(*model.LikeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLike indicates an expected call of SetLike.
+func (mr *MockUseCaseMockRecorder) SetLike(ctx, materialUUID, userUUID, liked interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLike", reflect.TypeOf((*MockUseCase)(nil).SetLike), ctx, materialUUID, userUUID, liked)
+}
+
+// ToggleLike mocks base method.
+func (m *MockUseCase) ToggleLike(ctx context.Context, materialUUID, userUUID string) (*model.LikeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToggleLike", ctx, materialUUID, userUUID)
+	ret0, _ := ret[0].(*model.LikeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToggleLike indicates an expected call of ToggleLike.
+func (mr *MockUseCaseMockRecorder) ToggleLike(ctx, materialUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToggleLike", reflect.TypeOf((*MockUseCase)(nil).ToggleLike), ctx, materialUUID, userUUID)
+}
+
+// UnarchiveMaterial mocks base method.
+func (m *MockUseCase) UnarchiveMaterial(ctx context.Context, materialUUID, userUUID string) (*model.Material, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnarchiveMaterial", ctx, materialUUID, userUUID)
+	ret0, _ := ret[0].(*model.Material)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnarchiveMaterial indicates an expected call of UnarchiveMaterial.
+func (mr *MockUseCaseMockRecorder) UnarchiveMaterial(ctx, materialUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveMaterial", reflect.TypeOf((*MockUseCase)(nil).UnarchiveMaterial), ctx, materialUUID, userUUID)
+}
+
+// UploadAttachment mocks base method.
+func (m *MockUseCase) UploadAttachment(ctx context.Context, materialUUID, userUUID, filename string, data []byte) (*model.MaterialAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadAttachment", ctx, materialUUID, userUUID, filename, data)
+	ret0, _ := ret[0].(*model.MaterialAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadAttachment indicates an expected call of UploadAttachment.
+func (mr *MockUseCaseMockRecorder) UploadAttachment(ctx, materialUUID, userUUID, filename, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*MockUseCase)(nil).UploadAttachment), ctx, materialUUID, userUUID, filename, data)
+}
+
+// UploadCover mocks base method.
+func (m *MockUseCase) UploadCover(ctx context.Context, materialUUID, userUUID string, data []byte) (*model.Cover, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadCover", ctx, materialUUID, userUUID, data)
+	ret0, _ := ret[0].(*model.Cover)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SetMaterial indicates an expected call of SetMaterial.
-func (mr *MockRedisRepoMockRecorder) SetMaterial(ctx, material, ttl interface{}) *gomock.Call {
+// UploadCover indicates an expected call of UploadCover.
+func (mr *MockUseCaseMockRecorder) UploadCover(ctx, materialUUID, userUUID, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaterial", reflect.TypeOf((*MockRedisRepo)(nil).SetMaterial), ctx, material, ttl)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadCover", reflect.TypeOf((*MockUseCase)(nil).UploadCover), ctx, materialUUID, userUUID, data)
 }
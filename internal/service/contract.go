@@ -7,23 +7,39 @@ import (
 	"github.com/s21platform/materials-service/internal/model"
 )
 
-type DBRepo interface {
+type UseCase interface {
 	SaveDraftMaterial(ctx context.Context, ownerUUID string, material *model.SaveDraftMaterial) (string, error)
-	GetMaterial(ctx context.Context, uuid string) (*model.Material, error)
-	EditMaterial(ctx context.Context, material *model.EditMaterial) (*model.Material, error)
-	GetMaterialOwnerUUID(ctx context.Context, uuid string) (string, error)
-	PublishMaterial(ctx context.Context, uuid string) (*model.Material, error)
-	MaterialExists(ctx context.Context, materialUUID string) (bool, error)
-	DeleteMaterial(ctx context.Context, uuid string) (int64, error)
-	ArchivedMaterial(ctx context.Context, uuid string) (int64, error)
-	CheckLike(ctx context.Context, materialUUID string, userUUID string) (bool, error)
-	AddLike(ctx context.Context, materialUUID string, userUUID string) error
-	RemoveLike(ctx context.Context, materialUUID string, userUUID string) error
-	GetLikesCount(ctx context.Context, materialUUID string) (int32, error)
-	UpdateLikesCount(ctx context.Context, materialUUID string, likesCount int32) error
-	WithTx(ctx context.Context, cb func(ctx context.Context) error) (err error)
-}
-
-type KafkaProducer interface {
-	ProduceMessage(ctx context.Context, message interface{}, key interface{}) error
+	GetMaterial(ctx context.Context, materialUUID, userUUID string) (*model.MaterialView, error)
+	AutosaveDraft(ctx context.Context, userUUID string, autosave *model.AutosaveDraft) (*model.AutosaveDraft, error)
+	PromoteAutosave(ctx context.Context, materialUUID, userUUID string) (*model.Material, error)
+	DuplicateMaterial(ctx context.Context, materialUUID, userUUID string) (*model.Material, error)
+	BulkOperation(ctx context.Context, action, userUUID string, uuids, tags []string) (model.BulkResultList, error)
+	ChangeReaction(ctx context.Context, materialUUID, userUUID, reaction string, set bool) (*model.ReactionState, error)
+	ReportMaterial(ctx context.Context, materialUUID, userUUID, reason, comment string) (*model.CreatedReport, error)
+	ListMaterialReports(ctx context.Context, materialUUID string, offset, limit int) (model.MaterialReportList, error)
+	ResolveMaterialReport(ctx context.Context, reportUUID, moderatorUUID, resolution string) (*model.MaterialReport, error)
+	ModerateMaterial(ctx context.Context, materialUUID, moderatorUUID, reason string, hide bool) (int64, error)
+	PublishMaterial(ctx context.Context, materialUUID, userUUID string) (*model.Material, error)
+	EditMaterial(ctx context.Context, userUUID string, material *model.EditMaterial) (*model.Material, error)
+	ToggleLike(ctx context.Context, materialUUID, userUUID string) (*model.LikeResult, error)
+	SetLike(ctx context.Context, materialUUID, userUUID string, liked bool) (*model.LikeResult, error)
+	DeleteMaterial(ctx context.Context, materialUUID, userUUID string) error
+	ArchiveMaterial(ctx context.Context, materialUUID, userUUID string) error
+	UnarchiveMaterial(ctx context.Context, materialUUID, userUUID string) (*model.Material, error)
+	RestoreMaterial(ctx context.Context, materialUUID, userUUID string) (*model.Material, error)
+	GetMaterialsByUUIDs(ctx context.Context, uuids []string, userUUID string) (model.MaterialList, error)
+	ExportMaterial(ctx context.Context, materialUUID, userUUID, format string) (*model.MaterialExport, error)
+	GetAllMaterials(ctx context.Context, offset, limit int, includeArchived bool) (model.MaterialList, error)
+	GetDeletedMaterials(ctx context.Context, userUUID string, offset, limit int) (model.MaterialList, error)
+	GetArchivedMaterials(ctx context.Context, userUUID string, offset, limit int) (model.MaterialList, error)
+	ListLikers(ctx context.Context, materialUUID, userUUID string, offset, limit int) (model.MaterialLikerList, error)
+	GetTrending(ctx context.Context, tag string, offset, limit int) (model.MaterialList, error)
+	GetRelated(ctx context.Context, materialUUID, userUUID string, offset, limit int) (model.MaterialList, error)
+	UploadCover(ctx context.Context, materialUUID, userUUID string, data []byte) (*model.Cover, error)
+	UploadAttachment(ctx context.Context, materialUUID, userUUID, filename string, data []byte) (*model.MaterialAttachment, error)
+	ListAttachments(ctx context.Context, materialUUID, userUUID string) (model.MaterialAttachmentList, error)
+	DeleteAttachment(ctx context.Context, materialUUID, attachmentUUID, userUUID string) error
+	ResolveContent(ctx context.Context, materialUUID, content string) (string, error)
+	ExportMaterials(ctx context.Context, userUUID string, filter model.ExportFilter, fn func(model.MaterialList) error) error
+	ExportUserData(ctx context.Context, userUUID string) (*model.UserData, error)
 }
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/s21platform/materials-service/internal/model"
+)
+
+const errorDomain = "materials-service"
+
+// statusError переводит ошибку в gRPC-статус. Доменная ошибка получает код своей категории
+// и детали ErrorInfo (и BadRequest для ошибок валидации), остальные — codes.Internal с префиксом message.
+func statusError(err error, message string) error {
+	var domainErr *model.Error
+	if !errors.As(err, &domainErr) {
+		return status.Errorf(codes.Internal, "%s: %v", message, err)
+	}
+
+	st := status.New(grpcCode(domainErr.Kind), domainErr.Message)
+
+	details := []protoadapt.MessageV1{
+		&errdetails.ErrorInfo{
+			Reason: string(domainErr.Kind),
+			Domain: errorDomain,
+		},
+	}
+	if domainErr.Field != "" {
+		details = append(details, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: domainErr.Field, Description: domainErr.Message},
+			},
+		})
+	}
+
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+func grpcCode(kind model.ErrorKind) codes.Code {
+	switch kind {
+	case model.ErrorKindNotFound:
+		return codes.NotFound
+	case model.ErrorKindForbidden:
+		return codes.PermissionDenied
+	case model.ErrorKindConflict:
+		return codes.AlreadyExists
+	case model.ErrorKindValidation:
+		return codes.InvalidArgument
+	case model.ErrorKindPrecondition:
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
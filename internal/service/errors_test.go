@@ -0,0 +1,80 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/s21platform/materials-service/internal/model"
+)
+
+func TestStatusError(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+		wantMsg  string
+	}{
+		{"not_found", model.NotFoundError("material does not exist"), codes.NotFound, "material does not exist"},
+		{"forbidden", model.ForbiddenError("user is not owner"), codes.PermissionDenied, "user is not owner"},
+		{"conflict", model.ConflictError("already reported"), codes.AlreadyExists, "already reported"},
+		{"validation", model.ValidationError("uuid", "material uuid is required"), codes.InvalidArgument, "material uuid is required"},
+		{"precondition", model.PreconditionError("autosave has empty title"), codes.FailedPrecondition, "autosave has empty title"},
+		{"wrapped", fmt.Errorf("failed to get material: %w", model.NotFoundError("material does not exist")), codes.NotFound, "material does not exist"},
+		{"internal", fmt.Errorf("connection refused"), codes.Internal, "failed to do: connection refused"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			st, ok := status.FromError(statusError(tc.err, "failed to do"))
+			require.True(t, ok)
+			assert.Equal(t, tc.wantCode, st.Code())
+			assert.Equal(t, tc.wantMsg, st.Message())
+		})
+	}
+}
+
+func TestStatusError_Details(t *testing.T) {
+	t.Parallel()
+
+	t.Run("domain_error_has_error_info", func(t *testing.T) {
+		t.Parallel()
+
+		st := status.Convert(statusError(model.ForbiddenError("user is not owner"), "failed"))
+
+		require.Len(t, st.Details(), 1)
+		info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+		require.True(t, ok)
+		assert.Equal(t, string(model.ErrorKindForbidden), info.Reason)
+		assert.Equal(t, errorDomain, info.Domain)
+	})
+
+	t.Run("validation_error_has_field_violation", func(t *testing.T) {
+		t.Parallel()
+
+		st := status.Convert(statusError(model.ValidationError("title", "title is required"), "failed"))
+
+		require.Len(t, st.Details(), 2)
+		badRequest, ok := st.Details()[1].(*errdetails.BadRequest)
+		require.True(t, ok)
+		require.Len(t, badRequest.FieldViolations, 1)
+		assert.Equal(t, "title", badRequest.FieldViolations[0].Field)
+		assert.Equal(t, "title is required", badRequest.FieldViolations[0].Description)
+	})
+
+	t.Run("internal_error_has_no_details", func(t *testing.T) {
+		t.Parallel()
+
+		st := status.Convert(statusError(fmt.Errorf("db error"), "failed"))
+
+		assert.Empty(t, st.Details())
+	})
+}
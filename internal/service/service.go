@@ -2,39 +2,43 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
+	"io"
 
 	logger_lib "github.com/s21platform/logger-lib"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/s21platform/materials-service/internal/config"
 	"github.com/s21platform/materials-service/internal/model"
-	"github.com/s21platform/materials-service/internal/pkg/tx"
 	"github.com/s21platform/materials-service/pkg/materials"
 )
 
 type Service struct {
 	materials.UnimplementedMaterialsServiceServer
-	repository DBRepo
+	useCase            UseCase
+	reactionTypes      []string
+	coverMaxBytes      int64
+	attachmentMaxBytes int64
 }
 
-func New(repo DBRepo) *Service {
+func New(useCase UseCase, cfg *config.Config) *Service {
 	return &Service{
-		repository: repo,
+		useCase:            useCase,
+		reactionTypes:      model.ReactionTypes(cfg.Reactions.Types),
+		coverMaxBytes:      cfg.Covers.MaxBytes,
+		attachmentMaxBytes: cfg.Attachments.MaxBytes,
 	}
 }
 
 func (s *Service) SaveDraftMaterial(ctx context.Context, in *materials.SaveDraftMaterialIn) (*materials.SaveDraftMaterialOut, error) {
 	ctx = logger_lib.WithField(ctx, "func_name", "SaveDraftMaterial")
 
-	if strings.TrimSpace(in.Title) == "" {
-		logger_lib.Error(ctx, "title is required")
-		return nil, status.Error(codes.InvalidArgument, "title is required")
-	}
-
 	ownerUUID, ok := ctx.Value(config.KeyUUID).(string)
 	if !ok || ownerUUID == "" {
 		logger_lib.Error(ctx, "uuid is required")
@@ -43,11 +47,11 @@ func (s *Service) SaveDraftMaterial(ctx context.Context, in *materials.SaveDraft
 
 	newMaterialData := &model.SaveDraftMaterial{}
 	newMaterialData.ToDTO(in)
-	materialUUID, err := s.repository.SaveDraftMaterial(ctx, ownerUUID, newMaterialData)
 
+	materialUUID, err := s.useCase.SaveDraftMaterial(ctx, ownerUUID, newMaterialData)
 	if err != nil {
 		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to save draft material: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to save draft material: %v", err)
+		return nil, statusError(err, "failed to save draft material")
 	}
 
 	return &materials.SaveDraftMaterialOut{
@@ -58,49 +62,74 @@ func (s *Service) SaveDraftMaterial(ctx context.Context, in *materials.SaveDraft
 func (s *Service) GetMaterial(ctx context.Context, in *materials.GetMaterialIn) (*materials.GetMaterialOut, error) {
 	ctx = logger_lib.WithField(ctx, "func_name", "GetMaterial")
 
-	material, err := s.repository.GetMaterial(ctx, in.Uuid)
+	userUUID, _ := ctx.Value(config.KeyUUID).(string)
+
+	view, err := s.useCase.GetMaterial(ctx, in.Uuid, userUUID)
 	if err != nil {
 		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get material: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to get material: %v", err)
+		return nil, statusError(err, "failed to get material")
+	}
+
+	out := view.Material.FromDTO()
+	out.Reactions = view.Material.ReactionCounts.FromDTO(s.reactionTypes)
+	out.MyReactions = view.MyReactions
+
+	out.Content, err = s.useCase.ResolveContent(ctx, in.Uuid, out.Content)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to resolve attachments: %v", err))
+		return nil, statusError(err, "failed to resolve attachments")
 	}
 
 	return &materials.GetMaterialOut{
-		Material: material.FromDTO(),
+		Material: out,
 	}, nil
 }
 
-func (s *Service) EditMaterial(ctx context.Context, in *materials.EditMaterialIn) (*materials.EditMaterialOut, error) {
-	ctx = logger_lib.WithField(ctx, "func_name", "EditMaterial")
+func (s *Service) GetMaterialsByUUIDs(ctx context.Context, in *materials.GetMaterialsByUUIDsIn) (*materials.GetMaterialsByUUIDsOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "GetMaterialsByUUIDs")
 
-	if strings.TrimSpace(in.Title) == "" {
-		logger_lib.Error(ctx, "title is required")
-		return nil, status.Error(codes.InvalidArgument, "title is required")
+	userUUID, _ := ctx.Value(config.KeyUUID).(string)
+
+	found, err := s.useCase.GetMaterialsByUUIDs(ctx, in.Uuids, userUUID)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get materials: %v", err))
+		return nil, statusError(err, "failed to get materials")
 	}
 
+	out := make([]*materials.Material, 0, len(found))
+	for i := range found {
+		material := found[i].FromDTO()
+		material.Reactions = found[i].ReactionCounts.FromDTO(s.reactionTypes)
+
+		material.Content, err = s.useCase.ResolveContent(ctx, material.Uuid, material.Content)
+		if err != nil {
+			logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to resolve attachments: %v", err))
+			return nil, statusError(err, "failed to resolve attachments")
+		}
+		out = append(out, material)
+	}
+
+	return &materials.GetMaterialsByUUIDsOut{
+		Materials: out,
+	}, nil
+}
+
+func (s *Service) EditMaterial(ctx context.Context, in *materials.EditMaterialIn) (*materials.EditMaterialOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "EditMaterial")
+
 	userUUID, ok := ctx.Value(config.KeyUUID).(string)
 	if !ok || userUUID == "" {
 		logger_lib.Error(ctx, "uuid is required")
 		return nil, status.Error(codes.Unauthenticated, "uuid is required")
 	}
 
-	materialOwnerUUID, err := s.repository.GetMaterialOwnerUUID(ctx, in.Uuid)
-	if err != nil {
-		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get owner uuid: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to get owner uuid: %v", err)
-	}
-
-	if materialOwnerUUID != userUUID {
-		logger_lib.Error(ctx, "failed to edit: user is not owner")
-		return nil, status.Errorf(codes.PermissionDenied, "failed to edit: user is not owner")
-	}
-
 	updatedMaterial := &model.EditMaterial{}
 	updatedMaterial.ToDTO(in)
 
-	editedMaterial, err := s.repository.EditMaterial(ctx, updatedMaterial)
+	editedMaterial, err := s.useCase.EditMaterial(ctx, userUUID, updatedMaterial)
 	if err != nil {
 		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to edit material: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to edit material: %v", err)
+		return nil, statusError(err, "failed to edit material")
 	}
 
 	return &materials.EditMaterialOut{
@@ -117,33 +146,55 @@ func (s *Service) DeleteMaterial(ctx context.Context, in *materials.DeleteMateri
 		return nil, status.Error(codes.Unauthenticated, "uuid is required")
 	}
 
-	materialOwnerUUID, err := s.repository.GetMaterialOwnerUUID(ctx, in.Uuid)
+	err := s.useCase.DeleteMaterial(ctx, in.Uuid, userUUID)
 	if err != nil {
-		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get owner uuid: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to get owner uuid: %v", err)
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to delete material: %v", err))
+		return nil, statusError(err, "failed to delete material")
 	}
 
-	if materialOwnerUUID != userUUID {
-		logger_lib.Error(ctx, "failed to delete: user is not owner")
-		return nil, status.Errorf(codes.PermissionDenied, "failed to delete: user is not owner")
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) ArchivedMaterial(ctx context.Context, in *materials.ArchivedMaterialIn) (*emptypb.Empty, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "ArchivedMaterial")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "uuid is required")
+		return nil, status.Error(codes.Unauthenticated, "uuid is required")
 	}
 
-	rowsAffected, err := s.repository.DeleteMaterial(ctx, in.Uuid)
+	err := s.useCase.ArchiveMaterial(ctx, in.Uuid, userUUID)
 	if err != nil {
-		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to delete material: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to delete material: %v", err)
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to archive material: %v", err))
+		return nil, statusError(err, "failed to archive material")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) UnarchiveMaterial(ctx context.Context, in *materials.UnarchiveMaterialIn) (*materials.UnarchiveMaterialOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "UnarchiveMaterial")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "uuid is required")
+		return nil, status.Error(codes.Unauthenticated, "uuid is required")
 	}
 
-	if rowsAffected == 0 {
-		logger_lib.Error(ctx, "failed to delete: material already deleted or not found")
-		return nil, status.Errorf(codes.NotFound, "failed to delete: material already deleted or not found")
+	unarchivedMaterial, err := s.useCase.UnarchiveMaterial(ctx, in.Uuid, userUUID)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to unarchive material: %v", err))
+		return nil, statusError(err, "failed to unarchive material")
 	}
 
-	return nil, nil
+	return &materials.UnarchiveMaterialOut{
+		Material: unarchivedMaterial.FromDTO(),
+	}, nil
 }
 
-func (s *Service) ArchivedMaterial(ctx context.Context, in *materials.ArchivedMaterialIn) (*emptypb.Empty, error) {
-	ctx = logger_lib.WithField(ctx, "func_name", "ArchivedMaterial")
+func (s *Service) GetArchivedMaterials(ctx context.Context, in *materials.GetArchivedMaterialsIn) (*materials.GetArchivedMaterialsOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "GetArchivedMaterials")
 
 	userUUID, ok := ctx.Value(config.KeyUUID).(string)
 	if !ok || userUUID == "" {
@@ -151,80 +202,161 @@ func (s *Service) ArchivedMaterial(ctx context.Context, in *materials.ArchivedMa
 		return nil, status.Error(codes.Unauthenticated, "uuid is required")
 	}
 
-	materialOwnerUUID, err := s.repository.GetMaterialOwnerUUID(ctx, in.Uuid)
+	page := int(in.Page)
+	if page < 1 {
+		page = 1
+	}
+	limit := int(in.Limit)
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	archivedMaterials, err := s.useCase.GetArchivedMaterials(ctx, userUUID, (page-1)*limit, limit)
 	if err != nil {
-		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get owner uuid: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to get owner uuid: %v", err)
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get archived materials: %v", err))
+		return nil, statusError(err, "failed to get archived materials")
 	}
 
-	if materialOwnerUUID != userUUID {
-		logger_lib.Error(ctx, "failed to archived: user is not owner")
-		return nil, status.Errorf(codes.PermissionDenied, "failed to archived: user is not owner")
+	return &materials.GetArchivedMaterialsOut{
+		MaterialList: archivedMaterials.ListFromDTO(),
+	}, nil
+}
+
+func (s *Service) PublishMaterial(ctx context.Context, in *materials.PublishMaterialIn) (*materials.PublishMaterialOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "PublishMaterial")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "user uuid is required")
+		return nil, status.Error(codes.Unauthenticated, "user uuid is required")
 	}
 
-	rowsAffected, err := s.repository.ArchivedMaterial(ctx, in.Uuid)
+	publishedMaterial, err := s.useCase.PublishMaterial(ctx, in.Uuid, userUUID)
 	if err != nil {
-		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to archived material: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to archived material: %v", err)
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to publish material: %v", err))
+		return nil, statusError(err, "failed to publish material")
 	}
 
-	if rowsAffected == 0 {
-		logger_lib.Error(ctx, "failed to archived material: material already archived or not found")
-		return nil, status.Error(codes.NotFound, "failed to archived material: material already archived or not found")
+	return &materials.PublishMaterialOut{
+		Material: publishedMaterial.FromDTO(),
+	}, nil
+}
+
+func (s *Service) ToggleLike(ctx context.Context, in *materials.ToggleLikeIn) (*materials.ToggleLikeOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "ToggleLike")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "uuid is required")
+		return nil, status.Error(codes.Unauthenticated, "uuid is required")
+	}
+
+	like, err := s.useCase.ToggleLike(ctx, in.MaterialUuid, userUUID)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to toggle like: %v", err))
+		return nil, statusError(err, "failed to toggle like")
 	}
 
-	return nil, nil
+	return &materials.ToggleLikeOut{
+		IsLiked:    like.IsLiked,
+		LikesCount: like.LikesCount,
+	}, nil
 }
 
-func (s *Service) PublishMaterial(ctx context.Context, in *materials.PublishMaterialIn) (*materials.PublishMaterialOut, error) {
-	ctx = logger_lib.WithField(ctx, "func_name", "PublishMaterial")
+func (s *Service) AutosaveDraft(ctx context.Context, in *materials.AutosaveDraftIn) (*materials.AutosaveDraftOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "AutosaveDraft")
 
-	if in.Uuid == "" {
-		logger_lib.Error(ctx, "material uuid is required")
-		return nil, status.Error(codes.InvalidArgument, "material uuid is required")
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "uuid is required")
+		return nil, status.Error(codes.Unauthenticated, "uuid is required")
 	}
 
+	autosave := &model.AutosaveDraft{}
+	autosave.ToDTO(in)
+
+	saved, err := s.useCase.AutosaveDraft(ctx, userUUID, autosave)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to autosave draft: %v", err))
+		return nil, statusError(err, "failed to autosave draft")
+	}
+
+	return &materials.AutosaveDraftOut{
+		SavedAt: timestamppb.New(saved.SavedAt),
+	}, nil
+}
+
+func (s *Service) PromoteAutosave(ctx context.Context, in *materials.PromoteAutosaveIn) (*materials.PromoteAutosaveOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "PromoteAutosave")
+
 	userUUID, ok := ctx.Value(config.KeyUUID).(string)
 	if !ok || userUUID == "" {
-		logger_lib.Error(ctx, "user uuid is required")
-		return nil, status.Error(codes.Unauthenticated, "user uuid is required")
+		logger_lib.Error(ctx, "uuid is required")
+		return nil, status.Error(codes.Unauthenticated, "uuid is required")
 	}
 
-	materialOwnerUUID, err := s.repository.GetMaterialOwnerUUID(ctx, in.Uuid)
+	editedMaterial, err := s.useCase.PromoteAutosave(ctx, in.Uuid, userUUID)
 	if err != nil {
-		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get owner uuid: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to get owner uuid: %v", err)
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to promote autosave: %v", err))
+		return nil, statusError(err, "failed to promote autosave")
 	}
 
-	if materialOwnerUUID != userUUID {
-		logger_lib.Error(ctx, "failed to publish: user is not owner")
-		return nil, status.Errorf(codes.PermissionDenied, "failed to publish: user is not owner")
+	return &materials.PromoteAutosaveOut{
+		Material: editedMaterial.FromDTO(),
+	}, nil
+}
+
+func (s *Service) DuplicateMaterial(ctx context.Context, in *materials.DuplicateMaterialIn) (*materials.DuplicateMaterialOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "DuplicateMaterial")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "uuid is required")
+		return nil, status.Error(codes.Unauthenticated, "uuid is required")
 	}
 
-	exists, err := s.repository.MaterialExists(ctx, in.Uuid)
+	duplicate, err := s.useCase.DuplicateMaterial(ctx, in.Uuid, userUUID)
 	if err != nil {
-		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to check material existence: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to check material existence: %v", err)
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to duplicate material: %v", err))
+		return nil, statusError(err, "failed to duplicate material")
 	}
 
-	if !exists {
-		logger_lib.Error(ctx, "material does not exist")
-		return nil, status.Errorf(codes.FailedPrecondition, "material does not exist")
+	return &materials.DuplicateMaterialOut{
+		Material: duplicate.FromDTO(),
+	}, nil
+}
+
+func (s *Service) GetDeletedMaterials(ctx context.Context, in *materials.GetDeletedMaterialsIn) (*materials.GetDeletedMaterialsOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "GetDeletedMaterials")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "uuid is required")
+		return nil, status.Error(codes.Unauthenticated, "uuid is required")
 	}
 
-	publishedMaterial, err := s.repository.PublishMaterial(ctx, in.Uuid)
+	page := int(in.Page)
+	if page < 1 {
+		page = 1
+	}
+	limit := int(in.Limit)
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	deletedMaterials, err := s.useCase.GetDeletedMaterials(ctx, userUUID, (page-1)*limit, limit)
 	if err != nil {
-		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to publish material: %v", err))
-		return nil, status.Errorf(codes.Internal, "failed to publish material: %v", err)
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get deleted materials: %v", err))
+		return nil, statusError(err, "failed to get deleted materials")
 	}
 
-	return &materials.PublishMaterialOut{
-		Material: publishedMaterial.FromDTO(),
+	return &materials.GetDeletedMaterialsOut{
+		MaterialList: deletedMaterials.ListFromDTO(),
 	}, nil
 }
 
-func (s *Service) ToggleLike(ctx context.Context, in *materials.ToggleLikeIn) (*materials.ToggleLikeOut, error) {
-	ctx = logger_lib.WithField(ctx, "func_name", "ToggleLike")
+func (s *Service) RestoreMaterial(ctx context.Context, in *materials.RestoreMaterialIn) (*materials.RestoreMaterialOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "RestoreMaterial")
 
 	userUUID, ok := ctx.Value(config.KeyUUID).(string)
 	if !ok || userUUID == "" {
@@ -232,46 +364,487 @@ func (s *Service) ToggleLike(ctx context.Context, in *materials.ToggleLikeIn) (*
 		return nil, status.Error(codes.Unauthenticated, "uuid is required")
 	}
 
-	var isLiked bool
-	var likesCount int32
-	err := tx.TxExecute(ctx, func(ctx context.Context) error {
-		var err error
-		isLiked, err = s.repository.CheckLike(ctx, in.MaterialUuid, userUUID)
+	restoredMaterial, err := s.useCase.RestoreMaterial(ctx, in.Uuid, userUUID)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to restore material: %v", err))
+		return nil, statusError(err, "failed to restore material")
+	}
+
+	return &materials.RestoreMaterialOut{
+		Material: restoredMaterial.FromDTO(),
+	}, nil
+}
+
+func (s *Service) BulkDeleteMaterials(ctx context.Context, in *materials.BulkMaterialsIn) (*materials.BulkMaterialsOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "BulkDeleteMaterials")
+	return s.bulkOperation(ctx, model.BulkActionDelete, in.Uuids, nil)
+}
+
+func (s *Service) BulkArchiveMaterials(ctx context.Context, in *materials.BulkMaterialsIn) (*materials.BulkMaterialsOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "BulkArchiveMaterials")
+	return s.bulkOperation(ctx, model.BulkActionArchive, in.Uuids, nil)
+}
+
+func (s *Service) BulkPublishMaterials(ctx context.Context, in *materials.BulkMaterialsIn) (*materials.BulkMaterialsOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "BulkPublishMaterials")
+	return s.bulkOperation(ctx, model.BulkActionPublish, in.Uuids, nil)
+}
+
+func (s *Service) BulkTagMaterials(ctx context.Context, in *materials.BulkTagMaterialsIn) (*materials.BulkMaterialsOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "BulkTagMaterials")
+	return s.bulkOperation(ctx, model.BulkActionTag, in.Uuids, in.Tags)
+}
+
+func (s *Service) bulkOperation(ctx context.Context, action string, uuids []string, tags []string) (*materials.BulkMaterialsOut, error) {
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "uuid is required")
+		return nil, status.Error(codes.Unauthenticated, "uuid is required")
+	}
+
+	results, err := s.useCase.BulkOperation(ctx, action, userUUID, uuids, tags)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to %s materials: %v", action, err))
+		return nil, statusError(err, fmt.Sprintf("failed to %s materials", action))
+	}
+
+	return &materials.BulkMaterialsOut{
+		Results: results.FromDTO(),
+	}, nil
+}
+
+func (s *Service) SetLike(ctx context.Context, in *materials.SetLikeIn) (*materials.SetLikeOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "SetLike")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "uuid is required")
+		return nil, status.Error(codes.Unauthenticated, "uuid is required")
+	}
+
+	like, err := s.useCase.SetLike(ctx, in.MaterialUuid, userUUID, in.Liked)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to set like: %v", err))
+		return nil, statusError(err, "failed to set like")
+	}
+
+	return &materials.SetLikeOut{
+		IsLiked:    like.IsLiked,
+		LikesCount: like.LikesCount,
+	}, nil
+}
+
+func (s *Service) ListMaterialLikers(ctx context.Context, in *materials.ListMaterialLikersIn) (*materials.ListMaterialLikersOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "ListMaterialLikers")
+
+	page := int(in.Page)
+	if page < 1 {
+		page = 1
+	}
+	limit := int(in.Limit)
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	userUUID, _ := ctx.Value(config.KeyUUID).(string)
+
+	likers, err := s.useCase.ListLikers(ctx, in.MaterialUuid, userUUID, (page-1)*limit, limit)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get material likers: %v", err))
+		return nil, statusError(err, "failed to get material likers")
+	}
+
+	return &materials.ListMaterialLikersOut{
+		Likers: likers.FromDTO(),
+	}, nil
+}
+
+func (s *Service) SetReaction(ctx context.Context, in *materials.SetReactionIn) (*materials.ReactionsOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "SetReaction")
+	return s.changeReaction(ctx, in.MaterialUuid, in.Reaction, true)
+}
+
+func (s *Service) ClearReaction(ctx context.Context, in *materials.ClearReactionIn) (*materials.ReactionsOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "ClearReaction")
+	return s.changeReaction(ctx, in.MaterialUuid, in.Reaction, false)
+}
+
+func (s *Service) changeReaction(ctx context.Context, materialUUID, reaction string, set bool) (*materials.ReactionsOut, error) {
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "uuid is required")
+		return nil, status.Error(codes.Unauthenticated, "uuid is required")
+	}
+
+	state, err := s.useCase.ChangeReaction(ctx, materialUUID, userUUID, reaction, set)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to change reaction: %v", err))
+		return nil, statusError(err, "failed to change reaction")
+	}
+
+	return &materials.ReactionsOut{
+		Reactions:   state.Counts.FromDTO(s.reactionTypes),
+		MyReactions: state.MyReactions,
+	}, nil
+}
+
+func (s *Service) GetTrendingMaterials(ctx context.Context, in *materials.GetTrendingMaterialsIn) (*materials.GetTrendingMaterialsOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "GetTrendingMaterials")
+
+	page := int(in.Page)
+	if page < 1 {
+		page = 1
+	}
+	limit := int(in.Limit)
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	trendingMaterials, err := s.useCase.GetTrending(ctx, in.Tag, (page-1)*limit, limit)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get trending materials: %v", err))
+		return nil, statusError(err, "failed to get trending materials")
+	}
+
+	return &materials.GetTrendingMaterialsOut{
+		MaterialList: trendingMaterials.ListFromDTO(),
+	}, nil
+}
+
+func (s *Service) GetRelatedMaterials(ctx context.Context, in *materials.GetRelatedMaterialsIn) (*materials.GetRelatedMaterialsOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "GetRelatedMaterials")
+
+	page := int(in.Page)
+	if page < 1 {
+		page = 1
+	}
+	limit := int(in.Limit)
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	userUUID, _ := ctx.Value(config.KeyUUID).(string)
+
+	relatedMaterials, err := s.useCase.GetRelated(ctx, in.MaterialUuid, userUUID, (page-1)*limit, limit)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get related materials: %v", err))
+		return nil, statusError(err, "failed to get related materials")
+	}
+
+	return &materials.GetRelatedMaterialsOut{
+		MaterialList: relatedMaterials.ListFromDTO(),
+	}, nil
+}
+
+func (s *Service) ReportMaterial(ctx context.Context, in *materials.ReportMaterialIn) (*materials.ReportMaterialOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "ReportMaterial")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "uuid is required")
+		return nil, status.Error(codes.Unauthenticated, "uuid is required")
+	}
+
+	report, err := s.useCase.ReportMaterial(ctx, in.MaterialUuid, userUUID, in.Reason, in.Comment)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to report material: %v", err))
+		return nil, statusError(err, "failed to report material")
+	}
+
+	return &materials.ReportMaterialOut{
+		ReportUuid:     report.UUID,
+		MaterialHidden: report.MaterialHidden,
+	}, nil
+}
+
+func (s *Service) ListMaterialReports(ctx context.Context, in *materials.ListMaterialReportsIn) (*materials.ListMaterialReportsOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "ListMaterialReports")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "uuid is required")
+		return nil, status.Error(codes.Unauthenticated, "uuid is required")
+	}
+
+	page := int(in.Page)
+	if page < 1 {
+		page = 1
+	}
+	limit := int(in.Limit)
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	reports, err := s.useCase.ListMaterialReports(ctx, in.MaterialUuid, (page-1)*limit, limit)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to get reports: %v", err))
+		return nil, statusError(err, "failed to get reports")
+	}
+
+	return &materials.ListMaterialReportsOut{
+		Reports: reports.FromDTO(),
+	}, nil
+}
+
+func (s *Service) ResolveMaterialReport(ctx context.Context, in *materials.ResolveMaterialReportIn) (*materials.ResolveMaterialReportOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "ResolveMaterialReport")
+
+	moderatorUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || moderatorUUID == "" {
+		logger_lib.Error(ctx, "uuid is required")
+		return nil, status.Error(codes.Unauthenticated, "uuid is required")
+	}
+
+	report, err := s.useCase.ResolveMaterialReport(ctx, in.ReportUuid, moderatorUUID, in.Resolution)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to resolve report: %v", err))
+		return nil, statusError(err, "failed to resolve report")
+	}
+
+	return &materials.ResolveMaterialReportOut{
+		Report: report.FromDTO(),
+	}, nil
+}
+
+func (s *Service) HideMaterial(ctx context.Context, in *materials.HideMaterialIn) (*materials.ModerateMaterialOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "HideMaterial")
+	return s.moderateMaterial(ctx, in.MaterialUuid, in.Reason, true)
+}
+
+func (s *Service) UnhideMaterial(ctx context.Context, in *materials.UnhideMaterialIn) (*materials.ModerateMaterialOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "UnhideMaterial")
+	return s.moderateMaterial(ctx, in.MaterialUuid, "", false)
+}
+
+func (s *Service) moderateMaterial(ctx context.Context, materialUUID, reason string, hide bool) (*materials.ModerateMaterialOut, error) {
+	moderatorUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || moderatorUUID == "" {
+		logger_lib.Error(ctx, "uuid is required")
+		return nil, status.Error(codes.Unauthenticated, "uuid is required")
+	}
+
+	resolved, err := s.useCase.ModerateMaterial(ctx, materialUUID, moderatorUUID, reason, hide)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to moderate material: %v", err))
+		return nil, statusError(err, "failed to moderate material")
+	}
+
+	return &materials.ModerateMaterialOut{
+		ResolvedReports: int32(resolved),
+	}, nil
+}
+
+func (s *Service) UploadCover(stream grpc.ClientStreamingServer[materials.UploadCoverIn, materials.UploadCoverOut]) error {
+	ctx := logger_lib.WithField(stream.Context(), "func_name", "UploadCover")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "uuid is required")
+		return status.Error(codes.Unauthenticated, "uuid is required")
+	}
+
+	var (
+		materialUUID string
+		data         []byte
+	)
+	for {
+		in, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
 		if err != nil {
-			return fmt.Errorf("failed to check like: %v", err)
+			logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to receive cover chunk: %v", err))
+			return status.Errorf(codes.Unknown, "failed to receive cover chunk: %v", err)
 		}
 
-		if isLiked {
-			err = s.repository.RemoveLike(ctx, in.MaterialUuid, userUUID)
-			if err != nil {
-				return fmt.Errorf("failed to remove like: %v", err)
-			}
-		} else {
-			err = s.repository.AddLike(ctx, in.MaterialUuid, userUUID)
-			if err != nil {
-				return fmt.Errorf("failed to add like: %v", err)
-			}
+		if materialUUID == "" {
+			materialUUID = in.MaterialUuid
 		}
 
-		likesCount, err = s.repository.GetLikesCount(ctx, in.MaterialUuid)
-		if err != nil {
-			return fmt.Errorf("failed to get likes count: %v", err)
+		if int64(len(data)+len(in.Chunk)) > s.coverMaxBytes {
+			logger_lib.Error(ctx, "cover image is too large")
+			return status.Errorf(codes.InvalidArgument, "cover image is larger than %d bytes", s.coverMaxBytes)
 		}
+		data = append(data, in.Chunk...)
+	}
+
+	uploaded, err := s.useCase.UploadCover(ctx, materialUUID, userUUID, data)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to upload cover: %v", err))
+		return statusError(err, "failed to upload cover")
+	}
 
-		err = s.repository.UpdateLikesCount(ctx, in.MaterialUuid, likesCount)
+	return stream.SendAndClose(&materials.UploadCoverOut{
+		CoverImageUrl:   uploaded.URL,
+		CoverThumbnails: uploaded.Thumbnails.FromDTO(),
+	})
+}
+
+func (s *Service) UploadAttachment(stream grpc.ClientStreamingServer[materials.UploadAttachmentIn, materials.UploadAttachmentOut]) error {
+	ctx := logger_lib.WithField(stream.Context(), "func_name", "UploadAttachment")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "uuid is required")
+		return status.Error(codes.Unauthenticated, "uuid is required")
+	}
+
+	var (
+		materialUUID string
+		filename     string
+		data         []byte
+	)
+	for {
+		in, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
 		if err != nil {
-			return fmt.Errorf("failed to update likes count: %v", err)
+			logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to receive attachment chunk: %v", err))
+			return status.Errorf(codes.Unknown, "failed to receive attachment chunk: %v", err)
 		}
 
+		if materialUUID == "" {
+			materialUUID = in.MaterialUuid
+		}
+		if filename == "" {
+			filename = in.Filename
+		}
+
+		if int64(len(data)+len(in.Chunk)) > s.attachmentMaxBytes {
+			logger_lib.Error(ctx, "attachment is too large")
+			return status.Errorf(codes.InvalidArgument, "attachment is larger than %d bytes", s.attachmentMaxBytes)
+		}
+		data = append(data, in.Chunk...)
+	}
+
+	uploaded, err := s.useCase.UploadAttachment(ctx, materialUUID, userUUID, filename, data)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to upload attachment: %v", err))
+		return statusError(err, "failed to upload attachment")
+	}
+
+	return stream.SendAndClose(&materials.UploadAttachmentOut{
+		Attachment: uploaded.FromDTO(),
+	})
+}
+
+func (s *Service) ListMaterialAttachments(ctx context.Context, in *materials.ListMaterialAttachmentsIn) (*materials.ListMaterialAttachmentsOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "ListMaterialAttachments")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "uuid is required")
+		return nil, status.Error(codes.Unauthenticated, "uuid is required")
+	}
+
+	attachments, err := s.useCase.ListAttachments(ctx, in.MaterialUuid, userUUID)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to list attachments: %v", err))
+		return nil, statusError(err, "failed to list attachments")
+	}
+
+	return &materials.ListMaterialAttachmentsOut{
+		Attachments: attachments.FromDTO(),
+	}, nil
+}
+
+func (s *Service) DeleteMaterialAttachment(ctx context.Context, in *materials.DeleteMaterialAttachmentIn) (*emptypb.Empty, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "DeleteMaterialAttachment")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "uuid is required")
+		return nil, status.Error(codes.Unauthenticated, "uuid is required")
+	}
+
+	err := s.useCase.DeleteAttachment(ctx, in.MaterialUuid, in.AttachmentUuid, userUUID)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to delete attachment: %v", err))
+		return nil, statusError(err, "failed to delete attachment")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ExportMaterials выгружает материалы потоком для аналитики и бэкапов. Всё подряд может выгружать только
+// сервис с ролью экспорта, пользователь — только свои материалы. Send блокируется, пока клиент не освободит
+// окно HTTP/2, а следующая пачка читается из базы только после отправки предыдущей.
+func (s *Service) ExportMaterials(in *materials.ExportMaterialsIn, stream grpc.ServerStreamingServer[materials.Material]) error {
+	ctx := logger_lib.WithField(stream.Context(), "func_name", "ExportMaterials")
+
+	userUUID, _ := ctx.Value(config.KeyUUID).(string)
+
+	filter := model.ExportFilter{}
+	filter.ToDTO(in)
+
+	var exported int
+	err := s.useCase.ExportMaterials(ctx, userUUID, filter, func(batch model.MaterialList) error {
+		for i := range batch {
+			material := batch[i].FromDTO()
+			material.Reactions = batch[i].ReactionCounts.FromDTO(s.reactionTypes)
+			if err := stream.Send(material); err != nil {
+				return fmt.Errorf("failed to send material: %w", err)
+			}
+			exported++
+		}
 		return nil
 	})
 	if err != nil {
-		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("transaction failed: %v", err))
-		return nil, status.Errorf(codes.Internal, "transaction failed: %v", err)
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to export materials after %d sent: %v", exported, err))
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return status.FromContextError(ctxErr).Err()
+		}
+		return statusError(err, "failed to export materials")
 	}
 
-	return &materials.ToggleLikeOut{
-		IsLiked:    !isLiked,
-		LikesCount: likesCount,
+	return nil
+}
+
+func (s *Service) ExportMaterial(ctx context.Context, in *materials.ExportMaterialIn) (*materials.ExportMaterialOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "ExportMaterial")
+
+	userUUID, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || userUUID == "" {
+		logger_lib.Error(ctx, "uuid is required")
+		return nil, status.Error(codes.Unauthenticated, "uuid is required")
+	}
+
+	export, err := s.useCase.ExportMaterial(ctx, in.MaterialUuid, userUUID, in.Format)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to export material: %v", err))
+		return nil, statusError(err, "failed to export material")
+	}
+
+	return &materials.ExportMaterialOut{
+		Filename:    export.Filename,
+		ContentType: export.ContentType,
+		Data:        export.Data,
+	}, nil
+}
+
+// ExportUserData выгружает всё, что сервис хранит о пользователе, одним JSON-документом
+func (s *Service) ExportUserData(ctx context.Context, in *materials.ExportUserDataIn) (*materials.ExportUserDataOut, error) {
+	ctx = logger_lib.WithField(ctx, "func_name", "ExportUserData")
+
+	userData, err := s.useCase.ExportUserData(ctx, in.UserUuid)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to export user data: %v", err))
+		return nil, statusError(err, "failed to export user data")
+	}
+
+	archive := userData.FromDTO(s.reactionTypes)
+	archive.ExportedAt = timestamppb.Now()
+
+	data, err := protojson.MarshalOptions{Multiline: true, UseProtoNames: true}.Marshal(archive)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to marshal user data: %v", err))
+		return nil, status.Error(codes.Internal, "failed to marshal user data")
+	}
+
+	return &materials.ExportUserDataOut{
+		Filename:    fmt.Sprintf("user-data-%s.json", in.UserUuid),
+		ContentType: model.UserDataContentType,
+		Data:        data,
 	}, nil
 }
@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/s21platform/materials-service/internal/config"
+	"github.com/s21platform/materials-service/internal/model"
+	"github.com/s21platform/materials-service/pkg/materials"
+)
+
+type exportStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	sent    []*materials.Material
+	sendErr error
+}
+
+func (s *exportStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *exportStream) Send(material *materials.Material) error {
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+	s.sent = append(s.sent, material)
+	return nil
+}
+
+func exportBatches(batches ...model.MaterialList) func(context.Context, string, model.ExportFilter, func(model.MaterialList) error) error {
+	return func(_ context.Context, _ string, _ model.ExportFilter, fn func(model.MaterialList) error) error {
+		for _, batch := range batches {
+			if err := fn(batch); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func TestService_ExportMaterials(t *testing.T) {
+	t.Parallel()
+
+	userUUID := uuid.New().String()
+	first := model.MaterialList{
+		{UUID: uuid.New().String(), OwnerUUID: userUUID, Title: "first", ReactionCounts: model.ReactionCounts{"like": 2}},
+		{UUID: uuid.New().String(), OwnerUUID: userUUID, Title: "second"},
+	}
+	second := model.MaterialList{
+		{UUID: uuid.New().String(), OwnerUUID: userUUID, Title: "third"},
+	}
+
+	newService := func(useCase UseCase) *Service {
+		return &Service{
+			useCase:       useCase,
+			reactionTypes: []string{"like"},
+		}
+	}
+
+	t.Run("streams_all_batches", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockUseCase := NewMockUseCase(ctrl)
+		mockUseCase.EXPECT().ExportMaterials(gomock.Any(), "", model.ExportFilter{Status: "published"}, gomock.Any()).
+			DoAndReturn(exportBatches(first, second))
+
+		stream := &exportStream{ctx: context.Background()}
+		err := newService(mockUseCase).ExportMaterials(&materials.ExportMaterialsIn{Status: "published"}, stream)
+
+		require.NoError(t, err)
+		require.Len(t, stream.sent, 3)
+		assert.Equal(t, []string{"first", "second", "third"}, []string{stream.sent[0].Title, stream.sent[1].Title, stream.sent[2].Title})
+		assert.Equal(t, int32(2), stream.sent[0].Reactions[0].Count)
+	})
+
+	t.Run("passes_caller_uuid", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockUseCase := NewMockUseCase(ctrl)
+		mockUseCase.EXPECT().ExportMaterials(gomock.Any(), userUUID, model.ExportFilter{OwnerUUID: userUUID}, gomock.Any()).
+			DoAndReturn(exportBatches(first))
+
+		stream := &exportStream{ctx: context.WithValue(context.Background(), config.KeyUUID, userUUID)}
+		err := newService(mockUseCase).ExportMaterials(&materials.ExportMaterialsIn{OwnerUuid: userUUID}, stream)
+
+		require.NoError(t, err)
+		assert.Len(t, stream.sent, 2)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockUseCase := NewMockUseCase(ctrl)
+		mockUseCase.EXPECT().ExportMaterials(gomock.Any(), "", gomock.Any(), gomock.Any()).
+			Return(model.ForbiddenError("only own materials can be exported"))
+
+		err := newService(mockUseCase).ExportMaterials(&materials.ExportMaterialsIn{}, &exportStream{ctx: context.Background()})
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("invalid_filter", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockUseCase := NewMockUseCase(ctrl)
+		mockUseCase.EXPECT().ExportMaterials(gomock.Any(), "", model.ExportFilter{Status: "deleted"}, gomock.Any()).
+			Return(model.ValidationError("status", "unknown status"))
+
+		stream := &exportStream{ctx: context.Background()}
+		err := newService(mockUseCase).ExportMaterials(&materials.ExportMaterialsIn{Status: "deleted"}, stream)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("send_error_stops_export", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockUseCase := NewMockUseCase(ctrl)
+		mockUseCase.EXPECT().ExportMaterials(gomock.Any(), "", gomock.Any(), gomock.Any()).
+			DoAndReturn(exportBatches(first, second))
+
+		stream := &exportStream{ctx: context.Background(), sendErr: errors.New("transport is closing")}
+		err := newService(mockUseCase).ExportMaterials(&materials.ExportMaterialsIn{}, stream)
+
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Empty(t, stream.sent)
+	})
+
+	t.Run("client_canceled", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		mockUseCase := NewMockUseCase(ctrl)
+		mockUseCase.EXPECT().ExportMaterials(gomock.Any(), "", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ string, _ model.ExportFilter, fn func(model.MaterialList) error) error {
+				if err := fn(first); err != nil {
+					return err
+				}
+				cancel()
+				return ctx.Err()
+			})
+
+		stream := &exportStream{ctx: ctx}
+		err := newService(mockUseCase).ExportMaterials(&materials.ExportMaterialsIn{}, stream)
+
+		assert.Equal(t, codes.Canceled, status.Code(err))
+		assert.Len(t, stream.sent, 2)
+	})
+
+	t.Run("usecase_error", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockUseCase := NewMockUseCase(ctrl)
+		mockUseCase.EXPECT().ExportMaterials(gomock.Any(), "", gomock.Any(), gomock.Any()).
+			Return(errors.New("failed to export materials: connection reset"))
+
+		stream := &exportStream{ctx: context.Background()}
+		err := newService(mockUseCase).ExportMaterials(&materials.ExportMaterialsIn{}, stream)
+
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
+func TestService_ExportUserData(t *testing.T) {
+	t.Parallel()
+
+	userUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+	deletedAt := time.Now()
+
+	newService := func(useCase UseCase) *Service {
+		return &Service{
+			useCase:       useCase,
+			reactionTypes: []string{"like"},
+		}
+	}
+
+	t.Run("full_archive", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockUseCase := NewMockUseCase(ctrl)
+		mockUseCase.EXPECT().ExportUserData(gomock.Any(), userUUID).Return(&model.UserData{
+			UserUUID: userUUID,
+			Profile:  &model.User{Uuid: userUUID, Nickname: "ivan", Name: "Ivan"},
+			Materials: model.MaterialList{
+				{UUID: materialUUID, OwnerUUID: userUUID, Title: "draft"},
+				{UUID: uuid.New().String(), OwnerUUID: userUUID, Title: "trashed", DeletedAt: &deletedAt},
+			},
+			Tags: []model.MaterialTag{
+				{MaterialUUID: materialUUID, Tag: "go"},
+				{MaterialUUID: materialUUID, Tag: "sql"},
+			},
+			Reactions: model.UserReactionList{
+				{MaterialUUID: uuid.New().String(), Reaction: "like", CreatedAt: time.Now()},
+			},
+			Reports: model.MaterialReportList{
+				{UUID: uuid.New().String(), MaterialUUID: uuid.New().String(), ReporterUUID: userUUID, Reason: "spam", Status: "open", CreatedAt: time.Now()},
+			},
+			Attachments: model.MaterialAttachmentList{
+				{UUID: uuid.New().String(), MaterialUUID: materialUUID, OwnerUUID: userUUID, Filename: "diagram.png", CreatedAt: time.Now()},
+			},
+		}, nil)
+
+		out, err := newService(mockUseCase).ExportUserData(context.Background(), &materials.ExportUserDataIn{UserUuid: userUUID})
+		require.NoError(t, err)
+
+		assert.Equal(t, "user-data-"+userUUID+".json", out.Filename)
+		assert.Equal(t, model.UserDataContentType, out.ContentType)
+
+		archive := &materials.UserDataArchive{}
+		require.NoError(t, protojson.Unmarshal(out.Data, archive))
+		assert.Equal(t, userUUID, archive.UserUuid)
+		assert.NotNil(t, archive.ExportedAt)
+		assert.Equal(t, "ivan", archive.Profile.Nickname)
+		require.Len(t, archive.Materials, 2)
+		assert.Equal(t, []string{"go", "sql"}, archive.Materials[0].Tags)
+		assert.Empty(t, archive.Materials[1].Tags)
+		assert.Len(t, archive.Reactions, 1)
+		assert.Len(t, archive.Reports, 1)
+		assert.Len(t, archive.Attachments, 1)
+	})
+
+	errorCases := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{"erased_user", model.NotFoundError("no data stored for user"), codes.NotFound},
+		{"privacy_role_required", model.ForbiddenError("privacy admin role is required"), codes.PermissionDenied},
+		{"invalid_uuid", model.ValidationError("user_uuid", "invalid user uuid"), codes.InvalidArgument},
+		{"usecase_error", errors.New("failed to get user: connection reset"), codes.Internal},
+	}
+	for _, tc := range errorCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := NewMockUseCase(ctrl)
+			mockUseCase.EXPECT().ExportUserData(gomock.Any(), userUUID).Return(nil, tc.err)
+
+			_, err := newService(mockUseCase).ExportUserData(context.Background(), &materials.ExportUserDataIn{UserUuid: userUUID})
+			assert.Equal(t, tc.wantCode, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,85 @@
+//go:generate mockgen -destination=mock_contract_test.go -package=${GOPACKAGE} -source=contract.go
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/s21platform/materials-service/internal/model"
+)
+
+type DBRepo interface {
+	SaveDraftMaterial(ctx context.Context, ownerUUID string, material *model.SaveDraftMaterial) (string, error)
+	AddMaterialsTags(ctx context.Context, uuids []string, tags []string) error
+	GetMaterialOwnerUUID(ctx context.Context, uuid string) (string, error)
+	MaterialExists(ctx context.Context, materialUUID string) (bool, error)
+	PublishMaterial(ctx context.Context, uuid string) (*model.Material, error)
+	EditMaterial(ctx context.Context, material *model.EditMaterial) (*model.Material, error)
+	GetMaterial(ctx context.Context, uuid string) (*model.Material, error)
+	GetMaterialsByUUIDs(ctx context.Context, uuids []string) (model.MaterialList, error)
+	DeleteMaterial(ctx context.Context, uuid string) (int64, error)
+	ArchivedMaterial(ctx context.Context, uuid string) (int64, error)
+	UnarchiveMaterial(ctx context.Context, uuid string) (int64, error)
+	RestoreMaterial(ctx context.Context, uuid string, deletedAfter time.Time) (int64, error)
+	AddReaction(ctx context.Context, materialUUID, userUUID, reaction string) (*model.ReactionResult, error)
+	RemoveReaction(ctx context.Context, materialUUID, userUUID, reaction string) (*model.ReactionResult, error)
+	GetUserReactions(ctx context.Context, materialUUID, userUUID string) ([]string, error)
+	DuplicateMaterial(ctx context.Context, sourceUUID, ownerUUID string) (*model.Material, error)
+	IncrementForksCount(ctx context.Context, materialUUID string) error
+	GetMaterialsStateForUpdate(ctx context.Context, uuids []string) ([]model.MaterialState, error)
+	BulkDeleteMaterials(ctx context.Context, uuids []string) error
+	BulkArchiveMaterials(ctx context.Context, uuids []string) error
+	BulkPublishMaterials(ctx context.Context, uuids []string) error
+	CreateMaterialReport(ctx context.Context, materialUUID, reporterUUID, reason, comment string) (*model.ReportResult, error)
+	HideReportedMaterial(ctx context.Context, materialUUID string, threshold int) (bool, error)
+	GetOpenMaterialReports(ctx context.Context, materialUUID string, offset, limit int) (*model.MaterialReportList, error)
+	ResolveMaterialReport(ctx context.Context, reportUUID, moderatorUUID, resolution string) (*model.MaterialReport, error)
+	ResolveOpenMaterialReports(ctx context.Context, materialUUID, moderatorUUID, resolution string) (int64, error)
+	HideMaterial(ctx context.Context, uuid string) (int64, error)
+	UnhideMaterial(ctx context.Context, uuid string) (int64, error)
+	GetAllMaterials(ctx context.Context, offset, limit int, includeArchived bool) (*model.MaterialList, error)
+	GetDeletedMaterials(ctx context.Context, ownerUUID string, deletedAfter time.Time, offset, limit int) (*model.MaterialList, error)
+	GetArchivedMaterials(ctx context.Context, ownerUUID string, offset, limit int) (*model.MaterialList, error)
+	GetMaterialLikers(ctx context.Context, materialUUID string, offset, limit int) (*model.MaterialLikerList, error)
+	GetPublishedMaterialsByUUIDs(ctx context.Context, uuids []string) (*model.MaterialList, error)
+	GetRelatedMaterials(ctx context.Context, materialUUID, excludeOwnerUUID string, offset, limit int) (*model.MaterialList, error)
+	ExportMaterials(ctx context.Context, filter model.ExportFilter, batchSize int, fn func(model.MaterialList) error) error
+	GetMaterialsTags(ctx context.Context, uuids []string) ([]model.MaterialTag, error)
+	GetUser(ctx context.Context, userUUID string) (*model.User, error)
+	GetReactionsByUser(ctx context.Context, userUUID string) (model.UserReactionList, error)
+	GetReportsByReporter(ctx context.Context, reporterUUID string) (model.MaterialReportList, error)
+	GetAttachmentsByOwner(ctx context.Context, ownerUUID string) (model.MaterialAttachmentList, error)
+	WithTx(ctx context.Context, cb func(ctx context.Context) error) (err error)
+}
+
+type RedisRepo interface {
+	DeleteMaterial(ctx context.Context, uuid string) error
+	DeleteMaterials(ctx context.Context, uuids []string) error
+	GetMaterials(ctx context.Context, uuids []string) (model.MaterialList, error)
+	SetMaterials(ctx context.Context, materials model.MaterialList, ttl time.Duration) error
+	IncrementMaterialViews(ctx context.Context, uuid, viewerUUID string) error
+	SetAutosave(ctx context.Context, autosave *model.AutosaveDraft) error
+	GetAutosave(ctx context.Context, materialUUID string) (*model.AutosaveDraft, error)
+	DeleteAutosave(ctx context.Context, materialUUID string) error
+	GetTrending(ctx context.Context, tag string, offset, limit int) ([]string, error)
+}
+
+type KafkaProducer interface {
+	ProduceMessage(ctx context.Context, message interface{}, key interface{}) error
+}
+
+type MaterialExporter interface {
+	Export(ctx context.Context, material *model.Material, format string) (*model.MaterialExport, error)
+}
+
+type CoverUploader interface {
+	Upload(ctx context.Context, materialUUID, ownerUUID string, data []byte) (*model.Cover, error)
+}
+
+type AttachmentManager interface {
+	Upload(ctx context.Context, materialUUID, ownerUUID, filename string, data []byte) (*model.MaterialAttachment, error)
+	List(ctx context.Context, materialUUID string) (model.MaterialAttachmentList, error)
+	Delete(ctx context.Context, materialUUID, attachmentUUID string) error
+	Open(ctx context.Context, materialUUID, attachmentUUID string) (*model.MaterialAttachment, []byte, error)
+	ResolveContent(ctx context.Context, materialUUID, content string) (string, error)
+}
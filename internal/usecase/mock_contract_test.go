@@ -0,0 +1,1008 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/s21platform/materials-service/internal/model"
+)
+
+// MockDBRepo is a mock of DBRepo interface.
+type MockDBRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockDBRepoMockRecorder
+}
+
+// MockDBRepoMockRecorder is the mock recorder for MockDBRepo.
+type MockDBRepoMockRecorder struct {
+	mock *MockDBRepo
+}
+
+// NewMockDBRepo creates a new mock instance.
+func NewMockDBRepo(ctrl *gomock.Controller) *MockDBRepo {
+	mock := &MockDBRepo{ctrl: ctrl}
+	mock.recorder = &MockDBRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDBRepo) EXPECT() *MockDBRepoMockRecorder {
+	return m.recorder
+}
+
+// AddMaterialsTags mocks base method.
+func (m *MockDBRepo) AddMaterialsTags(ctx context.Context, uuids, tags []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMaterialsTags", ctx, uuids, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMaterialsTags indicates an expected call of AddMaterialsTags.
+func (mr *MockDBRepoMockRecorder) AddMaterialsTags(ctx, uuids, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMaterialsTags", reflect.TypeOf((*MockDBRepo)(nil).AddMaterialsTags), ctx, uuids, tags)
+}
+
+// AddReaction mocks base method.
+func (m *MockDBRepo) AddReaction(ctx context.Context, materialUUID, userUUID, reaction string) (*model.ReactionResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReaction", ctx, materialUUID, userUUID, reaction)
+	ret0, _ := ret[0].(*model.ReactionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReaction indicates an expected call of AddReaction.
+func (mr *MockDBRepoMockRecorder) AddReaction(ctx, materialUUID, userUUID, reaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReaction", reflect.TypeOf((*MockDBRepo)(nil).AddReaction), ctx, materialUUID, userUUID, reaction)
+}
+
+// ArchivedMaterial mocks base method.
+func (m *MockDBRepo) ArchivedMaterial(ctx context.Context, uuid string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchivedMaterial", ctx, uuid)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchivedMaterial indicates an expected call of ArchivedMaterial.
+func (mr *MockDBRepoMockRecorder) ArchivedMaterial(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchivedMaterial", reflect.TypeOf((*MockDBRepo)(nil).ArchivedMaterial), ctx, uuid)
+}
+
+// BulkArchiveMaterials mocks base method.
+func (m *MockDBRepo) BulkArchiveMaterials(ctx context.Context, uuids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkArchiveMaterials", ctx, uuids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkArchiveMaterials indicates an expected call of BulkArchiveMaterials.
+func (mr *MockDBRepoMockRecorder) BulkArchiveMaterials(ctx, uuids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkArchiveMaterials", reflect.TypeOf((*MockDBRepo)(nil).BulkArchiveMaterials), ctx, uuids)
+}
+
+// BulkDeleteMaterials mocks base method.
+func (m *MockDBRepo) BulkDeleteMaterials(ctx context.Context, uuids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkDeleteMaterials", ctx, uuids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkDeleteMaterials indicates an expected call of BulkDeleteMaterials.
+func (mr *MockDBRepoMockRecorder) BulkDeleteMaterials(ctx, uuids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkDeleteMaterials", reflect.TypeOf((*MockDBRepo)(nil).BulkDeleteMaterials), ctx, uuids)
+}
+
+// BulkPublishMaterials mocks base method.
+func (m *MockDBRepo) BulkPublishMaterials(ctx context.Context, uuids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkPublishMaterials", ctx, uuids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkPublishMaterials indicates an expected call of BulkPublishMaterials.
+func (mr *MockDBRepoMockRecorder) BulkPublishMaterials(ctx, uuids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkPublishMaterials", reflect.TypeOf((*MockDBRepo)(nil).BulkPublishMaterials), ctx, uuids)
+}
+
+// CreateMaterialReport mocks base method.
+func (m *MockDBRepo) CreateMaterialReport(ctx context.Context, materialUUID, reporterUUID, reason, comment string) (*model.ReportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMaterialReport", ctx, materialUUID, reporterUUID, reason, comment)
+	ret0, _ := ret[0].(*model.ReportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMaterialReport indicates an expected call of CreateMaterialReport.
+func (mr *MockDBRepoMockRecorder) CreateMaterialReport(ctx, materialUUID, reporterUUID, reason, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMaterialReport", reflect.TypeOf((*MockDBRepo)(nil).CreateMaterialReport), ctx, materialUUID, reporterUUID, reason, comment)
+}
+
+// DeleteMaterial mocks base method.
+func (m *MockDBRepo) DeleteMaterial(ctx context.Context, uuid string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMaterial", ctx, uuid)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMaterial indicates an expected call of DeleteMaterial.
+func (mr *MockDBRepoMockRecorder) DeleteMaterial(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMaterial", reflect.TypeOf((*MockDBRepo)(nil).DeleteMaterial), ctx, uuid)
+}
+
+// DuplicateMaterial mocks base method.
+func (m *MockDBRepo) DuplicateMaterial(ctx context.Context, sourceUUID, ownerUUID string) (*model.Material, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DuplicateMaterial", ctx, sourceUUID, ownerUUID)
+	ret0, _ := ret[0].(*model.Material)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DuplicateMaterial indicates an expected call of DuplicateMaterial.
+func (mr *MockDBRepoMockRecorder) DuplicateMaterial(ctx, sourceUUID, ownerUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DuplicateMaterial", reflect.TypeOf((*MockDBRepo)(nil).DuplicateMaterial), ctx, sourceUUID, ownerUUID)
+}
+
+// EditMaterial mocks base method.
+func (m *MockDBRepo) EditMaterial(ctx context.Context, material *model.EditMaterial) (*model.Material, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditMaterial", ctx, material)
+	ret0, _ := ret[0].(*model.Material)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditMaterial indicates an expected call of EditMaterial.
+func (mr *MockDBRepoMockRecorder) EditMaterial(ctx, material interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditMaterial", reflect.TypeOf((*MockDBRepo)(nil).EditMaterial), ctx, material)
+}
+
+// ExportMaterials mocks base method.
+func (m *MockDBRepo) ExportMaterials(ctx context.Context, filter model.ExportFilter, batchSize int, fn func(model.MaterialList) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportMaterials", ctx, filter, batchSize, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportMaterials indicates an expected call of ExportMaterials.
+func (mr *MockDBRepoMockRecorder) ExportMaterials(ctx, filter, batchSize, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportMaterials", reflect.TypeOf((*MockDBRepo)(nil).ExportMaterials), ctx, filter, batchSize, fn)
+}
+
+// GetAllMaterials mocks base method.
+func (m *MockDBRepo) GetAllMaterials(ctx context.Context, offset, limit int, includeArchived bool) (*model.MaterialList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllMaterials", ctx, offset, limit, includeArchived)
+	ret0, _ := ret[0].(*model.MaterialList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllMaterials indicates an expected call of GetAllMaterials.
+func (mr *MockDBRepoMockRecorder) GetAllMaterials(ctx, offset, limit, includeArchived interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllMaterials", reflect.TypeOf((*MockDBRepo)(nil).GetAllMaterials), ctx, offset, limit, includeArchived)
+}
+
+// GetArchivedMaterials mocks base method.
+func (m *MockDBRepo) GetArchivedMaterials(ctx context.Context, ownerUUID string, offset, limit int) (*model.MaterialList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchivedMaterials", ctx, ownerUUID, offset, limit)
+	ret0, _ := ret[0].(*model.MaterialList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchivedMaterials indicates an expected call of GetArchivedMaterials.
+func (mr *MockDBRepoMockRecorder) GetArchivedMaterials(ctx, ownerUUID, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivedMaterials", reflect.TypeOf((*MockDBRepo)(nil).GetArchivedMaterials), ctx, ownerUUID, offset, limit)
+}
+
+// GetAttachmentsByOwner mocks base method.
+func (m *MockDBRepo) GetAttachmentsByOwner(ctx context.Context, ownerUUID string) (model.MaterialAttachmentList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentsByOwner", ctx, ownerUUID)
+	ret0, _ := ret[0].(model.MaterialAttachmentList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentsByOwner indicates an expected call of GetAttachmentsByOwner.
+func (mr *MockDBRepoMockRecorder) GetAttachmentsByOwner(ctx, ownerUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentsByOwner", reflect.TypeOf((*MockDBRepo)(nil).GetAttachmentsByOwner), ctx, ownerUUID)
+}
+
+// GetDeletedMaterials mocks base method.
+func (m *MockDBRepo) GetDeletedMaterials(ctx context.Context, ownerUUID string, deletedAfter time.Time, offset, limit int) (*model.MaterialList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedMaterials", ctx, ownerUUID, deletedAfter, offset, limit)
+	ret0, _ := ret[0].(*model.MaterialList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedMaterials indicates an expected call of GetDeletedMaterials.
+func (mr *MockDBRepoMockRecorder) GetDeletedMaterials(ctx, ownerUUID, deletedAfter, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedMaterials", reflect.TypeOf((*MockDBRepo)(nil).GetDeletedMaterials), ctx, ownerUUID, deletedAfter, offset, limit)
+}
+
+// GetMaterial mocks base method.
+func (m *MockDBRepo) GetMaterial(ctx context.Context, uuid string) (*model.Material, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaterial", ctx, uuid)
+	ret0, _ := ret[0].(*model.Material)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaterial indicates an expected call of GetMaterial.
+func (mr *MockDBRepoMockRecorder) GetMaterial(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaterial", reflect.TypeOf((*MockDBRepo)(nil).GetMaterial), ctx, uuid)
+}
+
+// GetMaterialLikers mocks base method.
+func (m *MockDBRepo) GetMaterialLikers(ctx context.Context, materialUUID string, offset, limit int) (*model.MaterialLikerList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaterialLikers", ctx, materialUUID, offset, limit)
+	ret0, _ := ret[0].(*model.MaterialLikerList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaterialLikers indicates an expected call of GetMaterialLikers.
+func (mr *MockDBRepoMockRecorder) GetMaterialLikers(ctx, materialUUID, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaterialLikers", reflect.TypeOf((*MockDBRepo)(nil).GetMaterialLikers), ctx, materialUUID, offset, limit)
+}
+
+// GetMaterialOwnerUUID mocks base method.
+func (m *MockDBRepo) GetMaterialOwnerUUID(ctx context.Context, uuid string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaterialOwnerUUID", ctx, uuid)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaterialOwnerUUID indicates an expected call of GetMaterialOwnerUUID.
+func (mr *MockDBRepoMockRecorder) GetMaterialOwnerUUID(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaterialOwnerUUID", reflect.TypeOf((*MockDBRepo)(nil).GetMaterialOwnerUUID), ctx, uuid)
+}
+
+// GetMaterialsByUUIDs mocks base method.
+func (m *MockDBRepo) GetMaterialsByUUIDs(ctx context.Context, uuids []string) (model.MaterialList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaterialsByUUIDs", ctx, uuids)
+	ret0, _ := ret[0].(model.MaterialList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaterialsByUUIDs indicates an expected call of GetMaterialsByUUIDs.
+func (mr *MockDBRepoMockRecorder) GetMaterialsByUUIDs(ctx, uuids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaterialsByUUIDs", reflect.TypeOf((*MockDBRepo)(nil).GetMaterialsByUUIDs), ctx, uuids)
+}
+
+// GetMaterialsStateForUpdate mocks base method.
+func (m *MockDBRepo) GetMaterialsStateForUpdate(ctx context.Context, uuids []string) ([]model.MaterialState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaterialsStateForUpdate", ctx, uuids)
+	ret0, _ := ret[0].([]model.MaterialState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaterialsStateForUpdate indicates an expected call of GetMaterialsStateForUpdate.
+func (mr *MockDBRepoMockRecorder) GetMaterialsStateForUpdate(ctx, uuids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaterialsStateForUpdate", reflect.TypeOf((*MockDBRepo)(nil).GetMaterialsStateForUpdate), ctx, uuids)
+}
+
+// GetMaterialsTags mocks base method.
+func (m *MockDBRepo) GetMaterialsTags(ctx context.Context, uuids []string) ([]model.MaterialTag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaterialsTags", ctx, uuids)
+	ret0, _ := ret[0].([]model.MaterialTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaterialsTags indicates an expected call of GetMaterialsTags.
+func (mr *MockDBRepoMockRecorder) GetMaterialsTags(ctx, uuids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaterialsTags", reflect.TypeOf((*MockDBRepo)(nil).GetMaterialsTags), ctx, uuids)
+}
+
+// GetOpenMaterialReports mocks base method.
+func (m *MockDBRepo) GetOpenMaterialReports(ctx context.Context, materialUUID string, offset, limit int) (*model.MaterialReportList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenMaterialReports", ctx, materialUUID, offset, limit)
+	ret0, _ := ret[0].(*model.MaterialReportList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenMaterialReports indicates an expected call of GetOpenMaterialReports.
+func (mr *MockDBRepoMockRecorder) GetOpenMaterialReports(ctx, materialUUID, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenMaterialReports", reflect.TypeOf((*MockDBRepo)(nil).GetOpenMaterialReports), ctx, materialUUID, offset, limit)
+}
+
+// GetPublishedMaterialsByUUIDs mocks base method.
+func (m *MockDBRepo) GetPublishedMaterialsByUUIDs(ctx context.Context, uuids []string) (*model.MaterialList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublishedMaterialsByUUIDs", ctx, uuids)
+	ret0, _ := ret[0].(*model.MaterialList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublishedMaterialsByUUIDs indicates an expected call of GetPublishedMaterialsByUUIDs.
+func (mr *MockDBRepoMockRecorder) GetPublishedMaterialsByUUIDs(ctx, uuids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublishedMaterialsByUUIDs", reflect.TypeOf((*MockDBRepo)(nil).GetPublishedMaterialsByUUIDs), ctx, uuids)
+}
+
+// GetReactionsByUser mocks base method.
+func (m *MockDBRepo) GetReactionsByUser(ctx context.Context, userUUID string) (model.UserReactionList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReactionsByUser", ctx, userUUID)
+	ret0, _ := ret[0].(model.UserReactionList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReactionsByUser indicates an expected call of GetReactionsByUser.
+func (mr *MockDBRepoMockRecorder) GetReactionsByUser(ctx, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReactionsByUser", reflect.TypeOf((*MockDBRepo)(nil).GetReactionsByUser), ctx, userUUID)
+}
+
+// GetRelatedMaterials mocks base method.
+func (m *MockDBRepo) GetRelatedMaterials(ctx context.Context, materialUUID, excludeOwnerUUID string, offset, limit int) (*model.MaterialList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelatedMaterials", ctx, materialUUID, excludeOwnerUUID, offset, limit)
+	ret0, _ := ret[0].(*model.MaterialList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelatedMaterials indicates an expected call of GetRelatedMaterials.
+func (mr *MockDBRepoMockRecorder) GetRelatedMaterials(ctx, materialUUID, excludeOwnerUUID, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelatedMaterials", reflect.TypeOf((*MockDBRepo)(nil).GetRelatedMaterials), ctx, materialUUID, excludeOwnerUUID, offset, limit)
+}
+
+// GetReportsByReporter mocks base method.
+func (m *MockDBRepo) GetReportsByReporter(ctx context.Context, reporterUUID string) (model.MaterialReportList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportsByReporter", ctx, reporterUUID)
+	ret0, _ := ret[0].(model.MaterialReportList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReportsByReporter indicates an expected call of GetReportsByReporter.
+func (mr *MockDBRepoMockRecorder) GetReportsByReporter(ctx, reporterUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportsByReporter", reflect.TypeOf((*MockDBRepo)(nil).GetReportsByReporter), ctx, reporterUUID)
+}
+
+// GetUser mocks base method.
+func (m *MockDBRepo) GetUser(ctx context.Context, userUUID string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, userUUID)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockDBRepoMockRecorder) GetUser(ctx, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockDBRepo)(nil).GetUser), ctx, userUUID)
+}
+
+// GetUserReactions mocks base method.
+func (m *MockDBRepo) GetUserReactions(ctx context.Context, materialUUID, userUUID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserReactions", ctx, materialUUID, userUUID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserReactions indicates an expected call of GetUserReactions.
+func (mr *MockDBRepoMockRecorder) GetUserReactions(ctx, materialUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserReactions", reflect.TypeOf((*MockDBRepo)(nil).GetUserReactions), ctx, materialUUID, userUUID)
+}
+
+// HideMaterial mocks base method.
+func (m *MockDBRepo) HideMaterial(ctx context.Context, uuid string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HideMaterial", ctx, uuid)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HideMaterial indicates an expected call of HideMaterial.
+func (mr *MockDBRepoMockRecorder) HideMaterial(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HideMaterial", reflect.TypeOf((*MockDBRepo)(nil).HideMaterial), ctx, uuid)
+}
+
+// HideReportedMaterial mocks base method.
+func (m *MockDBRepo) HideReportedMaterial(ctx context.Context, materialUUID string, threshold int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HideReportedMaterial", ctx, materialUUID, threshold)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HideReportedMaterial indicates an expected call of HideReportedMaterial.
+func (mr *MockDBRepoMockRecorder) HideReportedMaterial(ctx, materialUUID, threshold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HideReportedMaterial", reflect.TypeOf((*MockDBRepo)(nil).HideReportedMaterial), ctx, materialUUID, threshold)
+}
+
+// IncrementForksCount mocks base method.
+func (m *MockDBRepo) IncrementForksCount(ctx context.Context, materialUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementForksCount", ctx, materialUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementForksCount indicates an expected call of IncrementForksCount.
+func (mr *MockDBRepoMockRecorder) IncrementForksCount(ctx, materialUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementForksCount", reflect.TypeOf((*MockDBRepo)(nil).IncrementForksCount), ctx, materialUUID)
+}
+
+// MaterialExists mocks base method.
+func (m *MockDBRepo) MaterialExists(ctx context.Context, materialUUID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaterialExists", ctx, materialUUID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MaterialExists indicates an expected call of MaterialExists.
+func (mr *MockDBRepoMockRecorder) MaterialExists(ctx, materialUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaterialExists", reflect.TypeOf((*MockDBRepo)(nil).MaterialExists), ctx, materialUUID)
+}
+
+// PublishMaterial mocks base method.
+func (m *MockDBRepo) PublishMaterial(ctx context.Context, uuid string) (*model.Material, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishMaterial", ctx, uuid)
+	ret0, _ := ret[0].(*model.Material)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishMaterial indicates an expected call of PublishMaterial.
+func (mr *MockDBRepoMockRecorder) PublishMaterial(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishMaterial", reflect.TypeOf((*MockDBRepo)(nil).PublishMaterial), ctx, uuid)
+}
+
+// RemoveReaction mocks base method.
+func (m *MockDBRepo) RemoveReaction(ctx context.Context, materialUUID, userUUID, reaction string) (*model.ReactionResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReaction", ctx, materialUUID, userUUID, reaction)
+	ret0, _ := ret[0].(*model.ReactionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveReaction indicates an expected call of RemoveReaction.
+func (mr *MockDBRepoMockRecorder) RemoveReaction(ctx, materialUUID, userUUID, reaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReaction", reflect.TypeOf((*MockDBRepo)(nil).RemoveReaction), ctx, materialUUID, userUUID, reaction)
+}
+
+// ResolveMaterialReport mocks base method.
+func (m *MockDBRepo) ResolveMaterialReport(ctx context.Context, reportUUID, moderatorUUID, resolution string) (*model.MaterialReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveMaterialReport", ctx, reportUUID, moderatorUUID, resolution)
+	ret0, _ := ret[0].(*model.MaterialReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveMaterialReport indicates an expected call of ResolveMaterialReport.
+func (mr *MockDBRepoMockRecorder) ResolveMaterialReport(ctx, reportUUID, moderatorUUID, resolution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveMaterialReport", reflect.TypeOf((*MockDBRepo)(nil).ResolveMaterialReport), ctx, reportUUID, moderatorUUID, resolution)
+}
+
+// ResolveOpenMaterialReports mocks base method.
+func (m *MockDBRepo) ResolveOpenMaterialReports(ctx context.Context, materialUUID, moderatorUUID, resolution string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveOpenMaterialReports", ctx, materialUUID, moderatorUUID, resolution)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveOpenMaterialReports indicates an expected call of ResolveOpenMaterialReports.
+func (mr *MockDBRepoMockRecorder) ResolveOpenMaterialReports(ctx, materialUUID, moderatorUUID, resolution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveOpenMaterialReports", reflect.TypeOf((*MockDBRepo)(nil).ResolveOpenMaterialReports), ctx, materialUUID, moderatorUUID, resolution)
+}
+
+// RestoreMaterial mocks base method.
+func (m *MockDBRepo) RestoreMaterial(ctx context.Context, uuid string, deletedAfter time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreMaterial", ctx, uuid, deletedAfter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreMaterial indicates an expected call of RestoreMaterial.
+func (mr *MockDBRepoMockRecorder) RestoreMaterial(ctx, uuid, deletedAfter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreMaterial", reflect.TypeOf((*MockDBRepo)(nil).RestoreMaterial), ctx, uuid, deletedAfter)
+}
+
+// SaveDraftMaterial mocks base method.
+func (m *MockDBRepo) SaveDraftMaterial(ctx context.Context, ownerUUID string, material *model.SaveDraftMaterial) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDraftMaterial", ctx, ownerUUID, material)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveDraftMaterial indicates an expected call of SaveDraftMaterial.
+func (mr *MockDBRepoMockRecorder) SaveDraftMaterial(ctx, ownerUUID, material interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDraftMaterial", reflect.TypeOf((*MockDBRepo)(nil).SaveDraftMaterial), ctx, ownerUUID, material)
+}
+
+// UnarchiveMaterial mocks base method.
+func (m *MockDBRepo) UnarchiveMaterial(ctx context.Context, uuid string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnarchiveMaterial", ctx, uuid)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnarchiveMaterial indicates an expected call of UnarchiveMaterial.
+func (mr *MockDBRepoMockRecorder) UnarchiveMaterial(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveMaterial", reflect.TypeOf((*MockDBRepo)(nil).UnarchiveMaterial), ctx, uuid)
+}
+
+// UnhideMaterial mocks base method.
+func (m *MockDBRepo) UnhideMaterial(ctx context.Context, uuid string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnhideMaterial", ctx, uuid)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnhideMaterial indicates an expected call of UnhideMaterial.
+func (mr *MockDBRepoMockRecorder) UnhideMaterial(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnhideMaterial", reflect.TypeOf((*MockDBRepo)(nil).UnhideMaterial), ctx, uuid)
+}
+
+// WithTx mocks base method.
+func (m *MockDBRepo) WithTx(ctx context.Context, cb func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", ctx, cb)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockDBRepoMockRecorder) WithTx(ctx, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockDBRepo)(nil).WithTx), ctx, cb)
+}
+
+// MockRedisRepo is a mock of RedisRepo interface.
+type MockRedisRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockRedisRepoMockRecorder
+}
+
+// MockRedisRepoMockRecorder is the mock recorder for MockRedisRepo.
+type MockRedisRepoMockRecorder struct {
+	mock *MockRedisRepo
+}
+
+// NewMockRedisRepo creates a new mock instance.
+func NewMockRedisRepo(ctrl *gomock.Controller) *MockRedisRepo {
+	mock := &MockRedisRepo{ctrl: ctrl}
+	mock.recorder = &MockRedisRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRedisRepo) EXPECT() *MockRedisRepoMockRecorder {
+	return m.recorder
+}
+
+// DeleteAutosave mocks base method.
+func (m *MockRedisRepo) DeleteAutosave(ctx context.Context, materialUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAutosave", ctx, materialUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAutosave indicates an expected call of DeleteAutosave.
+func (mr *MockRedisRepoMockRecorder) DeleteAutosave(ctx, materialUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutosave", reflect.TypeOf((*MockRedisRepo)(nil).DeleteAutosave), ctx, materialUUID)
+}
+
+// DeleteMaterial mocks base method.
+func (m *MockRedisRepo) DeleteMaterial(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMaterial", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMaterial indicates an expected call of DeleteMaterial.
+func (mr *MockRedisRepoMockRecorder) DeleteMaterial(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMaterial", reflect.TypeOf((*MockRedisRepo)(nil).DeleteMaterial), ctx, uuid)
+}
+
+// DeleteMaterials mocks base method.
+func (m *MockRedisRepo) DeleteMaterials(ctx context.Context, uuids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMaterials", ctx, uuids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMaterials indicates an expected call of DeleteMaterials.
+func (mr *MockRedisRepoMockRecorder) DeleteMaterials(ctx, uuids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMaterials", reflect.TypeOf((*MockRedisRepo)(nil).DeleteMaterials), ctx, uuids)
+}
+
+// GetAutosave mocks base method.
+func (m *MockRedisRepo) GetAutosave(ctx context.Context, materialUUID string) (*model.AutosaveDraft, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutosave", ctx, materialUUID)
+	ret0, _ := ret[0].(*model.AutosaveDraft)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutosave indicates an expected call of GetAutosave.
+func (mr *MockRedisRepoMockRecorder) GetAutosave(ctx, materialUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutosave", reflect.TypeOf((*MockRedisRepo)(nil).GetAutosave), ctx, materialUUID)
+}
+
+// GetMaterials mocks base method.
+func (m *MockRedisRepo) GetMaterials(ctx context.Context, uuids []string) (model.MaterialList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaterials", ctx, uuids)
+	ret0, _ := ret[0].(model.MaterialList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaterials indicates an expected call of GetMaterials.
+func (mr *MockRedisRepoMockRecorder) GetMaterials(ctx, uuids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaterials", reflect.TypeOf((*MockRedisRepo)(nil).GetMaterials), ctx, uuids)
+}
+
+// GetTrending mocks base method.
+func (m *MockRedisRepo) GetTrending(ctx context.Context, tag string, offset, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrending", ctx, tag, offset, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrending indicates an expected call of GetTrending.
+func (mr *MockRedisRepoMockRecorder) GetTrending(ctx, tag, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrending", reflect.TypeOf((*MockRedisRepo)(nil).GetTrending), ctx, tag, offset, limit)
+}
+
+// IncrementMaterialViews mocks base method.
+func (m *MockRedisRepo) IncrementMaterialViews(ctx context.Context, uuid, viewerUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementMaterialViews", ctx, uuid, viewerUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementMaterialViews indicates an expected call of IncrementMaterialViews.
+func (mr *MockRedisRepoMockRecorder) IncrementMaterialViews(ctx, uuid, viewerUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementMaterialViews", reflect.TypeOf((*MockRedisRepo)(nil).IncrementMaterialViews), ctx, uuid, viewerUUID)
+}
+
+// SetAutosave mocks base method.
+func (m *MockRedisRepo) SetAutosave(ctx context.Context, autosave *model.AutosaveDraft) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAutosave", ctx, autosave)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAutosave indicates an expected call of SetAutosave.
+func (mr *MockRedisRepoMockRecorder) SetAutosave(ctx, autosave interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAutosave", reflect.TypeOf((*MockRedisRepo)(nil).SetAutosave), ctx, autosave)
+}
+
+// SetMaterials mocks base method.
+func (m *MockRedisRepo) SetMaterials(ctx context.Context, materials model.MaterialList, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMaterials", ctx, materials, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMaterials indicates an expected call of SetMaterials.
+func (mr *MockRedisRepoMockRecorder) SetMaterials(ctx, materials, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaterials", reflect.TypeOf((*MockRedisRepo)(nil).SetMaterials), ctx, materials, ttl)
+}
+
+// MockKafkaProducer is a mock of KafkaProducer interface.
+type MockKafkaProducer struct {
+	ctrl     *gomock.Controller
+	recorder *MockKafkaProducerMockRecorder
+}
+
+// MockKafkaProducerMockRecorder is the mock recorder for MockKafkaProducer.
+type MockKafkaProducerMockRecorder struct {
+	mock *MockKafkaProducer
+}
+
+// NewMockKafkaProducer creates a new mock instance.
+func NewMockKafkaProducer(ctrl *gomock.Controller) *MockKafkaProducer {
+	mock := &MockKafkaProducer{ctrl: ctrl}
+	mock.recorder = &MockKafkaProducerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKafkaProducer) EXPECT() *MockKafkaProducerMockRecorder {
+	return m.recorder
+}
+
+// ProduceMessage mocks base method.
+func (m *MockKafkaProducer) ProduceMessage(ctx context.Context, message, key interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProduceMessage", ctx, message, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProduceMessage indicates an expected call of ProduceMessage.
+func (mr *MockKafkaProducerMockRecorder) ProduceMessage(ctx, message, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProduceMessage", reflect.TypeOf((*MockKafkaProducer)(nil).ProduceMessage), ctx, message, key)
+}
+
+// MockMaterialExporter is a mock of MaterialExporter interface.
+type MockMaterialExporter struct {
+	ctrl     *gomock.Controller
+	recorder *MockMaterialExporterMockRecorder
+}
+
+// MockMaterialExporterMockRecorder is the mock recorder for MockMaterialExporter.
+type MockMaterialExporterMockRecorder struct {
+	mock *MockMaterialExporter
+}
+
+// NewMockMaterialExporter creates a new mock instance.
+func NewMockMaterialExporter(ctrl *gomock.Controller) *MockMaterialExporter {
+	mock := &MockMaterialExporter{ctrl: ctrl}
+	mock.recorder = &MockMaterialExporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMaterialExporter) EXPECT() *MockMaterialExporterMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockMaterialExporter) Export(ctx context.Context, material *model.Material, format string) (*model.MaterialExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, material, format)
+	ret0, _ := ret[0].(*model.MaterialExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockMaterialExporterMockRecorder) Export(ctx, material, format interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockMaterialExporter)(nil).Export), ctx, material, format)
+}
+
+// MockCoverUploader is a mock of CoverUploader interface.
+type MockCoverUploader struct {
+	ctrl     *gomock.Controller
+	recorder *MockCoverUploaderMockRecorder
+}
+
+// MockCoverUploaderMockRecorder is the mock recorder for MockCoverUploader.
+type MockCoverUploaderMockRecorder struct {
+	mock *MockCoverUploader
+}
+
+// NewMockCoverUploader creates a new mock instance.
+func NewMockCoverUploader(ctrl *gomock.Controller) *MockCoverUploader {
+	mock := &MockCoverUploader{ctrl: ctrl}
+	mock.recorder = &MockCoverUploaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCoverUploader) EXPECT() *MockCoverUploaderMockRecorder {
+	return m.recorder
+}
+
+// Upload mocks base method.
+func (m *MockCoverUploader) Upload(ctx context.Context, materialUUID, ownerUUID string, data []byte) (*model.Cover, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, materialUUID, ownerUUID, data)
+	ret0, _ := ret[0].(*model.Cover)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockCoverUploaderMockRecorder) Upload(ctx, materialUUID, ownerUUID, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockCoverUploader)(nil).Upload), ctx, materialUUID, ownerUUID, data)
+}
+
+// MockAttachmentManager is a mock of AttachmentManager interface.
+type MockAttachmentManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentManagerMockRecorder
+}
+
+// MockAttachmentManagerMockRecorder is the mock recorder for MockAttachmentManager.
+type MockAttachmentManagerMockRecorder struct {
+	mock *MockAttachmentManager
+}
+
+// NewMockAttachmentManager creates a new mock instance.
+func NewMockAttachmentManager(ctrl *gomock.Controller) *MockAttachmentManager {
+	mock := &MockAttachmentManager{ctrl: ctrl}
+	mock.recorder = &MockAttachmentManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentManager) EXPECT() *MockAttachmentManagerMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockAttachmentManager) Delete(ctx context.Context, materialUUID, attachmentUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, materialUUID, attachmentUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAttachmentManagerMockRecorder) Delete(ctx, materialUUID, attachmentUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAttachmentManager)(nil).Delete), ctx, materialUUID, attachmentUUID)
+}
+
+// List mocks base method.
+func (m *MockAttachmentManager) List(ctx context.Context, materialUUID string) (model.MaterialAttachmentList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, materialUUID)
+	ret0, _ := ret[0].(model.MaterialAttachmentList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAttachmentManagerMockRecorder) List(ctx, materialUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAttachmentManager)(nil).List), ctx, materialUUID)
+}
+
+// Open mocks base method.
+func (m *MockAttachmentManager) Open(ctx context.Context, materialUUID, attachmentUUID string) (*model.MaterialAttachment, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", ctx, materialUUID, attachmentUUID)
+	ret0, _ := ret[0].(*model.MaterialAttachment)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockAttachmentManagerMockRecorder) Open(ctx, materialUUID, attachmentUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockAttachmentManager)(nil).Open), ctx, materialUUID, attachmentUUID)
+}
+
+// ResolveContent mocks base method.
+func (m *MockAttachmentManager) ResolveContent(ctx context.Context, materialUUID, content string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveContent", ctx, materialUUID, content)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveContent indicates an expected call of ResolveContent.
+func (mr *MockAttachmentManagerMockRecorder) ResolveContent(ctx, materialUUID, content interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveContent", reflect.TypeOf((*MockAttachmentManager)(nil).ResolveContent), ctx, materialUUID, content)
+}
+
+// Upload mocks base method.
+func (m *MockAttachmentManager) Upload(ctx context.Context, materialUUID, ownerUUID, filename string, data []byte) (*model.MaterialAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, materialUUID, ownerUUID, filename, data)
+	ret0, _ := ret[0].(*model.MaterialAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockAttachmentManagerMockRecorder) Upload(ctx, materialUUID, ownerUUID, filename, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockAttachmentManager)(nil).Upload), ctx, materialUUID, ownerUUID, filename, data)
+}
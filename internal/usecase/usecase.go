@@ -0,0 +1,1368 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	logger_lib "github.com/s21platform/logger-lib"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/s21platform/materials-service/internal/config"
+	"github.com/s21platform/materials-service/internal/model"
+	"github.com/s21platform/materials-service/internal/pkg/attachment"
+	"github.com/s21platform/materials-service/internal/pkg/auth"
+	"github.com/s21platform/materials-service/internal/pkg/mdimport"
+	"github.com/s21platform/materials-service/pkg/materials"
+)
+
+// материалы в кэше живут столько же, сколько при чтении одного материала через REST
+const materialCacheTTL = time.Hour
+
+// UseCase — бизнес-логика материалов, общая для gRPC и REST: проверка входных данных и прав,
+// изменение в транзакции, сброс кэша и отправка событий. Транспорт только достаёт пользователя
+// из контекста и переводит доменные ошибки в свой формат.
+type UseCase struct {
+	repository              DBRepo
+	redis                   RedisRepo
+	createKafkaProducer     KafkaProducer
+	editKafkaProducer       KafkaProducer
+	likeKafkaProducer       KafkaProducer
+	bulkKafkaProducer       KafkaProducer
+	moderationKafkaProducer KafkaProducer
+	exporter                MaterialExporter
+	covers                  CoverUploader
+	attachments             AttachmentManager
+	trashRetention          time.Duration
+	reactionTypes           []string
+	reportsHideThreshold    int
+	moderatorRole           string
+	importMaxFiles          int
+	importMaxBytes          int64
+	exportRole              string
+	exportBatchSize         int
+	privacyAdminRole        string
+}
+
+func New(repo DBRepo, redis RedisRepo, createKafkaProducer, editKafkaProducer, likeKafkaProducer, bulkKafkaProducer, moderationKafkaProducer KafkaProducer, exporter MaterialExporter, covers CoverUploader, attachments AttachmentManager, cfg *config.Config) *UseCase {
+	return &UseCase{
+		repository:              repo,
+		redis:                   redis,
+		createKafkaProducer:     createKafkaProducer,
+		editKafkaProducer:       editKafkaProducer,
+		likeKafkaProducer:       likeKafkaProducer,
+		bulkKafkaProducer:       bulkKafkaProducer,
+		moderationKafkaProducer: moderationKafkaProducer,
+		exporter:                exporter,
+		covers:                  covers,
+		attachments:             attachments,
+		trashRetention:          cfg.Trash.RetentionPeriod,
+		reactionTypes:           model.ReactionTypes(cfg.Reactions.Types),
+		reportsHideThreshold:    cfg.Reports.HideThreshold,
+		moderatorRole:           cfg.Reports.ModeratorRole,
+		importMaxFiles:          cfg.Import.MaxFiles,
+		importMaxBytes:          cfg.Import.MaxBytes,
+		exportRole:              cfg.Export.Role,
+		exportBatchSize:         cfg.Export.BatchSize,
+		privacyAdminRole:        cfg.Privacy.AdminRole,
+	}
+}
+
+func (u *UseCase) SaveDraftMaterial(ctx context.Context, ownerUUID string, material *model.SaveDraftMaterial) (string, error) {
+	if strings.TrimSpace(material.Title) == "" {
+		return "", model.ValidationError("title", "title is required")
+	}
+
+	materialUUID, err := u.repository.SaveDraftMaterial(ctx, ownerUUID, material)
+	if err != nil {
+		return "", fmt.Errorf("failed to save draft material: %w", err)
+	}
+	return materialUUID, nil
+}
+
+// GetMaterial отдаёт материал из кэша или базы и считает просмотр. Скрытый модератором материал
+// видят только владелец и модераторы, остальным он отдаётся как несуществующий.
+func (u *UseCase) GetMaterial(ctx context.Context, materialUUID, userUUID string) (*model.MaterialView, error) {
+	if materialUUID == "" {
+		return nil, model.ValidationError("material_uuid", "material uuid is required")
+	}
+
+	// недоступный кэш не должен ронять чтение, материал тогда достаём из базы
+	cached, err := u.redis.GetMaterials(ctx, []string{materialUUID})
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), "failed to get material from cache")
+		cached = nil
+	}
+
+	var material *model.Material
+	if len(cached) > 0 {
+		material = &cached[0]
+	} else {
+		material, err = u.repository.GetMaterial(ctx, materialUUID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get material: %w", err)
+		}
+
+		if err := u.redis.SetMaterials(ctx, model.MaterialList{*material}, materialCacheTTL); err != nil {
+			logger_lib.Error(logger_lib.WithError(ctx, err), "failed to set material in cache")
+		}
+	}
+
+	if material.HiddenAt != nil && material.OwnerUUID != userUUID && !auth.HasRole(ctx, u.moderatorRole) {
+		return nil, model.NotFoundError("material does not exist")
+	}
+
+	// просмотры автора и анонимов не влияют на рейтинг: анонимные нельзя отличить друг от друга
+	if userUUID != "" && userUUID != material.OwnerUUID {
+		if err := u.redis.IncrementMaterialViews(ctx, materialUUID, userUUID); err != nil {
+			logger_lib.Error(logger_lib.WithError(ctx, err), "failed to increment material views")
+		}
+	}
+
+	view := &model.MaterialView{Material: material}
+	if userUUID != "" {
+		view.MyReactions, err = u.repository.GetUserReactions(ctx, materialUUID, userUUID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user reactions: %w", err)
+		}
+	}
+
+	return view, nil
+}
+
+func (u *UseCase) PublishMaterial(ctx context.Context, materialUUID, userUUID string) (*model.Material, error) {
+	if materialUUID == "" {
+		return nil, model.ValidationError("uuid", "material uuid is required")
+	}
+
+	var published *model.Material
+	err := u.repository.WithTx(ctx, func(ctx context.Context) error {
+		if err := u.checkOwnedMaterial(ctx, materialUUID, userUUID, "failed to publish"); err != nil {
+			return err
+		}
+
+		var err error
+		published, err = u.repository.PublishMaterial(ctx, materialUUID)
+		if err != nil {
+			return fmt.Errorf("failed to publish material: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.invalidateMaterial(ctx, materialUUID)
+
+	msg := &materials.CreatedMaterial{
+		Material: published.FromDTO(),
+	}
+	u.produce(ctx, u.createKafkaProducer, msg, published.OwnerUUID)
+
+	return published, nil
+}
+
+func (u *UseCase) EditMaterial(ctx context.Context, userUUID string, material *model.EditMaterial) (*model.Material, error) {
+	if material.UUID == "" {
+		return nil, model.ValidationError("uuid", "material uuid is required")
+	}
+	if strings.TrimSpace(material.Title) == "" {
+		return nil, model.ValidationError("title", "title is required")
+	}
+
+	var edited *model.Material
+	err := u.repository.WithTx(ctx, func(ctx context.Context) error {
+		if err := u.checkOwnedMaterial(ctx, material.UUID, userUUID, "failed to edit"); err != nil {
+			return err
+		}
+
+		var err error
+		edited, err = u.repository.EditMaterial(ctx, material)
+		if err != nil {
+			return fmt.Errorf("failed to edit material: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.invalidateMaterial(ctx, material.UUID)
+
+	msg := &materials.EditMaterialMessage{
+		Uuid:      edited.UUID,
+		OwnerUuid: edited.OwnerUUID,
+		Title:     edited.Title,
+		EditedAt:  timestamppb.New(time.Now()),
+	}
+	u.produce(ctx, u.editKafkaProducer, msg, edited.UUID)
+
+	return edited, nil
+}
+
+// AutosaveDraft сохраняет промежуточную версию материала в отдельный слот, сам материал не меняется
+func (u *UseCase) AutosaveDraft(ctx context.Context, userUUID string, autosave *model.AutosaveDraft) (*model.AutosaveDraft, error) {
+	if autosave.MaterialUUID == "" {
+		return nil, model.ValidationError("uuid", "material uuid is required")
+	}
+
+	if err := u.checkDraft(ctx, autosave.MaterialUUID, userUUID, "failed to autosave"); err != nil {
+		return nil, err
+	}
+
+	autosave.SavedAt = time.Now()
+	if err := u.redis.SetAutosave(ctx, autosave); err != nil {
+		return nil, fmt.Errorf("failed to autosave draft: %w", err)
+	}
+
+	return autosave, nil
+}
+
+// PromoteAutosave переносит автосохранение в черновик и очищает слот; кэш и событие — как у EditMaterial
+func (u *UseCase) PromoteAutosave(ctx context.Context, materialUUID, userUUID string) (*model.Material, error) {
+	if materialUUID == "" {
+		return nil, model.ValidationError("uuid", "material uuid is required")
+	}
+
+	// проверка черновика и запись идут в одной транзакции под блокировкой строки, чтобы параллельные
+	// публикация или удаление не оказались между ними
+	var edited *model.Material
+	err := u.repository.WithTx(ctx, func(ctx context.Context) error {
+		if err := u.checkDraft(ctx, materialUUID, userUUID, "failed to promote autosave"); err != nil {
+			return err
+		}
+
+		autosave, err := u.redis.GetAutosave(ctx, materialUUID)
+		if err != nil {
+			return fmt.Errorf("failed to get autosave: %w", err)
+		}
+		if autosave == nil {
+			return model.NotFoundError("autosave not found")
+		}
+		if strings.TrimSpace(autosave.Title) == "" {
+			return model.PreconditionError("autosave has empty title")
+		}
+
+		edited, err = u.repository.EditMaterial(ctx, autosave.ToEditMaterial())
+		if err != nil {
+			return fmt.Errorf("failed to promote autosave: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.redis.DeleteAutosave(ctx, materialUUID); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), "failed to delete promoted autosave")
+	}
+
+	u.invalidateMaterial(ctx, materialUUID)
+
+	msg := &materials.EditMaterialMessage{
+		Uuid:      edited.UUID,
+		OwnerUuid: edited.OwnerUUID,
+		Title:     edited.Title,
+		EditedAt:  timestamppb.New(time.Now()),
+	}
+	u.produce(ctx, u.editKafkaProducer, msg, edited.UUID)
+
+	return edited, nil
+}
+
+// DuplicateMaterial создаёт черновик-копию материала с отметкой об источнике. Свой материал можно
+// скопировать в любом состоянии, чужой — только опубликованный.
+func (u *UseCase) DuplicateMaterial(ctx context.Context, materialUUID, userUUID string) (*model.Material, error) {
+	if materialUUID == "" {
+		return nil, model.ValidationError("uuid", "material uuid is required")
+	}
+
+	source, err := u.repository.GetMaterial(ctx, materialUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get material: %w", err)
+	}
+	if source.DeletedAt != nil {
+		return nil, model.NotFoundError("failed to duplicate: material is deleted")
+	}
+	if source.OwnerUUID != userUUID && (source.Status != "published" || source.ArchivedAt != nil) {
+		return nil, model.ForbiddenError("failed to duplicate: material is not published")
+	}
+
+	var duplicate *model.Material
+	err = u.repository.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		duplicate, err = u.repository.DuplicateMaterial(ctx, materialUUID, userUUID)
+		if err != nil {
+			return fmt.Errorf("failed to duplicate material: %w", err)
+		}
+
+		if err := u.repository.IncrementForksCount(ctx, materialUUID); err != nil {
+			return fmt.Errorf("failed to increment forks count: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// у источника изменился счётчик форков
+	u.invalidateMaterial(ctx, materialUUID)
+
+	return duplicate, nil
+}
+
+// BulkOperation применяет действие к материалам пользователя и возвращает результат по каждому в порядке uuids.
+// Чужие, удалённые и неподходящие по состоянию материалы пропускаются с ошибкой в результате, остальные
+// меняются в одной транзакции. По успешным уходит одно событие в топик bulk-операций.
+func (u *UseCase) BulkOperation(ctx context.Context, action, userUUID string, uuids, tags []string) (model.BulkResultList, error) {
+	if len(uuids) == 0 {
+		return nil, model.ValidationError("uuids", "material uuids are required")
+	}
+	if len(uuids) > model.BulkMaxItems {
+		return nil, model.ValidationError("uuids", "too many materials, max %d", model.BulkMaxItems)
+	}
+
+	if action == model.BulkActionTag {
+		tags = model.NormalizeTags(tags)
+		if len(tags) == 0 {
+			return nil, model.ValidationError("tags", "tags are required")
+		}
+		for _, tag := range tags {
+			if len([]rune(tag)) > model.TagMaxLength {
+				return nil, model.ValidationError("tags", "tag is too long, max %d characters", model.TagMaxLength)
+			}
+		}
+	}
+
+	validUUIDs, invalidResults := model.SplitValidUUIDs(uuids)
+
+	var checkedResults model.BulkResultList
+	err := u.repository.WithTx(ctx, func(ctx context.Context) error {
+		if len(validUUIDs) == 0 {
+			return nil
+		}
+
+		states, err := u.repository.GetMaterialsStateForUpdate(ctx, validUUIDs)
+		if err != nil {
+			return fmt.Errorf("failed to get materials state: %w", err)
+		}
+
+		var allowed []string
+		allowed, checkedResults = model.CheckBulkItems(action, userUUID, validUUIDs, states)
+		if len(allowed) == 0 {
+			return nil
+		}
+
+		switch action {
+		case model.BulkActionDelete:
+			err = u.repository.BulkDeleteMaterials(ctx, allowed)
+		case model.BulkActionArchive:
+			err = u.repository.BulkArchiveMaterials(ctx, allowed)
+		case model.BulkActionPublish:
+			err = u.repository.BulkPublishMaterials(ctx, allowed)
+		case model.BulkActionTag:
+			err = u.repository.AddMaterialsTags(ctx, allowed, tags)
+		default:
+			return fmt.Errorf("unknown bulk action %q", action)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to %s materials: %w", action, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := model.MergeBulkResults(uuids, invalidResults, checkedResults)
+	succeeded := results.SucceededUUIDs()
+	if len(succeeded) == 0 {
+		return results, nil
+	}
+
+	if action != model.BulkActionTag {
+		if err := u.redis.DeleteMaterials(ctx, succeeded); err != nil {
+			logger_lib.Error(logger_lib.WithError(ctx, err), "failed to invalidate materials cache")
+		}
+	}
+
+	msg := &materials.BulkOperationMessage{
+		Action:      action,
+		OwnerUuid:   userUUID,
+		Uuids:       succeeded,
+		Tags:        tags,
+		ProcessedAt: timestamppb.Now(),
+	}
+	u.produce(ctx, u.bulkKafkaProducer, msg, userUUID)
+
+	return results, nil
+}
+
+// ToggleLike ставит лайк, а если он уже стоял — снимает
+func (u *UseCase) ToggleLike(ctx context.Context, materialUUID, userUUID string) (*model.LikeResult, error) {
+	if materialUUID == "" {
+		return nil, model.ValidationError("material_uuid", "material uuid is required")
+	}
+
+	like := &model.LikeResult{IsLiked: true}
+	err := u.repository.WithTx(ctx, func(ctx context.Context) error {
+		result, err := u.repository.AddReaction(ctx, materialUUID, userUUID, model.ReactionLike)
+		if err != nil {
+			return fmt.Errorf("failed to add like: %w", err)
+		}
+
+		if !result.Changed {
+			like.IsLiked = false
+			result, err = u.repository.RemoveReaction(ctx, materialUUID, userUUID, model.ReactionLike)
+			if err != nil {
+				return fmt.Errorf("failed to remove like: %w", err)
+			}
+		}
+
+		like.LikesCount = result.LikesCount
+		like.Changed = result.Changed
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.likeChanged(ctx, materialUUID, like)
+
+	return like, nil
+}
+
+// SetLike приводит лайк к нужному состоянию; повторный запрос с тем же состоянием ничего не меняет
+func (u *UseCase) SetLike(ctx context.Context, materialUUID, userUUID string, liked bool) (*model.LikeResult, error) {
+	if materialUUID == "" {
+		return nil, model.ValidationError("material_uuid", "material uuid is required")
+	}
+
+	like := &model.LikeResult{IsLiked: liked}
+	err := u.repository.WithTx(ctx, func(ctx context.Context) error {
+		exists, err := u.repository.MaterialExists(ctx, materialUUID)
+		if err != nil {
+			return fmt.Errorf("failed to check material existence: %w", err)
+		}
+		if !exists {
+			return model.NotFoundError("failed to set like: material doesn't exist")
+		}
+
+		var result *model.ReactionResult
+		if liked {
+			result, err = u.repository.AddReaction(ctx, materialUUID, userUUID, model.ReactionLike)
+		} else {
+			result, err = u.repository.RemoveReaction(ctx, materialUUID, userUUID, model.ReactionLike)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to set like: %w", err)
+		}
+
+		like.LikesCount = result.LikesCount
+		like.Changed = result.Changed
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.likeChanged(ctx, materialUUID, like)
+
+	return like, nil
+}
+
+// ChangeReaction ставит (set) или снимает реакцию пользователя. Изменение лайка дополнительно
+// отправляет событие лайка, как ToggleLike и SetLike.
+func (u *UseCase) ChangeReaction(ctx context.Context, materialUUID, userUUID, reaction string, set bool) (*model.ReactionState, error) {
+	if materialUUID == "" {
+		return nil, model.ValidationError("material_uuid", "material uuid is required")
+	}
+
+	reaction = strings.ToLower(strings.TrimSpace(reaction))
+	if !model.IsAllowedReaction(u.reactionTypes, reaction) {
+		return nil, model.ValidationError("reaction", "unknown reaction type, allowed: %s", strings.Join(u.reactionTypes, ", "))
+	}
+
+	var (
+		result      *model.ReactionResult
+		myReactions []string
+	)
+	err := u.repository.WithTx(ctx, func(ctx context.Context) error {
+		exists, err := u.repository.MaterialExists(ctx, materialUUID)
+		if err != nil {
+			return fmt.Errorf("failed to check material existence: %w", err)
+		}
+		if !exists {
+			return model.NotFoundError("failed to change reaction: material doesn't exist")
+		}
+
+		if set {
+			result, err = u.repository.AddReaction(ctx, materialUUID, userUUID, reaction)
+		} else {
+			result, err = u.repository.RemoveReaction(ctx, materialUUID, userUUID, reaction)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to change reaction: %w", err)
+		}
+
+		myReactions, err = u.repository.GetUserReactions(ctx, materialUUID, userUUID)
+		if err != nil {
+			return fmt.Errorf("failed to get user reactions: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if reaction == model.ReactionLike {
+		u.likeChanged(ctx, materialUUID, &model.LikeResult{IsLiked: set, LikesCount: result.LikesCount, Changed: result.Changed})
+	} else if result.Changed {
+		u.invalidateMaterial(ctx, materialUUID)
+	}
+
+	return &model.ReactionState{
+		Counts:      result.Counts,
+		MyReactions: myReactions,
+	}, nil
+}
+
+// DeleteMaterial переносит материал в корзину, откуда его можно восстановить в течение срока хранения
+func (u *UseCase) DeleteMaterial(ctx context.Context, materialUUID, userUUID string) error {
+	if materialUUID == "" {
+		return model.ValidationError("uuid", "material uuid is required")
+	}
+
+	err := u.repository.WithTx(ctx, func(ctx context.Context) error {
+		if err := u.checkOwner(ctx, materialUUID, userUUID, "failed to delete"); err != nil {
+			return err
+		}
+
+		rowsAffected, err := u.repository.DeleteMaterial(ctx, materialUUID)
+		if err != nil {
+			return fmt.Errorf("failed to delete material: %w", err)
+		}
+		if rowsAffected == 0 {
+			return model.NotFoundError("failed to delete: material already deleted or not found")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	u.statusChanged(ctx, model.BulkActionDelete, materialUUID, userUUID)
+
+	return nil
+}
+
+func (u *UseCase) ArchiveMaterial(ctx context.Context, materialUUID, userUUID string) error {
+	if materialUUID == "" {
+		return model.ValidationError("uuid", "material uuid is required")
+	}
+
+	err := u.repository.WithTx(ctx, func(ctx context.Context) error {
+		if err := u.checkOwner(ctx, materialUUID, userUUID, "failed to archive"); err != nil {
+			return err
+		}
+
+		rowsAffected, err := u.repository.ArchivedMaterial(ctx, materialUUID)
+		if err != nil {
+			return fmt.Errorf("failed to archive material: %w", err)
+		}
+		if rowsAffected == 0 {
+			return model.NotFoundError("failed to archive: material already archived or not found")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	u.statusChanged(ctx, model.BulkActionArchive, materialUUID, userUUID)
+
+	return nil
+}
+
+// UnarchiveMaterial возвращает материалу статус, который был до архивации
+func (u *UseCase) UnarchiveMaterial(ctx context.Context, materialUUID, userUUID string) (*model.Material, error) {
+	if materialUUID == "" {
+		return nil, model.ValidationError("uuid", "material uuid is required")
+	}
+
+	var unarchived *model.Material
+	err := u.repository.WithTx(ctx, func(ctx context.Context) error {
+		if err := u.checkOwner(ctx, materialUUID, userUUID, "failed to unarchive"); err != nil {
+			return err
+		}
+
+		rowsAffected, err := u.repository.UnarchiveMaterial(ctx, materialUUID)
+		if err != nil {
+			return fmt.Errorf("failed to unarchive material: %w", err)
+		}
+		if rowsAffected == 0 {
+			return model.NotFoundError("failed to unarchive: material is not archived or not found")
+		}
+
+		unarchived, err = u.repository.GetMaterial(ctx, materialUUID)
+		if err != nil {
+			return fmt.Errorf("failed to get material: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.statusChanged(ctx, model.BulkActionUnarchive, materialUUID, userUUID)
+
+	return unarchived, nil
+}
+
+// RestoreMaterial достаёт материал из корзины, если срок хранения ещё не истёк
+func (u *UseCase) RestoreMaterial(ctx context.Context, materialUUID, userUUID string) (*model.Material, error) {
+	if materialUUID == "" {
+		return nil, model.ValidationError("uuid", "material uuid is required")
+	}
+
+	var restored *model.Material
+	err := u.repository.WithTx(ctx, func(ctx context.Context) error {
+		if err := u.checkOwner(ctx, materialUUID, userUUID, "failed to restore"); err != nil {
+			return err
+		}
+
+		rowsAffected, err := u.repository.RestoreMaterial(ctx, materialUUID, time.Now().Add(-u.trashRetention))
+		if err != nil {
+			return fmt.Errorf("failed to restore material: %w", err)
+		}
+		if rowsAffected == 0 {
+			return model.NotFoundError("failed to restore: material is not in trash or retention period expired")
+		}
+
+		restored, err = u.repository.GetMaterial(ctx, materialUUID)
+		if err != nil {
+			return fmt.Errorf("failed to get material: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.statusChanged(ctx, model.BulkActionRestore, materialUUID, userUUID)
+
+	return restored, nil
+}
+
+// GetMaterialsByUUIDs отдаёт материалы в порядке uuids: попадания берутся из кэша, промахи одним запросом
+// из базы с последующим заполнением кэша. Отсутствующие и невидимые пользователю материалы пропускаются.
+func (u *UseCase) GetMaterialsByUUIDs(ctx context.Context, uuids []string, userUUID string) (model.MaterialList, error) {
+	if len(uuids) == 0 {
+		return nil, model.ValidationError("uuids", "material uuids are required")
+	}
+	if len(uuids) > model.BatchMaxItems {
+		return nil, model.ValidationError("uuids", "too many materials, max %d", model.BatchMaxItems)
+	}
+
+	unique := make([]string, 0, len(uuids))
+	seen := make(map[string]struct{}, len(uuids))
+	for _, materialUUID := range uuids {
+		if _, err := uuid.Parse(materialUUID); err != nil {
+			return nil, model.ValidationError("uuids", "invalid material uuid: %s", materialUUID)
+		}
+		if _, ok := seen[materialUUID]; ok {
+			continue
+		}
+		seen[materialUUID] = struct{}{}
+		unique = append(unique, materialUUID)
+	}
+
+	// недоступный кэш не должен ронять чтение, все материалы тогда достаём из базы
+	found, err := u.redis.GetMaterials(ctx, unique)
+	if err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), "failed to get materials from cache")
+		found = nil
+	}
+
+	cached := make(map[string]struct{}, len(found))
+	for _, material := range found {
+		cached[material.UUID] = struct{}{}
+	}
+	misses := make([]string, 0, len(unique)-len(cached))
+	for _, materialUUID := range unique {
+		if _, ok := cached[materialUUID]; !ok {
+			misses = append(misses, materialUUID)
+		}
+	}
+
+	if len(misses) > 0 {
+		fetched, err := u.repository.GetMaterialsByUUIDs(ctx, misses)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get materials: %w", err)
+		}
+
+		if err := u.redis.SetMaterials(ctx, fetched, materialCacheTTL); err != nil {
+			logger_lib.Error(logger_lib.WithError(ctx, err), "failed to set materials in cache")
+		}
+		found = append(found, fetched...)
+	}
+
+	isModerator := auth.HasRole(ctx, u.moderatorRole)
+	visible := make(model.MaterialList, 0, len(found))
+	for i := range found {
+		if found[i].VisibleTo(userUUID, isModerator) {
+			visible = append(visible, found[i])
+		}
+	}
+
+	return visible.SortByUUIDs(uuids), nil
+}
+
+func (u *UseCase) GetAllMaterials(ctx context.Context, offset, limit int, includeArchived bool) (model.MaterialList, error) {
+	all, err := u.repository.GetAllMaterials(ctx, offset, limit, includeArchived)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get paginated materials: %w", err)
+	}
+	return *all, nil
+}
+
+// GetDeletedMaterials отдаёт материалы пользователя в корзине, которые ещё можно восстановить
+func (u *UseCase) GetDeletedMaterials(ctx context.Context, userUUID string, offset, limit int) (model.MaterialList, error) {
+	deleted, err := u.repository.GetDeletedMaterials(ctx, userUUID, time.Now().Add(-u.trashRetention), offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted materials: %w", err)
+	}
+	return *deleted, nil
+}
+
+func (u *UseCase) GetArchivedMaterials(ctx context.Context, userUUID string, offset, limit int) (model.MaterialList, error) {
+	archived, err := u.repository.GetArchivedMaterials(ctx, userUUID, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get archived materials: %w", err)
+	}
+	return *archived, nil
+}
+
+// ListLikers отдаёт тех, кто лайкнул материал. Список доступен только тем, кому виден сам материал
+func (u *UseCase) ListLikers(ctx context.Context, materialUUID, userUUID string, offset, limit int) (model.MaterialLikerList, error) {
+	if materialUUID == "" {
+		return nil, model.ValidationError("material_uuid", "material uuid is required")
+	}
+
+	material, err := u.repository.GetMaterial(ctx, materialUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get material: %w", err)
+	}
+	if !material.VisibleTo(userUUID, auth.HasRole(ctx, u.moderatorRole)) {
+		return nil, model.NotFoundError("failed to list likers: material doesn't exist")
+	}
+
+	likers, err := u.repository.GetMaterialLikers(ctx, materialUUID, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get material likers: %w", err)
+	}
+	return *likers, nil
+}
+
+// GetTrending отдаёт страницу рейтинга популярных материалов, общего или по тегу
+func (u *UseCase) GetTrending(ctx context.Context, tag string, offset, limit int) (model.MaterialList, error) {
+	uuids, err := u.redis.GetTrending(ctx, strings.ToLower(strings.TrimSpace(tag)), offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trending materials: %w", err)
+	}
+	if len(uuids) == 0 {
+		return nil, nil
+	}
+
+	// рейтинг пересчитывается периодически, поэтому видимость проверяем заново
+	published, err := u.repository.GetPublishedMaterialsByUUIDs(ctx, uuids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get materials: %w", err)
+	}
+	return published.SortByUUIDs(uuids), nil
+}
+
+// GetRelated отдаёт материалы, похожие на указанный, если он сам виден пользователю. Собственные материалы
+// пользователя не рекомендуются, анонимным показываются все
+func (u *UseCase) GetRelated(ctx context.Context, materialUUID, userUUID string, offset, limit int) (model.MaterialList, error) {
+	if materialUUID == "" {
+		return nil, model.ValidationError("material_uuid", "material uuid is required")
+	}
+
+	material, err := u.repository.GetMaterial(ctx, materialUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get material: %w", err)
+	}
+	if !material.VisibleTo(userUUID, auth.HasRole(ctx, u.moderatorRole)) {
+		return nil, model.NotFoundError("failed to get related materials: material doesn't exist")
+	}
+
+	related, err := u.repository.GetRelatedMaterials(ctx, materialUUID, userUUID, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get related materials: %w", err)
+	}
+	return *related, nil
+}
+
+// ImportMaterials создаёт материалы владельца из Markdown-файлов и zip-архивов. Импорт атомарный: если хотя бы
+// один файл не прошёл проверку, ничего не записывается, а отчёт показывает ошибку по каждому файлу.
+// В режиме dryRun файлы только проверяются.
+func (u *UseCase) ImportMaterials(ctx context.Context, ownerUUID string, files []model.ImportFile, dryRun bool) (*model.ImportReport, error) {
+	if ownerUUID == "" {
+		return nil, model.ValidationError("owner_uuid", "owner uuid is required")
+	}
+	if len(files) == 0 {
+		return nil, model.ValidationError("files", "files are required")
+	}
+
+	expanded, err := mdimport.Expand(files, u.importMaxFiles, u.importMaxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &model.ImportReport{
+		DryRun:  dryRun,
+		Results: make([]model.ImportResult, len(expanded)),
+	}
+	parsed := make(map[int]*model.ImportedMaterial, len(expanded))
+	for i, file := range expanded {
+		material, err := mdimport.Parse(file)
+		if err != nil {
+			report.Results[i] = model.ImportResult{Filename: file.Name, Error: err.Error()}
+			continue
+		}
+
+		parsed[i] = material
+		report.Results[i] = model.ImportResult{
+			Filename: file.Name,
+			Title:    material.Draft.Title,
+			Status:   material.Status,
+			Success:  true,
+		}
+	}
+
+	if dryRun || len(parsed) < len(expanded) {
+		return report, nil
+	}
+
+	var published []*model.Material
+	err = u.repository.WithTx(ctx, func(ctx context.Context) error {
+		for i := range expanded {
+			material := parsed[i]
+
+			materialUUID, err := u.repository.SaveDraftMaterial(ctx, ownerUUID, &material.Draft)
+			if err != nil {
+				return fmt.Errorf("%s: failed to save material: %w", material.Filename, err)
+			}
+
+			if len(material.Tags) > 0 {
+				if err := u.repository.AddMaterialsTags(ctx, []string{materialUUID}, material.Tags); err != nil {
+					return fmt.Errorf("%s: %w", material.Filename, err)
+				}
+			}
+
+			if material.Status == model.ImportStatusPublished {
+				publishedMaterial, err := u.repository.PublishMaterial(ctx, materialUUID)
+				if err != nil {
+					return fmt.Errorf("%s: failed to publish material: %w", material.Filename, err)
+				}
+				published = append(published, publishedMaterial)
+			}
+
+			report.Results[i].MaterialUUID = materialUUID
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	report.Committed = true
+
+	for _, material := range published {
+		msg := &materials.CreatedMaterial{
+			Material: material.FromDTO(),
+		}
+		u.produce(ctx, u.createKafkaProducer, msg, material.OwnerUUID)
+	}
+
+	return report, nil
+}
+
+// ExportMaterial собирает файл материала в запрошенном формате. Выгрузить можно и черновик,
+// и архивный материал, но только владельцу: других участников у материала нет.
+func (u *UseCase) ExportMaterial(ctx context.Context, materialUUID, userUUID, format string) (*model.MaterialExport, error) {
+	if materialUUID == "" {
+		return nil, model.ValidationError("uuid", "material uuid is required")
+	}
+	if format == "" {
+		format = model.ExportFormatMarkdown
+	}
+
+	if err := u.checkOwner(ctx, materialUUID, userUUID, "failed to export"); err != nil {
+		return nil, err
+	}
+
+	material, err := u.repository.GetMaterial(ctx, materialUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get material: %w", err)
+	}
+	if material.DeletedAt != nil {
+		return nil, model.NotFoundError("material does not exist")
+	}
+
+	export, err := u.exporter.Export(ctx, material, format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export material: %w", err)
+	}
+	return export, nil
+}
+
+// ReportMaterial создаёт жалобу на материал. Когда открытых жалоб набирается порог, материал скрывается
+// автоматически, а модераторы получают событие.
+func (u *UseCase) ReportMaterial(ctx context.Context, materialUUID, userUUID, reason, comment string) (*model.CreatedReport, error) {
+	if materialUUID == "" {
+		return nil, model.ValidationError("material_uuid", "material uuid is required")
+	}
+
+	reason = strings.ToLower(strings.TrimSpace(reason))
+	if !model.IsAllowedReportReason(reason) {
+		return nil, model.ValidationError("reason", "unknown report reason, allowed: %s", strings.Join(model.ReportReasons, ", "))
+	}
+
+	comment = strings.TrimSpace(comment)
+	if len([]rune(comment)) > model.ReportCommentMaxLength {
+		return nil, model.ValidationError("comment", "report comment is too long, max %d characters", model.ReportCommentMaxLength)
+	}
+
+	// жаловаться можно только на то, что пользователь видит: удалённые, чужие черновики
+	// и уже скрытые материалы для него не существуют
+	material, err := u.repository.GetMaterial(ctx, materialUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get material: %w", err)
+	}
+	if !material.VisibleTo(userUUID, false) {
+		return nil, model.NotFoundError("failed to report: material doesn't exist")
+	}
+	if material.OwnerUUID == userUUID {
+		return nil, model.ForbiddenError("failed to report: cannot report own material")
+	}
+
+	result, err := u.repository.CreateMaterialReport(ctx, materialUUID, userUUID, reason, comment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create report: %w", err)
+	}
+	if result.UUID == nil {
+		return nil, model.ConflictError("failed to report: material is already reported by user")
+	}
+
+	report := &model.CreatedReport{UUID: *result.UUID}
+	if u.reportsHideThreshold > 0 && result.OpenReports >= u.reportsHideThreshold {
+		report.MaterialHidden, err = u.repository.HideReportedMaterial(ctx, materialUUID, u.reportsHideThreshold)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hide reported material: %w", err)
+		}
+	}
+
+	if report.MaterialHidden {
+		u.invalidateMaterial(ctx, materialUUID)
+		u.produceModerationDecision(ctx, &materials.ModerationDecisionMessage{
+			Action:       model.ModerationActionAutoHidden,
+			MaterialUuid: materialUUID,
+			ReportUuid:   report.UUID,
+			Reason:       fmt.Sprintf("%d open reports", result.OpenReports),
+		})
+	}
+
+	return report, nil
+}
+
+// ListMaterialReports отдаёт модератору открытые жалобы, по одному материалу или по всем
+func (u *UseCase) ListMaterialReports(ctx context.Context, materialUUID string, offset, limit int) (model.MaterialReportList, error) {
+	if err := u.checkModerator(ctx); err != nil {
+		return nil, err
+	}
+
+	reports, err := u.repository.GetOpenMaterialReports(ctx, materialUUID, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reports: %w", err)
+	}
+	return *reports, nil
+}
+
+func (u *UseCase) ResolveMaterialReport(ctx context.Context, reportUUID, moderatorUUID, resolution string) (*model.MaterialReport, error) {
+	if reportUUID == "" {
+		return nil, model.ValidationError("report_uuid", "report uuid is required")
+	}
+	if !model.IsReportResolution(resolution) {
+		return nil, model.ValidationError("resolution", "resolution must be actioned or dismissed")
+	}
+	if err := u.checkModerator(ctx); err != nil {
+		return nil, err
+	}
+
+	report, err := u.repository.ResolveMaterialReport(ctx, reportUUID, moderatorUUID, resolution)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve report: %w", err)
+	}
+	if report == nil {
+		return nil, model.NotFoundError("failed to resolve: report is already resolved or not found")
+	}
+
+	u.produceModerationDecision(ctx, &materials.ModerationDecisionMessage{
+		Action:        model.ModerationActionReportResolved,
+		MaterialUuid:  report.MaterialUUID,
+		ReportUuid:    report.UUID,
+		ModeratorUuid: moderatorUUID,
+		Resolution:    report.Status,
+		Reason:        report.Reason,
+	})
+
+	return report, nil
+}
+
+// ModerateMaterial скрывает или возвращает материал и закрывает открытые жалобы на него: при скрытии
+// как actioned, при возврате как dismissed, чтобы старые жалобы не скрыли материал повторно.
+// Возвращает количество закрытых жалоб.
+func (u *UseCase) ModerateMaterial(ctx context.Context, materialUUID, moderatorUUID, reason string, hide bool) (int64, error) {
+	if materialUUID == "" {
+		return 0, model.ValidationError("material_uuid", "material uuid is required")
+	}
+	if err := u.checkModerator(ctx); err != nil {
+		return 0, err
+	}
+
+	action, resolution := model.ModerationActionUnhidden, model.ReportStatusDismissed
+	if hide {
+		action, resolution = model.ModerationActionHidden, model.ReportStatusActioned
+	}
+
+	var resolved int64
+	err := u.repository.WithTx(ctx, func(ctx context.Context) error {
+		var (
+			rowsAffected int64
+			err          error
+		)
+		if hide {
+			rowsAffected, err = u.repository.HideMaterial(ctx, materialUUID)
+		} else {
+			rowsAffected, err = u.repository.UnhideMaterial(ctx, materialUUID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to change material visibility: %w", err)
+		}
+		if rowsAffected == 0 {
+			return model.NotFoundError("failed to moderate: material is already %s or not found", action)
+		}
+
+		resolved, err = u.repository.ResolveOpenMaterialReports(ctx, materialUUID, moderatorUUID, resolution)
+		if err != nil {
+			return fmt.Errorf("failed to resolve reports: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	u.invalidateMaterial(ctx, materialUUID)
+	u.produceModerationDecision(ctx, &materials.ModerationDecisionMessage{
+		Action:        action,
+		MaterialUuid:  materialUUID,
+		ModeratorUuid: moderatorUUID,
+		Resolution:    resolution,
+		Reason:        reason,
+	})
+
+	return resolved, nil
+}
+
+// checkModerator проверяет роль модератора у пользователя из контекста
+// UploadCover сохраняет обложку материала с миниатюрами и сбрасывает кэш материала
+func (u *UseCase) UploadCover(ctx context.Context, materialUUID, userUUID string, data []byte) (*model.Cover, error) {
+	if materialUUID == "" {
+		return nil, model.ValidationError("material_uuid", "material uuid is required")
+	}
+	if len(data) == 0 {
+		return nil, model.ValidationError("file", "cover image is required")
+	}
+
+	if err := u.checkOwner(ctx, materialUUID, userUUID, "failed to upload cover"); err != nil {
+		return nil, err
+	}
+
+	uploaded, err := u.covers.Upload(ctx, materialUUID, userUUID, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload cover: %w", err)
+	}
+
+	u.invalidateMaterial(ctx, materialUUID)
+
+	return uploaded, nil
+}
+
+func (u *UseCase) UploadAttachment(ctx context.Context, materialUUID, userUUID, filename string, data []byte) (*model.MaterialAttachment, error) {
+	if materialUUID == "" {
+		return nil, model.ValidationError("material_uuid", "material uuid is required")
+	}
+
+	if err := u.checkOwner(ctx, materialUUID, userUUID, "failed to upload attachment"); err != nil {
+		return nil, err
+	}
+
+	uploaded, err := u.attachments.Upload(ctx, materialUUID, userUUID, filename, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload attachment: %w", err)
+	}
+	return uploaded, nil
+}
+
+func (u *UseCase) ListAttachments(ctx context.Context, materialUUID, userUUID string) (model.MaterialAttachmentList, error) {
+	if materialUUID == "" {
+		return nil, model.ValidationError("material_uuid", "material uuid is required")
+	}
+
+	if err := u.checkOwner(ctx, materialUUID, userUUID, "failed to list attachments"); err != nil {
+		return nil, err
+	}
+
+	attachments, err := u.attachments.List(ctx, materialUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+	return attachments, nil
+}
+
+func (u *UseCase) DeleteAttachment(ctx context.Context, materialUUID, attachmentUUID, userUUID string) error {
+	if materialUUID == "" || attachmentUUID == "" {
+		return model.ValidationError("attachment_uuid", "material uuid and attachment uuid are required")
+	}
+
+	if err := u.checkOwner(ctx, materialUUID, userUUID, "failed to delete attachment"); err != nil {
+		return err
+	}
+
+	if err := u.attachments.Delete(ctx, materialUUID, attachmentUUID); err != nil {
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+	return nil
+}
+
+// OpenAttachment отдаёт файл вложения тем, кому виден материал. Скрытые, черновые и удалённые материалы
+// неотличимы от отсутствующих
+func (u *UseCase) OpenAttachment(ctx context.Context, materialUUID, attachmentUUID, userUUID string) (*model.MaterialAttachment, []byte, error) {
+	material, err := u.repository.GetMaterial(ctx, materialUUID)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return nil, nil, fmt.Errorf("failed to get material: %w", err)
+	}
+	if material == nil || !material.VisibleTo(userUUID, auth.HasRole(ctx, u.moderatorRole)) {
+		return nil, nil, model.NotFoundError("attachment not found")
+	}
+
+	file, data, err := u.attachments.Open(ctx, materialUUID, attachmentUUID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open attachment: %w", err)
+	}
+	return file, data, nil
+}
+
+// ResolveContent заменяет ссылки на вложения в content материала на их URL
+func (u *UseCase) ResolveContent(ctx context.Context, materialUUID, content string) (string, error) {
+	if !attachment.HasReferences(content) {
+		return content, nil
+	}
+
+	resolved, err := u.attachments.ResolveContent(ctx, materialUUID, content)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve attachments: %w", err)
+	}
+	return resolved, nil
+}
+
+// ExportMaterials передаёт в fn материалы пачками по мере чтения из базы. Всё подряд может выгружать только
+// сервис с ролью экспорта, пользователь — только свои материалы
+func (u *UseCase) ExportMaterials(ctx context.Context, userUUID string, filter model.ExportFilter, fn func(model.MaterialList) error) error {
+	if err := filter.Validate(); err != nil {
+		return err
+	}
+
+	if !auth.HasRole(ctx, u.exportRole) && (userUUID == "" || filter.OwnerUUID != userUUID) {
+		return model.ForbiddenError("only own materials can be exported")
+	}
+
+	if err := u.repository.ExportMaterials(ctx, filter, u.exportBatchSize, fn); err != nil {
+		return fmt.Errorf("failed to export materials: %w", err)
+	}
+	return nil
+}
+
+// ExportUserData собирает всё, что сервис хранит о пользователе. Выгрузку делает служба с ролью
+// администратора приватности, сам пользователь её не запрашивает
+func (u *UseCase) ExportUserData(ctx context.Context, userUUID string) (*model.UserData, error) {
+	if !auth.HasRole(ctx, u.privacyAdminRole) {
+		return nil, model.ForbiddenError("privacy admin role is required")
+	}
+
+	if _, err := uuid.Parse(userUUID); err != nil {
+		return nil, model.ValidationError("user_uuid", "invalid user uuid")
+	}
+
+	data := &model.UserData{UserUUID: userUUID}
+
+	profile, err := u.repository.GetUser(ctx, userUUID)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	data.Profile = profile
+
+	filter := model.ExportFilter{OwnerUUID: userUUID, IncludeDeleted: true}
+	err = u.repository.ExportMaterials(ctx, filter, u.exportBatchSize, func(batch model.MaterialList) error {
+		data.Materials = append(data.Materials, batch...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user materials: %w", err)
+	}
+
+	if len(data.Materials) > 0 {
+		materialUUIDs := make([]string, 0, len(data.Materials))
+		for _, material := range data.Materials {
+			materialUUIDs = append(materialUUIDs, material.UUID)
+		}
+
+		data.Tags, err = u.repository.GetMaterialsTags(ctx, materialUUIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get material tags: %w", err)
+		}
+	}
+
+	data.Reactions, err = u.repository.GetReactionsByUser(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user reactions: %w", err)
+	}
+
+	data.Reports, err = u.repository.GetReportsByReporter(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user reports: %w", err)
+	}
+
+	data.Attachments, err = u.repository.GetAttachmentsByOwner(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user attachments: %w", err)
+	}
+
+	if data.Empty() {
+		return nil, model.NotFoundError("no data stored for user")
+	}
+	return data, nil
+}
+
+func (u *UseCase) checkModerator(ctx context.Context) error {
+	if !auth.HasRole(ctx, u.moderatorRole) {
+		return model.ForbiddenError("moderator role is required")
+	}
+	return nil
+}
+
+// checkOwner проверяет владельца материала, в том числе удалённого
+func (u *UseCase) checkOwner(ctx context.Context, materialUUID, userUUID, action string) error {
+	ownerUUID, err := u.repository.GetMaterialOwnerUUID(ctx, materialUUID)
+	if err != nil {
+		return fmt.Errorf("failed to get owner uuid: %w", err)
+	}
+	if ownerUUID != userUUID {
+		return model.ForbiddenError("%s: user is not owner", action)
+	}
+	return nil
+}
+
+// checkDraft проверяет, что материал принадлежит пользователю и всё ещё черновик: автосохранение
+// удалённого, опубликованного или архивного материала не имеет смысла. Внутри транзакции строка
+// материала остаётся заблокированной до её конца
+func (u *UseCase) checkDraft(ctx context.Context, materialUUID, userUUID, action string) error {
+	states, err := u.repository.GetMaterialsStateForUpdate(ctx, []string{materialUUID})
+	if err != nil {
+		return fmt.Errorf("failed to get material: %w", err)
+	}
+	if len(states) == 0 {
+		return model.NotFoundError("material does not exist")
+	}
+
+	material := states[0]
+	if material.OwnerUUID != userUUID {
+		return model.ForbiddenError("%s: user is not owner", action)
+	}
+	if material.DeletedAt != nil {
+		return model.NotFoundError("material does not exist")
+	}
+	if material.Status != "draft" || material.ArchivedAt != nil {
+		return model.PreconditionError("%s: material is not a draft", action)
+	}
+	return nil
+}
+
+// checkOwnedMaterial проверяет, что материал существует, не удалён и принадлежит пользователю
+func (u *UseCase) checkOwnedMaterial(ctx context.Context, materialUUID, userUUID, action string) error {
+	if err := u.checkOwner(ctx, materialUUID, userUUID, action); err != nil {
+		return err
+	}
+
+	exists, err := u.repository.MaterialExists(ctx, materialUUID)
+	if err != nil {
+		return fmt.Errorf("failed to check material existence: %w", err)
+	}
+	if !exists {
+		return model.NotFoundError("material does not exist")
+	}
+
+	return nil
+}
+
+// likeChanged сбрасывает кэш материала и отправляет событие, если лайк действительно изменился
+func (u *UseCase) likeChanged(ctx context.Context, materialUUID string, like *model.LikeResult) {
+	if !like.Changed {
+		return
+	}
+
+	u.invalidateMaterial(ctx, materialUUID)
+
+	msg := &materials.ToggleLikeMessage{
+		MaterialUuid: materialUUID,
+		IsLiked:      like.IsLiked,
+		LikesCount:   like.LikesCount,
+	}
+	u.produce(ctx, u.likeKafkaProducer, msg, materialUUID)
+}
+
+// statusChanged сбрасывает кэш материала и отправляет событие в топик bulk-операций с одним материалом
+func (u *UseCase) statusChanged(ctx context.Context, action, materialUUID, ownerUUID string) {
+	u.invalidateMaterial(ctx, materialUUID)
+
+	msg := &materials.BulkOperationMessage{
+		Action:      action,
+		OwnerUuid:   ownerUUID,
+		Uuids:       []string{materialUUID},
+		ProcessedAt: timestamppb.Now(),
+	}
+	u.produce(ctx, u.bulkKafkaProducer, msg, ownerUUID)
+}
+
+// invalidateMaterial сбрасывает кэш материала; изменение уже сохранено, поэтому ошибку только логируем
+func (u *UseCase) invalidateMaterial(ctx context.Context, materialUUID string) {
+	if err := u.redis.DeleteMaterial(ctx, materialUUID); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), "failed to invalidate material cache")
+	}
+}
+
+func (u *UseCase) produceModerationDecision(ctx context.Context, msg *materials.ModerationDecisionMessage) {
+	msg.DecidedAt = timestamppb.Now()
+	u.produce(ctx, u.moderationKafkaProducer, msg, msg.MaterialUuid)
+}
+
+func (u *UseCase) produce(ctx context.Context, producer KafkaProducer, message interface{}, key interface{}) {
+	if err := producer.ProduceMessage(ctx, message, key); err != nil {
+		logger_lib.Error(logger_lib.WithError(ctx, err), "failed to produce message")
+	}
+}
@@ -0,0 +1,2764 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/s21platform/materials-service/internal/config"
+	"github.com/s21platform/materials-service/internal/model"
+	"github.com/s21platform/materials-service/pkg/materials"
+)
+
+const (
+	trashRetention = 30 * 24 * time.Hour
+	moderatorRole  = "moderator"
+	hideThreshold  = 3
+
+	exportRole      = "materials-exporter"
+	exportBatchSize = 2
+	privacyRole     = "privacy-admin"
+)
+
+type mocks struct {
+	db          *MockDBRepo
+	redis       *MockRedisRepo
+	createKafka *MockKafkaProducer
+	editKafka   *MockKafkaProducer
+	likeKafka   *MockKafkaProducer
+	bulkKafka   *MockKafkaProducer
+	modKafka    *MockKafkaProducer
+	exporter    *MockMaterialExporter
+	covers      *MockCoverUploader
+	attachments *MockAttachmentManager
+}
+
+func newUseCase(t *testing.T) (*UseCase, *mocks) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	m := &mocks{
+		db:          NewMockDBRepo(ctrl),
+		redis:       NewMockRedisRepo(ctrl),
+		createKafka: NewMockKafkaProducer(ctrl),
+		editKafka:   NewMockKafkaProducer(ctrl),
+		likeKafka:   NewMockKafkaProducer(ctrl),
+		bulkKafka:   NewMockKafkaProducer(ctrl),
+		modKafka:    NewMockKafkaProducer(ctrl),
+		exporter:    NewMockMaterialExporter(ctrl),
+		covers:      NewMockCoverUploader(ctrl),
+		attachments: NewMockAttachmentManager(ctrl),
+	}
+	m.db.EXPECT().WithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(context.WithValue(ctx, txKey{}, true))
+		}).AnyTimes()
+
+	cfg := &config.Config{}
+	cfg.Trash.RetentionPeriod = trashRetention
+	cfg.Reports.ModeratorRole = moderatorRole
+	cfg.Reports.HideThreshold = hideThreshold
+	cfg.Reactions.Types = []string{"helpful", "confusing"}
+	cfg.Import.MaxFiles = 10
+	cfg.Import.MaxBytes = 1 << 20
+	cfg.Export.Role = exportRole
+	cfg.Export.BatchSize = exportBatchSize
+	cfg.Privacy.AdminRole = privacyRole
+
+	return New(m.db, m.redis, m.createKafka, m.editKafka, m.likeKafka, m.bulkKafka, m.modKafka, m.exporter, m.covers, m.attachments, cfg), m
+}
+
+// txKey помечает контекст, переданный в колбэк WithTx
+type txKey struct{}
+
+// inTx совпадает с контекстом, полученным внутри транзакции
+var inTx gomock.Matcher = txMatcher{}
+
+type txMatcher struct{}
+
+func (txMatcher) Matches(x interface{}) bool {
+	ctx, ok := x.(context.Context)
+	return ok && ctx.Value(txKey{}) != nil
+}
+
+func (txMatcher) String() string {
+	return "is a context inside WithTx"
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestUseCase_PublishMaterial(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+
+	published := &model.Material{
+		UUID:      materialUUID,
+		OwnerUUID: userUUID,
+		Title:     "Test Title",
+		Content:   stringPtr("Test Content"),
+		Status:    "published",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().MaterialExists(gomock.Any(), materialUUID).Return(true, nil)
+		m.db.EXPECT().PublishMaterial(gomock.Any(), materialUUID).Return(published, nil)
+		m.redis.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(nil)
+		m.createKafka.EXPECT().
+			ProduceMessage(gomock.Any(), &materials.CreatedMaterial{Material: published.FromDTO()}, userUUID).
+			Return(nil)
+
+		material, err := uc.PublishMaterial(ctx, materialUUID, userUUID)
+
+		require.NoError(t, err)
+		assert.Equal(t, published, material)
+	})
+
+	t.Run("cache_and_kafka_errors_are_ignored", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().MaterialExists(gomock.Any(), materialUUID).Return(true, nil)
+		m.db.EXPECT().PublishMaterial(gomock.Any(), materialUUID).Return(published, nil)
+		m.redis.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(fmt.Errorf("redis error"))
+		m.createKafka.EXPECT().ProduceMessage(gomock.Any(), gomock.Any(), userUUID).Return(fmt.Errorf("kafka error"))
+
+		material, err := uc.PublishMaterial(ctx, materialUUID, userUUID)
+
+		require.NoError(t, err)
+		assert.Equal(t, published, material)
+	})
+
+	t.Run("missing_material_uuid", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		_, err := uc.PublishMaterial(ctx, "", userUUID)
+
+		assert.ErrorIs(t, err, model.ErrValidation)
+		assert.EqualError(t, err, "material uuid is required")
+	})
+
+	t.Run("not_owner", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(uuid.New().String(), nil)
+
+		_, err := uc.PublishMaterial(ctx, materialUUID, userUUID)
+
+		assert.ErrorIs(t, err, model.ErrForbidden)
+		assert.EqualError(t, err, "failed to publish: user is not owner")
+	})
+
+	t.Run("material_not_found", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().MaterialExists(gomock.Any(), materialUUID).Return(false, nil)
+
+		_, err := uc.PublishMaterial(ctx, materialUUID, userUUID)
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("owner_lookup_not_found", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return("", model.NotFoundError("material doesn't exist"))
+
+		_, err := uc.PublishMaterial(ctx, materialUUID, userUUID)
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("material_exists_error", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		dbErr := errors.New("database error")
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().MaterialExists(gomock.Any(), materialUUID).Return(false, dbErr)
+
+		_, err := uc.PublishMaterial(ctx, materialUUID, userUUID)
+
+		assert.ErrorIs(t, err, dbErr)
+		assert.Contains(t, err.Error(), "failed to check material existence")
+	})
+
+	t.Run("publish_error", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		dbErr := errors.New("database error")
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().MaterialExists(gomock.Any(), materialUUID).Return(true, nil)
+		m.db.EXPECT().PublishMaterial(gomock.Any(), materialUUID).Return(nil, dbErr)
+
+		_, err := uc.PublishMaterial(ctx, materialUUID, userUUID)
+
+		assert.ErrorIs(t, err, dbErr)
+		assert.Contains(t, err.Error(), "failed to publish material")
+	})
+}
+
+func TestUseCase_EditMaterial(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+
+	editReq := &model.EditMaterial{
+		UUID:            materialUUID,
+		Title:           "Updated Title",
+		Content:         "Updated Content",
+		Description:     "Updated Description",
+		CoverImageURL:   "http://example.com/cover.jpg",
+		ReadTimeMinutes: 10,
+	}
+	edited := &model.Material{
+		UUID:      materialUUID,
+		OwnerUUID: userUUID,
+		Title:     "Updated Title",
+		Content:   stringPtr("Updated Content"),
+		Status:    "draft",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().MaterialExists(gomock.Any(), materialUUID).Return(true, nil)
+		m.db.EXPECT().EditMaterial(gomock.Any(), editReq).Return(edited, nil)
+		m.redis.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(nil)
+		m.editKafka.EXPECT().
+			ProduceMessage(gomock.Any(), gomock.Any(), materialUUID).
+			DoAndReturn(func(_ context.Context, message interface{}, _ interface{}) error {
+				msg, ok := message.(*materials.EditMaterialMessage)
+				require.True(t, ok)
+				assert.Equal(t, materialUUID, msg.Uuid)
+				assert.Equal(t, userUUID, msg.OwnerUuid)
+				assert.Equal(t, "Updated Title", msg.Title)
+				assert.NotNil(t, msg.EditedAt)
+				return nil
+			})
+
+		material, err := uc.EditMaterial(ctx, userUUID, editReq)
+
+		require.NoError(t, err)
+		assert.Equal(t, edited, material)
+	})
+
+	t.Run("kafka_error_is_ignored", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().MaterialExists(gomock.Any(), materialUUID).Return(true, nil)
+		m.db.EXPECT().EditMaterial(gomock.Any(), editReq).Return(edited, nil)
+		m.redis.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(nil)
+		m.editKafka.EXPECT().ProduceMessage(gomock.Any(), gomock.Any(), materialUUID).Return(fmt.Errorf("kafka error"))
+
+		_, err := uc.EditMaterial(ctx, userUUID, editReq)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("missing_material_uuid", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		_, err := uc.EditMaterial(ctx, userUUID, &model.EditMaterial{Title: "Title"})
+
+		assert.ErrorIs(t, err, model.ErrValidation)
+		assert.EqualError(t, err, "material uuid is required")
+	})
+
+	t.Run("missing_title", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		_, err := uc.EditMaterial(ctx, userUUID, &model.EditMaterial{UUID: materialUUID, Title: "   "})
+
+		var domainErr *model.Error
+		require.ErrorAs(t, err, &domainErr)
+		assert.Equal(t, model.ErrorKindValidation, domainErr.Kind)
+		assert.Equal(t, "title", domainErr.Field)
+	})
+
+	t.Run("not_owner", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(uuid.New().String(), nil)
+
+		_, err := uc.EditMaterial(ctx, userUUID, editReq)
+
+		assert.ErrorIs(t, err, model.ErrForbidden)
+		assert.EqualError(t, err, "failed to edit: user is not owner")
+	})
+
+	t.Run("owner_lookup_error", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return("", fmt.Errorf("db error"))
+
+		_, err := uc.EditMaterial(ctx, userUUID, editReq)
+
+		assert.Contains(t, err.Error(), "failed to get owner uuid")
+	})
+
+	t.Run("material_not_found", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().MaterialExists(gomock.Any(), materialUUID).Return(false, nil)
+
+		_, err := uc.EditMaterial(ctx, userUUID, editReq)
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("edit_error", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().MaterialExists(gomock.Any(), materialUUID).Return(true, nil)
+		m.db.EXPECT().EditMaterial(gomock.Any(), editReq).Return(nil, fmt.Errorf("db error"))
+
+		_, err := uc.EditMaterial(ctx, userUUID, editReq)
+
+		assert.Contains(t, err.Error(), "failed to edit material")
+	})
+}
+
+func TestUseCase_ToggleLike(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+
+	t.Run("add_like", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().AddReaction(gomock.Any(), materialUUID, userUUID, model.ReactionLike).
+			Return(&model.ReactionResult{Changed: true, LikesCount: 10}, nil)
+		m.redis.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(nil)
+		m.likeKafka.EXPECT().
+			ProduceMessage(gomock.Any(), &materials.ToggleLikeMessage{
+				MaterialUuid: materialUUID,
+				IsLiked:      true,
+				LikesCount:   10,
+			}, materialUUID).
+			Return(nil)
+
+		like, err := uc.ToggleLike(ctx, materialUUID, userUUID)
+
+		require.NoError(t, err)
+		assert.Equal(t, &model.LikeResult{IsLiked: true, LikesCount: 10, Changed: true}, like)
+	})
+
+	t.Run("remove_like", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().AddReaction(gomock.Any(), materialUUID, userUUID, model.ReactionLike).
+			Return(&model.ReactionResult{LikesCount: 10}, nil)
+		m.db.EXPECT().RemoveReaction(gomock.Any(), materialUUID, userUUID, model.ReactionLike).
+			Return(&model.ReactionResult{Changed: true, LikesCount: 9}, nil)
+		m.redis.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(nil)
+		m.likeKafka.EXPECT().
+			ProduceMessage(gomock.Any(), &materials.ToggleLikeMessage{
+				MaterialUuid: materialUUID,
+				IsLiked:      false,
+				LikesCount:   9,
+			}, materialUUID).
+			Return(nil)
+
+		like, err := uc.ToggleLike(ctx, materialUUID, userUUID)
+
+		require.NoError(t, err)
+		assert.False(t, like.IsLiked)
+		assert.Equal(t, int32(9), like.LikesCount)
+	})
+
+	t.Run("kafka_error_is_ignored", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().AddReaction(gomock.Any(), materialUUID, userUUID, model.ReactionLike).
+			Return(&model.ReactionResult{Changed: true, LikesCount: 10}, nil)
+		m.redis.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(nil)
+		m.likeKafka.EXPECT().ProduceMessage(gomock.Any(), gomock.Any(), materialUUID).Return(fmt.Errorf("kafka error"))
+
+		like, err := uc.ToggleLike(ctx, materialUUID, userUUID)
+
+		require.NoError(t, err)
+		assert.True(t, like.IsLiked)
+	})
+
+	t.Run("missing_material_uuid", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		_, err := uc.ToggleLike(ctx, "", userUUID)
+
+		assert.ErrorIs(t, err, model.ErrValidation)
+	})
+
+	t.Run("add_like_error", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().AddReaction(gomock.Any(), materialUUID, userUUID, model.ReactionLike).Return(nil, fmt.Errorf("db error"))
+
+		_, err := uc.ToggleLike(ctx, materialUUID, userUUID)
+
+		assert.Contains(t, err.Error(), "failed to add like")
+	})
+}
+
+func TestUseCase_SetLike(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+
+	t.Run("set_like", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().MaterialExists(gomock.Any(), materialUUID).Return(true, nil)
+		m.db.EXPECT().AddReaction(gomock.Any(), materialUUID, userUUID, model.ReactionLike).
+			Return(&model.ReactionResult{Changed: true, LikesCount: 3}, nil)
+		m.redis.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(nil)
+		m.likeKafka.EXPECT().
+			ProduceMessage(gomock.Any(), &materials.ToggleLikeMessage{
+				MaterialUuid: materialUUID,
+				IsLiked:      true,
+				LikesCount:   3,
+			}, materialUUID).
+			Return(nil)
+
+		like, err := uc.SetLike(ctx, materialUUID, userUUID, true)
+
+		require.NoError(t, err)
+		assert.Equal(t, &model.LikeResult{IsLiked: true, LikesCount: 3, Changed: true}, like)
+	})
+
+	t.Run("remove_like", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().MaterialExists(gomock.Any(), materialUUID).Return(true, nil)
+		m.db.EXPECT().RemoveReaction(gomock.Any(), materialUUID, userUUID, model.ReactionLike).
+			Return(&model.ReactionResult{Changed: true, LikesCount: 2}, nil)
+		m.redis.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(nil)
+		m.likeKafka.EXPECT().
+			ProduceMessage(gomock.Any(), &materials.ToggleLikeMessage{
+				MaterialUuid: materialUUID,
+				IsLiked:      false,
+				LikesCount:   2,
+			}, materialUUID).
+			Return(nil)
+
+		like, err := uc.SetLike(ctx, materialUUID, userUUID, false)
+
+		require.NoError(t, err)
+		assert.False(t, like.IsLiked)
+		assert.Equal(t, int32(2), like.LikesCount)
+	})
+
+	t.Run("already_in_requested_state", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		// состояние не изменилось — ни сброса кэша, ни события
+		m.db.EXPECT().MaterialExists(gomock.Any(), materialUUID).Return(true, nil)
+		m.db.EXPECT().AddReaction(gomock.Any(), materialUUID, userUUID, model.ReactionLike).
+			Return(&model.ReactionResult{LikesCount: 5}, nil)
+
+		like, err := uc.SetLike(ctx, materialUUID, userUUID, true)
+
+		require.NoError(t, err)
+		assert.Equal(t, &model.LikeResult{IsLiked: true, LikesCount: 5}, like)
+	})
+
+	t.Run("material_not_found", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().MaterialExists(gomock.Any(), materialUUID).Return(false, nil)
+
+		_, err := uc.SetLike(ctx, materialUUID, userUUID, true)
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+		assert.EqualError(t, err, "failed to set like: material doesn't exist")
+	})
+
+	t.Run("missing_material_uuid", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		_, err := uc.SetLike(ctx, "", userUUID, true)
+
+		assert.ErrorIs(t, err, model.ErrValidation)
+	})
+}
+
+// statusEvent проверяет событие об изменении статуса одного материала
+func statusEvent(t *testing.T, action, materialUUID, ownerUUID string) func(context.Context, interface{}, interface{}) error {
+	return func(_ context.Context, message interface{}, key interface{}) error {
+		msg, ok := message.(*materials.BulkOperationMessage)
+		require.True(t, ok)
+		assert.Equal(t, action, msg.Action)
+		assert.Equal(t, ownerUUID, msg.OwnerUuid)
+		assert.Equal(t, []string{materialUUID}, msg.Uuids)
+		assert.NotNil(t, msg.ProcessedAt)
+		assert.Equal(t, ownerUUID, key)
+		return nil
+	}
+}
+
+func TestUseCase_DeleteMaterial(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(int64(1), nil)
+		m.redis.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(nil)
+		m.bulkKafka.EXPECT().ProduceMessage(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(statusEvent(t, model.BulkActionDelete, materialUUID, userUUID))
+
+		require.NoError(t, uc.DeleteMaterial(ctx, materialUUID, userUUID))
+	})
+
+	t.Run("missing_material_uuid", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		assert.ErrorIs(t, uc.DeleteMaterial(ctx, "", userUUID), model.ErrValidation)
+	})
+
+	t.Run("not_owner", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(uuid.New().String(), nil)
+
+		err := uc.DeleteMaterial(ctx, materialUUID, userUUID)
+
+		assert.ErrorIs(t, err, model.ErrForbidden)
+		assert.EqualError(t, err, "failed to delete: user is not owner")
+	})
+
+	t.Run("already_deleted", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(int64(0), nil)
+
+		assert.ErrorIs(t, uc.DeleteMaterial(ctx, materialUUID, userUUID), model.ErrNotFound)
+	})
+
+	t.Run("delete_error", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		dbErr := errors.New("db error")
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(int64(0), dbErr)
+
+		assert.ErrorIs(t, uc.DeleteMaterial(ctx, materialUUID, userUUID), dbErr)
+	})
+}
+
+func TestUseCase_ArchiveMaterial(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().ArchivedMaterial(gomock.Any(), materialUUID).Return(int64(1), nil)
+		m.redis.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(nil)
+		m.bulkKafka.EXPECT().ProduceMessage(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(statusEvent(t, model.BulkActionArchive, materialUUID, userUUID))
+
+		require.NoError(t, uc.ArchiveMaterial(ctx, materialUUID, userUUID))
+	})
+
+	t.Run("kafka_error_is_ignored", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().ArchivedMaterial(gomock.Any(), materialUUID).Return(int64(1), nil)
+		m.redis.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(nil)
+		m.bulkKafka.EXPECT().ProduceMessage(gomock.Any(), gomock.Any(), userUUID).Return(fmt.Errorf("kafka error"))
+
+		require.NoError(t, uc.ArchiveMaterial(ctx, materialUUID, userUUID))
+	})
+
+	t.Run("not_owner", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(uuid.New().String(), nil)
+
+		assert.ErrorIs(t, uc.ArchiveMaterial(ctx, materialUUID, userUUID), model.ErrForbidden)
+	})
+
+	t.Run("already_archived", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().ArchivedMaterial(gomock.Any(), materialUUID).Return(int64(0), nil)
+
+		err := uc.ArchiveMaterial(ctx, materialUUID, userUUID)
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+		assert.EqualError(t, err, "failed to archive: material already archived or not found")
+	})
+}
+
+func TestUseCase_UnarchiveMaterial(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		unarchived := &model.Material{UUID: materialUUID, OwnerUUID: userUUID, Status: "published"}
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().UnarchiveMaterial(gomock.Any(), materialUUID).Return(int64(1), nil)
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(unarchived, nil)
+		m.redis.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(nil)
+		m.bulkKafka.EXPECT().ProduceMessage(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(statusEvent(t, model.BulkActionUnarchive, materialUUID, userUUID))
+
+		material, err := uc.UnarchiveMaterial(ctx, materialUUID, userUUID)
+
+		require.NoError(t, err)
+		assert.Equal(t, unarchived, material)
+	})
+
+	t.Run("not_archived", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().UnarchiveMaterial(gomock.Any(), materialUUID).Return(int64(0), nil)
+
+		_, err := uc.UnarchiveMaterial(ctx, materialUUID, userUUID)
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+		assert.Contains(t, err.Error(), "material is not archived or not found")
+	})
+
+	t.Run("missing_material_uuid", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		_, err := uc.UnarchiveMaterial(ctx, "", userUUID)
+
+		assert.ErrorIs(t, err, model.ErrValidation)
+	})
+}
+
+func TestUseCase_RestoreMaterial(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		restored := &model.Material{UUID: materialUUID, OwnerUUID: userUUID, Status: "draft"}
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().RestoreMaterial(gomock.Any(), materialUUID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, deletedAfter time.Time) (int64, error) {
+				assert.WithinDuration(t, time.Now().Add(-trashRetention), deletedAfter, time.Minute)
+				return 1, nil
+			})
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(restored, nil)
+		m.redis.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(nil)
+		m.bulkKafka.EXPECT().ProduceMessage(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(statusEvent(t, model.BulkActionRestore, materialUUID, userUUID))
+
+		material, err := uc.RestoreMaterial(ctx, materialUUID, userUUID)
+
+		require.NoError(t, err)
+		assert.Equal(t, restored, material)
+	})
+
+	t.Run("retention_expired", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().RestoreMaterial(gomock.Any(), materialUUID, gomock.Any()).Return(int64(0), nil)
+
+		_, err := uc.RestoreMaterial(ctx, materialUUID, userUUID)
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+		assert.Contains(t, err.Error(), "retention period expired")
+	})
+
+	t.Run("not_owner", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(uuid.New().String(), nil)
+
+		_, err := uc.RestoreMaterial(ctx, materialUUID, userUUID)
+
+		assert.ErrorIs(t, err, model.ErrForbidden)
+	})
+}
+
+func TestUseCase_GetMaterialsByUUIDs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+	now := time.Now()
+
+	published := func() model.Material {
+		return model.Material{UUID: uuid.New().String(), OwnerUUID: uuid.New().String(), Status: "published"}
+	}
+
+	t.Run("cache_hits_and_misses_in_input_order", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		first, second, third := published(), published(), published()
+		uuids := []string{third.UUID, first.UUID, second.UUID}
+
+		m.redis.EXPECT().GetMaterials(gomock.Any(), uuids).Return(model.MaterialList{first}, nil)
+		m.db.EXPECT().GetMaterialsByUUIDs(gomock.Any(), []string{third.UUID, second.UUID}).
+			Return(model.MaterialList{second, third}, nil)
+		m.redis.EXPECT().SetMaterials(gomock.Any(), model.MaterialList{second, third}, materialCacheTTL).Return(nil)
+
+		result, err := uc.GetMaterialsByUUIDs(ctx, uuids, userUUID)
+
+		require.NoError(t, err)
+		assert.Equal(t, model.MaterialList{third, first, second}, result)
+	})
+
+	t.Run("all_cached", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		first := published()
+
+		m.redis.EXPECT().GetMaterials(gomock.Any(), []string{first.UUID}).Return(model.MaterialList{first}, nil)
+
+		result, err := uc.GetMaterialsByUUIDs(ctx, []string{first.UUID}, userUUID)
+
+		require.NoError(t, err)
+		assert.Equal(t, model.MaterialList{first}, result)
+	})
+
+	t.Run("duplicates_fetched_once", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		first := published()
+
+		m.redis.EXPECT().GetMaterials(gomock.Any(), []string{first.UUID}).Return(nil, nil)
+		m.db.EXPECT().GetMaterialsByUUIDs(gomock.Any(), []string{first.UUID}).Return(model.MaterialList{first}, nil)
+		m.redis.EXPECT().SetMaterials(gomock.Any(), gomock.Any(), materialCacheTTL).Return(nil)
+
+		result, err := uc.GetMaterialsByUUIDs(ctx, []string{first.UUID, first.UUID}, userUUID)
+
+		require.NoError(t, err)
+		assert.Equal(t, model.MaterialList{first, first}, result)
+	})
+
+	t.Run("visibility", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		visible := published()
+		hidden := published()
+		hidden.HiddenAt = &now
+		draft := published()
+		draft.Status = "draft"
+		ownDraft := published()
+		ownDraft.Status = "draft"
+		ownDraft.OwnerUUID = userUUID
+		archived := published()
+		archived.ArchivedAt = &now
+		deleted := published()
+		deleted.OwnerUUID = userUUID
+		deleted.DeletedAt = &now
+		cached := model.MaterialList{visible, hidden, draft, ownDraft, archived, deleted}
+
+		uuids := make([]string, 0, len(cached))
+		for _, material := range cached {
+			uuids = append(uuids, material.UUID)
+		}
+		m.redis.EXPECT().GetMaterials(gomock.Any(), uuids).Return(cached, nil).Times(2)
+
+		result, err := uc.GetMaterialsByUUIDs(ctx, uuids, userUUID)
+
+		require.NoError(t, err)
+		assert.Equal(t, model.MaterialList{visible, ownDraft}, result)
+
+		moderatorCtx := context.WithValue(ctx, config.KeyRoles, []string{moderatorRole})
+		result, err = uc.GetMaterialsByUUIDs(moderatorCtx, uuids, userUUID)
+
+		require.NoError(t, err)
+		assert.Equal(t, model.MaterialList{visible, hidden, ownDraft}, result)
+	})
+
+	t.Run("cache_unavailable", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		first := published()
+
+		m.redis.EXPECT().GetMaterials(gomock.Any(), []string{first.UUID}).Return(nil, errors.New("redis error"))
+		m.db.EXPECT().GetMaterialsByUUIDs(gomock.Any(), []string{first.UUID}).Return(model.MaterialList{first}, nil)
+		m.redis.EXPECT().SetMaterials(gomock.Any(), gomock.Any(), materialCacheTTL).Return(errors.New("redis error"))
+
+		result, err := uc.GetMaterialsByUUIDs(ctx, []string{first.UUID}, userUUID)
+
+		require.NoError(t, err)
+		assert.Equal(t, model.MaterialList{first}, result)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		tooMany := make([]string, model.BatchMaxItems+1)
+		for i := range tooMany {
+			tooMany[i] = uuid.New().String()
+		}
+
+		for name, uuids := range map[string][]string{
+			"empty":        nil,
+			"too_many":     tooMany,
+			"invalid_uuid": {uuid.New().String(), "not-a-uuid"},
+		} {
+			_, err := uc.GetMaterialsByUUIDs(ctx, uuids, userUUID)
+			assert.ErrorIs(t, err, model.ErrValidation, name)
+		}
+	})
+
+	t.Run("db_error", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		dbErr := errors.New("db error")
+		materialUUID := uuid.New().String()
+		m.redis.EXPECT().GetMaterials(gomock.Any(), []string{materialUUID}).Return(nil, nil)
+		m.db.EXPECT().GetMaterialsByUUIDs(gomock.Any(), []string{materialUUID}).Return(nil, dbErr)
+
+		_, err := uc.GetMaterialsByUUIDs(ctx, []string{materialUUID}, userUUID)
+
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
+func zipFiles(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for name, content := range files {
+		file, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = file.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	return buf.Bytes()
+}
+
+func TestUseCase_ImportMaterials(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ownerUUID := uuid.New().String()
+
+	draftFile := model.ImportFile{
+		Name: "intro.md",
+		Data: []byte("---\ntitle: Intro\ndescription: First steps\ntags: [Go, go, Basics]\n---\n\nHello world\n"),
+	}
+	publishedFile := model.ImportFile{
+		Name: "guide.md",
+		Data: []byte("---\nstatus: published\ncover: https://example.com/cover.png\n---\n# Guide\n\nText\n"),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		draftUUID := uuid.New().String()
+		publishedUUID := uuid.New().String()
+
+		m.db.EXPECT().SaveDraftMaterial(gomock.Any(), ownerUUID, &model.SaveDraftMaterial{
+			Title:           "Intro",
+			Description:     "First steps",
+			Content:         "Hello world\n",
+			ReadTimeMinutes: 1,
+		}).Return(draftUUID, nil)
+		m.db.EXPECT().AddMaterialsTags(gomock.Any(), []string{draftUUID}, []string{"go", "basics"}).Return(nil)
+		m.db.EXPECT().SaveDraftMaterial(gomock.Any(), ownerUUID, &model.SaveDraftMaterial{
+			Title:           "Guide",
+			CoverImageURL:   "https://example.com/cover.png",
+			Content:         "# Guide\n\nText\n",
+			ReadTimeMinutes: 1,
+		}).Return(publishedUUID, nil)
+		m.db.EXPECT().PublishMaterial(gomock.Any(), publishedUUID).
+			Return(&model.Material{UUID: publishedUUID, OwnerUUID: ownerUUID, Title: "Guide", Status: "published"}, nil)
+		m.createKafka.EXPECT().ProduceMessage(gomock.Any(), gomock.Any(), ownerUUID).
+			DoAndReturn(func(_ context.Context, message interface{}, _ interface{}) error {
+				msg, ok := message.(*materials.CreatedMaterial)
+				require.True(t, ok)
+				assert.Equal(t, publishedUUID, msg.Material.Uuid)
+				return nil
+			})
+
+		report, err := uc.ImportMaterials(ctx, ownerUUID, []model.ImportFile{draftFile, publishedFile}, false)
+
+		require.NoError(t, err)
+		assert.True(t, report.Committed)
+		assert.Equal(t, []model.ImportResult{
+			{Filename: "intro.md", Title: "Intro", Status: model.ImportStatusDraft, MaterialUUID: draftUUID, Success: true},
+			{Filename: "guide.md", Title: "Guide", Status: model.ImportStatusPublished, MaterialUUID: publishedUUID, Success: true},
+		}, report.Results)
+	})
+
+	t.Run("zip_archive", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		archive := model.ImportFile{
+			Name: "docs.zip",
+			Data: zipFiles(t, map[string]string{
+				"docs/a.md":            "# A\n",
+				"docs/image.png":       "not markdown",
+				"__MACOSX/docs/._a.md": "resource fork",
+			}),
+		}
+
+		report, err := uc.ImportMaterials(ctx, ownerUUID, []model.ImportFile{archive}, true)
+
+		require.NoError(t, err)
+		assert.False(t, report.Committed)
+		require.Len(t, report.Results, 1)
+		assert.Equal(t, "docs.zip/docs/a.md", report.Results[0].Filename)
+		assert.Equal(t, "A", report.Results[0].Title)
+		assert.True(t, report.Results[0].Success)
+	})
+
+	t.Run("dry_run_writes_nothing", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		report, err := uc.ImportMaterials(ctx, ownerUUID, []model.ImportFile{draftFile, publishedFile}, true)
+
+		require.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.False(t, report.Committed)
+		assert.Len(t, report.Results, 2)
+		assert.Empty(t, report.Results[0].MaterialUUID)
+	})
+
+	t.Run("invalid_file_aborts_import", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		files := []model.ImportFile{
+			draftFile,
+			{Name: "broken.md", Data: []byte("---\ntitle: Broken\nstatus: deleted\n---\n")},
+			{Name: "untitled.md", Data: []byte("no heading here")},
+			{Name: "notes.txt", Data: []byte("# Notes")},
+			{Name: "open.md", Data: []byte("---\ntitle: Open\n")},
+		}
+
+		report, err := uc.ImportMaterials(ctx, ownerUUID, files, false)
+
+		require.NoError(t, err)
+		assert.False(t, report.Committed)
+		require.Len(t, report.Results, 5)
+		assert.True(t, report.Results[0].Success)
+		assert.Contains(t, report.Results[1].Error, "unknown status")
+		assert.Contains(t, report.Results[2].Error, "title is required")
+		assert.Contains(t, report.Results[3].Error, "not a markdown file")
+		assert.Contains(t, report.Results[4].Error, "front matter is not closed")
+	})
+
+	t.Run("invalid_zip", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		_, err := uc.ImportMaterials(ctx, ownerUUID, []model.ImportFile{{Name: "docs.zip", Data: []byte("not a zip")}}, false)
+
+		assert.ErrorIs(t, err, model.ErrValidation)
+	})
+
+	t.Run("too_many_files", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		files := make([]model.ImportFile, 11)
+		for i := range files {
+			files[i] = model.ImportFile{Name: fmt.Sprintf("%d.md", i), Data: []byte("# Title")}
+		}
+
+		_, err := uc.ImportMaterials(ctx, ownerUUID, files, false)
+
+		assert.ErrorIs(t, err, model.ErrValidation)
+	})
+
+	t.Run("db_error", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		dbErr := errors.New("db error")
+		m.db.EXPECT().SaveDraftMaterial(gomock.Any(), ownerUUID, gomock.Any()).Return("", dbErr)
+
+		_, err := uc.ImportMaterials(ctx, ownerUUID, []model.ImportFile{draftFile}, false)
+
+		assert.ErrorIs(t, err, dbErr)
+		assert.Contains(t, err.Error(), "intro.md")
+	})
+}
+
+func TestUseCase_ExportMaterial(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+
+	t.Run("archived_material", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		archivedAt := time.Now()
+		material := &model.Material{UUID: materialUUID, OwnerUUID: userUUID, Status: "archived", ArchivedAt: &archivedAt}
+		export := &model.MaterialExport{Filename: materialUUID + ".zip", ContentType: "application/zip", Data: []byte("PK")}
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(material, nil)
+		m.exporter.EXPECT().Export(gomock.Any(), material, model.ExportFormatZip).Return(export, nil)
+
+		result, err := uc.ExportMaterial(ctx, materialUUID, userUUID, model.ExportFormatZip)
+
+		require.NoError(t, err)
+		assert.Equal(t, export, result)
+	})
+
+	t.Run("default_format", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		material := &model.Material{UUID: materialUUID, OwnerUUID: userUUID, Status: "draft"}
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(material, nil)
+		m.exporter.EXPECT().Export(gomock.Any(), material, model.ExportFormatMarkdown).
+			Return(&model.MaterialExport{Filename: materialUUID + ".md"}, nil)
+
+		result, err := uc.ExportMaterial(ctx, materialUUID, userUUID, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, materialUUID+".md", result.Filename)
+	})
+
+	t.Run("missing_material_uuid", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		_, err := uc.ExportMaterial(ctx, "", userUUID, model.ExportFormatHTML)
+
+		assert.ErrorIs(t, err, model.ErrValidation)
+	})
+
+	t.Run("not_owner", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(uuid.New().String(), nil)
+
+		_, err := uc.ExportMaterial(ctx, materialUUID, userUUID, model.ExportFormatHTML)
+
+		assert.ErrorIs(t, err, model.ErrForbidden)
+	})
+
+	t.Run("deleted_material", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		deletedAt := time.Now()
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).
+			Return(&model.Material{UUID: materialUUID, OwnerUUID: userUUID, DeletedAt: &deletedAt}, nil)
+
+		_, err := uc.ExportMaterial(ctx, materialUUID, userUUID, model.ExportFormatHTML)
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("invalid_format", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(&model.Material{UUID: materialUUID, OwnerUUID: userUUID}, nil)
+		m.exporter.EXPECT().Export(gomock.Any(), gomock.Any(), "pdf").
+			Return(nil, model.ValidationError("format", "unknown export format %q", "pdf"))
+
+		_, err := uc.ExportMaterial(ctx, materialUUID, userUUID, "pdf")
+
+		assert.ErrorIs(t, err, model.ErrValidation)
+	})
+}
+
+func TestUseCase_SaveDraftMaterial(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		draft := &model.SaveDraftMaterial{Title: "Draft", Content: "Content"}
+		materialUUID := uuid.New().String()
+		m.db.EXPECT().SaveDraftMaterial(gomock.Any(), userUUID, draft).Return(materialUUID, nil)
+
+		result, err := uc.SaveDraftMaterial(ctx, userUUID, draft)
+
+		require.NoError(t, err)
+		assert.Equal(t, materialUUID, result)
+	})
+
+	t.Run("missing_title", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		_, err := uc.SaveDraftMaterial(ctx, userUUID, &model.SaveDraftMaterial{Title: "  "})
+
+		assert.ErrorIs(t, err, model.ErrValidation)
+	})
+}
+
+func TestUseCase_GetMaterial(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+	now := time.Now()
+
+	material := model.Material{
+		UUID:      materialUUID,
+		OwnerUUID: uuid.New().String(),
+		Title:     "Test Title",
+		Status:    "published",
+	}
+
+	t.Run("cache_hit", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.redis.EXPECT().GetMaterials(gomock.Any(), []string{materialUUID}).Return(model.MaterialList{material}, nil)
+		m.redis.EXPECT().IncrementMaterialViews(gomock.Any(), materialUUID, userUUID).Return(nil)
+		m.db.EXPECT().GetUserReactions(gomock.Any(), materialUUID, userUUID).Return([]string{"helpful"}, nil)
+
+		view, err := uc.GetMaterial(ctx, materialUUID, userUUID)
+
+		require.NoError(t, err)
+		assert.Equal(t, &material, view.Material)
+		assert.Equal(t, []string{"helpful"}, view.MyReactions)
+	})
+
+	t.Run("cache_miss_fills_cache", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.redis.EXPECT().GetMaterials(gomock.Any(), []string{materialUUID}).Return(nil, nil)
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(&material, nil)
+		m.redis.EXPECT().SetMaterials(gomock.Any(), model.MaterialList{material}, materialCacheTTL).Return(nil)
+
+		// анонимный просмотр не считается
+		view, err := uc.GetMaterial(ctx, materialUUID, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, &material, view.Material)
+		assert.Nil(t, view.MyReactions)
+	})
+
+	t.Run("cache_errors_are_ignored", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.redis.EXPECT().GetMaterials(gomock.Any(), []string{materialUUID}).Return(nil, errors.New("redis error"))
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(&material, nil)
+		m.redis.EXPECT().SetMaterials(gomock.Any(), gomock.Any(), materialCacheTTL).Return(errors.New("redis error"))
+		m.redis.EXPECT().IncrementMaterialViews(gomock.Any(), materialUUID, userUUID).Return(errors.New("redis error"))
+		m.db.EXPECT().GetUserReactions(gomock.Any(), materialUUID, userUUID).Return(nil, nil)
+
+		view, err := uc.GetMaterial(ctx, materialUUID, userUUID)
+
+		require.NoError(t, err)
+		assert.Equal(t, &material, view.Material)
+	})
+
+	t.Run("owner_view_not_counted", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.redis.EXPECT().GetMaterials(gomock.Any(), []string{materialUUID}).Return(model.MaterialList{material}, nil)
+		m.db.EXPECT().GetUserReactions(gomock.Any(), materialUUID, material.OwnerUUID).Return(nil, nil)
+
+		_, err := uc.GetMaterial(ctx, materialUUID, material.OwnerUUID)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("hidden_for_reader", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		hidden := material
+		hidden.HiddenAt = &now
+		m.redis.EXPECT().GetMaterials(gomock.Any(), []string{materialUUID}).Return(model.MaterialList{hidden}, nil)
+
+		_, err := uc.GetMaterial(ctx, materialUUID, userUUID)
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("hidden_visible_to_moderator", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		hidden := material
+		hidden.HiddenAt = &now
+		m.redis.EXPECT().GetMaterials(gomock.Any(), []string{materialUUID}).Return(model.MaterialList{hidden}, nil)
+
+		moderatorCtx := context.WithValue(ctx, config.KeyRoles, []string{moderatorRole})
+		view, err := uc.GetMaterial(moderatorCtx, materialUUID, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, &hidden, view.Material)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.redis.EXPECT().GetMaterials(gomock.Any(), []string{materialUUID}).Return(nil, nil)
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(nil, model.NotFoundError("material does not exist"))
+
+		_, err := uc.GetMaterial(ctx, materialUUID, "")
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("missing_material_uuid", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		_, err := uc.GetMaterial(ctx, "", userUUID)
+
+		assert.ErrorIs(t, err, model.ErrValidation)
+	})
+}
+
+func TestUseCase_AutosaveDraft(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+	now := time.Now()
+
+	draft := func() *model.MaterialState {
+		return &model.MaterialState{UUID: materialUUID, OwnerUUID: userUUID, Status: "draft"}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialsStateForUpdate(gomock.Any(), []string{materialUUID}).Return([]model.MaterialState{*draft()}, nil)
+		m.redis.EXPECT().
+			SetAutosave(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, autosave *model.AutosaveDraft) error {
+				assert.Equal(t, materialUUID, autosave.MaterialUUID)
+				assert.False(t, autosave.SavedAt.IsZero())
+				return nil
+			})
+
+		saved, err := uc.AutosaveDraft(ctx, userUUID, &model.AutosaveDraft{MaterialUUID: materialUUID, Title: "Work in progress"})
+
+		require.NoError(t, err)
+		assert.False(t, saved.SavedAt.IsZero())
+	})
+
+	t.Run("not_owner", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		material := draft()
+		material.OwnerUUID = uuid.New().String()
+		m.db.EXPECT().GetMaterialsStateForUpdate(gomock.Any(), []string{materialUUID}).Return([]model.MaterialState{*material}, nil)
+
+		_, err := uc.AutosaveDraft(ctx, userUUID, &model.AutosaveDraft{MaterialUUID: materialUUID})
+
+		assert.ErrorIs(t, err, model.ErrForbidden)
+	})
+
+	rejected := map[string]func(*model.MaterialState){
+		"published": func(m *model.MaterialState) { m.Status = "published" },
+		"archived":  func(m *model.MaterialState) { m.ArchivedAt = &now },
+	}
+	for name, mutate := range rejected {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			uc, m := newUseCase(t)
+
+			material := draft()
+			mutate(material)
+			m.db.EXPECT().GetMaterialsStateForUpdate(gomock.Any(), []string{materialUUID}).Return([]model.MaterialState{*material}, nil)
+
+			_, err := uc.AutosaveDraft(ctx, userUUID, &model.AutosaveDraft{MaterialUUID: materialUUID, Title: "Title"})
+
+			assert.ErrorIs(t, err, model.ErrPrecondition)
+		})
+	}
+
+	t.Run("deleted_is_not_found", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		material := draft()
+		material.DeletedAt = &now
+		m.db.EXPECT().GetMaterialsStateForUpdate(gomock.Any(), []string{materialUUID}).Return([]model.MaterialState{*material}, nil)
+
+		_, err := uc.AutosaveDraft(ctx, userUUID, &model.AutosaveDraft{MaterialUUID: materialUUID, Title: "Title"})
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("missing_material", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialsStateForUpdate(gomock.Any(), []string{materialUUID}).Return(nil, nil)
+
+		_, err := uc.AutosaveDraft(ctx, userUUID, &model.AutosaveDraft{MaterialUUID: materialUUID, Title: "Title"})
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("missing_material_uuid", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		_, err := uc.AutosaveDraft(ctx, userUUID, &model.AutosaveDraft{})
+
+		assert.ErrorIs(t, err, model.ErrValidation)
+	})
+}
+
+func TestUseCase_PromoteAutosave(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+	now := time.Now()
+
+	draft := func() *model.MaterialState {
+		return &model.MaterialState{UUID: materialUUID, OwnerUUID: userUUID, Status: "draft"}
+	}
+	autosave := &model.AutosaveDraft{
+		MaterialUUID: materialUUID,
+		Title:        "Autosaved Title",
+		Content:      "Autosaved Content",
+		SavedAt:      time.Now(),
+	}
+	edited := &model.Material{
+		UUID:      materialUUID,
+		OwnerUUID: userUUID,
+		Title:     "Autosaved Title",
+		Content:   stringPtr("Autosaved Content"),
+		Status:    "draft",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialsStateForUpdate(gomock.Any(), []string{materialUUID}).Return([]model.MaterialState{*draft()}, nil)
+		m.redis.EXPECT().GetAutosave(gomock.Any(), materialUUID).Return(autosave, nil)
+		m.db.EXPECT().EditMaterial(gomock.Any(), autosave.ToEditMaterial()).Return(edited, nil)
+		m.redis.EXPECT().DeleteAutosave(gomock.Any(), materialUUID).Return(nil)
+		m.redis.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(nil)
+		m.editKafka.EXPECT().
+			ProduceMessage(gomock.Any(), gomock.Any(), materialUUID).
+			DoAndReturn(func(_ context.Context, message interface{}, _ interface{}) error {
+				msg, ok := message.(*materials.EditMaterialMessage)
+				require.True(t, ok)
+				assert.Equal(t, materialUUID, msg.Uuid)
+				assert.Equal(t, userUUID, msg.OwnerUuid)
+				assert.Equal(t, "Autosaved Title", msg.Title)
+				return nil
+			})
+
+		material, err := uc.PromoteAutosave(ctx, materialUUID, userUUID)
+
+		require.NoError(t, err)
+		assert.Equal(t, edited, material)
+	})
+
+	t.Run("autosave_not_found", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialsStateForUpdate(gomock.Any(), []string{materialUUID}).Return([]model.MaterialState{*draft()}, nil)
+		m.redis.EXPECT().GetAutosave(gomock.Any(), materialUUID).Return(nil, nil)
+
+		_, err := uc.PromoteAutosave(ctx, materialUUID, userUUID)
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	rejected := map[string]func(*model.MaterialState){
+		"published": func(m *model.MaterialState) { m.Status = "published" },
+		"archived":  func(m *model.MaterialState) { m.ArchivedAt = &now },
+	}
+	for name, mutate := range rejected {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			uc, m := newUseCase(t)
+
+			material := draft()
+			mutate(material)
+			m.db.EXPECT().GetMaterialsStateForUpdate(gomock.Any(), []string{materialUUID}).Return([]model.MaterialState{*material}, nil)
+
+			_, err := uc.PromoteAutosave(ctx, materialUUID, userUUID)
+
+			assert.ErrorIs(t, err, model.ErrPrecondition)
+		})
+	}
+
+	t.Run("not_owner", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		material := draft()
+		material.OwnerUUID = uuid.New().String()
+		m.db.EXPECT().GetMaterialsStateForUpdate(gomock.Any(), []string{materialUUID}).Return([]model.MaterialState{*material}, nil)
+
+		_, err := uc.PromoteAutosave(ctx, materialUUID, userUUID)
+
+		assert.ErrorIs(t, err, model.ErrForbidden)
+	})
+
+	t.Run("deleted_is_not_found", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		material := draft()
+		material.DeletedAt = &now
+		m.db.EXPECT().GetMaterialsStateForUpdate(gomock.Any(), []string{materialUUID}).Return([]model.MaterialState{*material}, nil)
+
+		_, err := uc.PromoteAutosave(ctx, materialUUID, userUUID)
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("edit_runs_inside_transaction", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialsStateForUpdate(gomock.Any(), []string{materialUUID}).Return([]model.MaterialState{*draft()}, nil)
+		m.redis.EXPECT().GetAutosave(gomock.Any(), materialUUID).Return(autosave, nil)
+		m.db.EXPECT().EditMaterial(gomock.Any(), autosave.ToEditMaterial()).Return(nil, errors.New("db error"))
+
+		_, err := uc.PromoteAutosave(ctx, materialUUID, userUUID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("empty_title", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialsStateForUpdate(gomock.Any(), []string{materialUUID}).Return([]model.MaterialState{*draft()}, nil)
+		m.redis.EXPECT().GetAutosave(gomock.Any(), materialUUID).Return(&model.AutosaveDraft{MaterialUUID: materialUUID}, nil)
+
+		_, err := uc.PromoteAutosave(ctx, materialUUID, userUUID)
+
+		assert.ErrorIs(t, err, model.ErrPrecondition)
+	})
+}
+
+func TestUseCase_DuplicateMaterial(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+	authorUUID := uuid.New().String()
+	sourceUUID := uuid.New().String()
+
+	newSource := func(ownerUUID, status string) *model.Material {
+		return &model.Material{UUID: sourceUUID, OwnerUUID: ownerUUID, Title: "Source Title", Status: status}
+	}
+
+	t.Run("success_foreign_published", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		duplicate := &model.Material{UUID: uuid.New().String(), OwnerUUID: userUUID, Status: "draft", ForkedFromUUID: &sourceUUID}
+
+		m.db.EXPECT().GetMaterial(gomock.Any(), sourceUUID).Return(newSource(authorUUID, "published"), nil)
+		m.db.EXPECT().DuplicateMaterial(gomock.Any(), sourceUUID, userUUID).Return(duplicate, nil)
+		m.db.EXPECT().IncrementForksCount(gomock.Any(), sourceUUID).Return(nil)
+		m.redis.EXPECT().DeleteMaterial(gomock.Any(), sourceUUID).Return(nil)
+
+		result, err := uc.DuplicateMaterial(ctx, sourceUUID, userUUID)
+
+		require.NoError(t, err)
+		assert.Equal(t, duplicate, result)
+	})
+
+	t.Run("foreign_draft_forbidden", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterial(gomock.Any(), sourceUUID).Return(newSource(authorUUID, "draft"), nil)
+
+		_, err := uc.DuplicateMaterial(ctx, sourceUUID, userUUID)
+
+		assert.ErrorIs(t, err, model.ErrForbidden)
+	})
+
+	t.Run("deleted_source", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		source := newSource(userUUID, "draft")
+		deletedAt := time.Now()
+		source.DeletedAt = &deletedAt
+		m.db.EXPECT().GetMaterial(gomock.Any(), sourceUUID).Return(source, nil)
+
+		_, err := uc.DuplicateMaterial(ctx, sourceUUID, userUUID)
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("increment_error", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterial(gomock.Any(), sourceUUID).Return(newSource(userUUID, "draft"), nil)
+		m.db.EXPECT().DuplicateMaterial(gomock.Any(), sourceUUID, userUUID).Return(&model.Material{}, nil)
+		m.db.EXPECT().IncrementForksCount(gomock.Any(), sourceUUID).Return(errors.New("db error"))
+
+		_, err := uc.DuplicateMaterial(ctx, sourceUUID, userUUID)
+
+		assert.Contains(t, err.Error(), "failed to increment forks count")
+	})
+}
+
+func TestUseCase_BulkOperation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+	ownUUID := uuid.New().String()
+	foreignUUID := uuid.New().String()
+
+	states := []model.MaterialState{
+		{UUID: ownUUID, OwnerUUID: userUUID, Status: "draft"},
+		{UUID: foreignUUID, OwnerUUID: uuid.New().String(), Status: "draft"},
+	}
+
+	t.Run("delete_per_item_results", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		uuids := []string{ownUUID, foreignUUID, "not-a-uuid"}
+
+		m.db.EXPECT().GetMaterialsStateForUpdate(gomock.Any(), []string{ownUUID, foreignUUID}).Return(states, nil)
+		m.db.EXPECT().BulkDeleteMaterials(gomock.Any(), []string{ownUUID}).Return(nil)
+		m.redis.EXPECT().DeleteMaterials(gomock.Any(), []string{ownUUID}).Return(nil)
+		m.bulkKafka.EXPECT().
+			ProduceMessage(gomock.Any(), gomock.Any(), userUUID).
+			DoAndReturn(func(_ context.Context, message interface{}, _ interface{}) error {
+				msg, ok := message.(*materials.BulkOperationMessage)
+				require.True(t, ok)
+				assert.Equal(t, model.BulkActionDelete, msg.Action)
+				assert.Equal(t, []string{ownUUID}, msg.Uuids)
+				return nil
+			})
+
+		results, err := uc.BulkOperation(ctx, model.BulkActionDelete, userUUID, uuids, nil)
+
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		assert.True(t, results[0].Success)
+		assert.Equal(t, "user is not owner", results[1].Error)
+		assert.Equal(t, "invalid uuid", results[2].Error)
+	})
+
+	t.Run("nothing_allowed_no_event", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialsStateForUpdate(gomock.Any(), []string{foreignUUID}).Return(states[1:], nil)
+
+		results, err := uc.BulkOperation(ctx, model.BulkActionArchive, userUUID, []string{foreignUUID}, nil)
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.False(t, results[0].Success)
+	})
+
+	t.Run("tag_normalizes_and_keeps_cache", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialsStateForUpdate(gomock.Any(), []string{ownUUID}).Return(states[:1], nil)
+		m.db.EXPECT().AddMaterialsTags(gomock.Any(), []string{ownUUID}, []string{"go", "backend"}).Return(nil)
+		m.bulkKafka.EXPECT().ProduceMessage(gomock.Any(), gomock.Any(), userUUID).Return(nil)
+
+		results, err := uc.BulkOperation(ctx, model.BulkActionTag, userUUID, []string{ownUUID}, []string{" Go ", "go", "Backend"})
+
+		require.NoError(t, err)
+		assert.True(t, results[0].Success)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		tooMany := make([]string, model.BulkMaxItems+1)
+		for i := range tooMany {
+			tooMany[i] = uuid.New().String()
+		}
+
+		_, err := uc.BulkOperation(ctx, model.BulkActionDelete, userUUID, nil, nil)
+		assert.ErrorIs(t, err, model.ErrValidation)
+
+		_, err = uc.BulkOperation(ctx, model.BulkActionDelete, userUUID, tooMany, nil)
+		assert.ErrorIs(t, err, model.ErrValidation)
+
+		_, err = uc.BulkOperation(ctx, model.BulkActionTag, userUUID, []string{ownUUID}, []string{" "})
+		assert.ErrorIs(t, err, model.ErrValidation)
+	})
+
+	t.Run("repository_error", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialsStateForUpdate(gomock.Any(), []string{ownUUID}).Return(states[:1], nil)
+		m.db.EXPECT().BulkPublishMaterials(gomock.Any(), []string{ownUUID}).Return(errors.New("db error"))
+
+		_, err := uc.BulkOperation(ctx, model.BulkActionPublish, userUUID, []string{ownUUID}, nil)
+
+		assert.Contains(t, err.Error(), "failed to publish materials")
+	})
+}
+
+func TestUseCase_ChangeReaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+
+	t.Run("set_reaction", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		counts := model.ReactionCounts{"helpful": 1}
+		m.db.EXPECT().MaterialExists(gomock.Any(), materialUUID).Return(true, nil)
+		m.db.EXPECT().AddReaction(gomock.Any(), materialUUID, userUUID, "helpful").
+			Return(&model.ReactionResult{Changed: true, Counts: counts}, nil)
+		m.redis.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(nil)
+		m.db.EXPECT().GetUserReactions(gomock.Any(), materialUUID, userUUID).Return([]string{"helpful"}, nil)
+
+		state, err := uc.ChangeReaction(ctx, materialUUID, userUUID, " Helpful ", true)
+
+		require.NoError(t, err)
+		assert.Equal(t, &model.ReactionState{Counts: counts, MyReactions: []string{"helpful"}}, state)
+	})
+
+	t.Run("like_produces_like_event", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().MaterialExists(gomock.Any(), materialUUID).Return(true, nil)
+		m.db.EXPECT().RemoveReaction(gomock.Any(), materialUUID, userUUID, model.ReactionLike).
+			Return(&model.ReactionResult{Changed: true, LikesCount: 4}, nil)
+		m.redis.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(nil)
+		m.likeKafka.EXPECT().
+			ProduceMessage(gomock.Any(), &materials.ToggleLikeMessage{
+				MaterialUuid: materialUUID,
+				IsLiked:      false,
+				LikesCount:   4,
+			}, materialUUID).
+			Return(nil)
+		m.db.EXPECT().GetUserReactions(gomock.Any(), materialUUID, userUUID).Return(nil, nil)
+
+		_, err := uc.ChangeReaction(ctx, materialUUID, userUUID, model.ReactionLike, false)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("unchanged_keeps_cache", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().MaterialExists(gomock.Any(), materialUUID).Return(true, nil)
+		m.db.EXPECT().RemoveReaction(gomock.Any(), materialUUID, userUUID, "confusing").
+			Return(&model.ReactionResult{}, nil)
+		m.db.EXPECT().GetUserReactions(gomock.Any(), materialUUID, userUUID).Return(nil, nil)
+
+		_, err := uc.ChangeReaction(ctx, materialUUID, userUUID, "confusing", false)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("check_and_change_run_inside_transaction", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().MaterialExists(inTx, materialUUID).Return(true, nil)
+		m.db.EXPECT().AddReaction(inTx, materialUUID, userUUID, "helpful").Return(&model.ReactionResult{}, nil)
+		m.db.EXPECT().GetUserReactions(inTx, materialUUID, userUUID).Return([]string{"helpful"}, nil)
+
+		_, err := uc.ChangeReaction(ctx, materialUUID, userUUID, "helpful", true)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown_reaction", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		_, err := uc.ChangeReaction(ctx, materialUUID, userUUID, "angry", true)
+
+		assert.ErrorIs(t, err, model.ErrValidation)
+	})
+
+	t.Run("material_not_found", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().MaterialExists(gomock.Any(), materialUUID).Return(false, nil)
+
+		_, err := uc.ChangeReaction(ctx, materialUUID, userUUID, "helpful", true)
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+	})
+}
+
+func TestUseCase_ReportMaterial(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+	reportUUID := uuid.New().String()
+	now := time.Now()
+
+	material := func() *model.Material {
+		return &model.Material{UUID: materialUUID, OwnerUUID: uuid.New().String(), Status: "published"}
+	}
+
+	t.Run("below_threshold", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(material(), nil)
+		m.db.EXPECT().CreateMaterialReport(gomock.Any(), materialUUID, userUUID, "spam", "buy now").
+			Return(&model.ReportResult{UUID: &reportUUID, OpenReports: 1}, nil)
+
+		report, err := uc.ReportMaterial(ctx, materialUUID, userUUID, " SPAM ", " buy now ")
+
+		require.NoError(t, err)
+		assert.Equal(t, &model.CreatedReport{UUID: reportUUID}, report)
+	})
+
+	t.Run("threshold_reached_hides_material", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(material(), nil)
+		m.db.EXPECT().CreateMaterialReport(gomock.Any(), materialUUID, userUUID, "abuse", "").
+			Return(&model.ReportResult{UUID: &reportUUID, OpenReports: hideThreshold}, nil)
+		m.db.EXPECT().HideReportedMaterial(gomock.Any(), materialUUID, hideThreshold).Return(true, nil)
+		m.redis.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(nil)
+		m.modKafka.EXPECT().
+			ProduceMessage(gomock.Any(), gomock.Any(), materialUUID).
+			DoAndReturn(func(_ context.Context, message interface{}, _ interface{}) error {
+				msg, ok := message.(*materials.ModerationDecisionMessage)
+				require.True(t, ok)
+				assert.Equal(t, model.ModerationActionAutoHidden, msg.Action)
+				assert.Equal(t, reportUUID, msg.ReportUuid)
+				assert.NotNil(t, msg.DecidedAt)
+				return nil
+			})
+
+		report, err := uc.ReportMaterial(ctx, materialUUID, userUUID, "abuse", "")
+
+		require.NoError(t, err)
+		assert.True(t, report.MaterialHidden)
+	})
+
+	t.Run("already_reported", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(material(), nil)
+		m.db.EXPECT().CreateMaterialReport(gomock.Any(), materialUUID, userUUID, "spam", "").
+			Return(&model.ReportResult{OpenReports: 1}, nil)
+
+		_, err := uc.ReportMaterial(ctx, materialUUID, userUUID, "spam", "")
+
+		assert.ErrorIs(t, err, model.ErrConflict)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		_, err := uc.ReportMaterial(ctx, materialUUID, userUUID, "boring", "")
+		assert.ErrorIs(t, err, model.ErrValidation)
+
+		longComment := string(bytes.Repeat([]byte("a"), model.ReportCommentMaxLength+1))
+		_, err = uc.ReportMaterial(ctx, materialUUID, userUUID, "spam", longComment)
+		assert.ErrorIs(t, err, model.ErrValidation)
+	})
+
+	t.Run("material_not_found", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(nil, model.NotFoundError("material doesn't exist"))
+
+		_, err := uc.ReportMaterial(ctx, materialUUID, userUUID, "spam", "")
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	invisible := map[string]func(*model.Material){
+		"deleted":       func(m *model.Material) { m.DeletedAt = &now },
+		"own_deleted":   func(m *model.Material) { m.OwnerUUID = userUUID; m.DeletedAt = &now },
+		"foreign_draft": func(m *model.Material) { m.Status = "draft" },
+		"hidden":        func(m *model.Material) { m.HiddenAt = &now },
+	}
+	for name, mutate := range invisible {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			uc, m := newUseCase(t)
+
+			reported := material()
+			mutate(reported)
+			m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(reported, nil)
+
+			_, err := uc.ReportMaterial(ctx, materialUUID, userUUID, "spam", "")
+
+			assert.ErrorIs(t, err, model.ErrNotFound)
+		})
+	}
+
+	t.Run("own_material", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		own := material()
+		own.OwnerUUID = userUUID
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(own, nil)
+
+		_, err := uc.ReportMaterial(ctx, materialUUID, userUUID, "spam", "")
+
+		assert.ErrorIs(t, err, model.ErrForbidden)
+	})
+}
+
+func TestUseCase_Moderation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	moderatorUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+	reportUUID := uuid.New().String()
+	moderatorCtx := context.WithValue(ctx, config.KeyRoles, []string{moderatorRole})
+
+	t.Run("list_reports", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		reports := model.MaterialReportList{{UUID: reportUUID, MaterialUUID: materialUUID}}
+		m.db.EXPECT().GetOpenMaterialReports(gomock.Any(), materialUUID, 0, 10).Return(&reports, nil)
+
+		result, err := uc.ListMaterialReports(moderatorCtx, materialUUID, 0, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, reports, result)
+	})
+
+	t.Run("not_moderator", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		_, err := uc.ListMaterialReports(ctx, "", 0, 10)
+		assert.ErrorIs(t, err, model.ErrForbidden)
+
+		_, err = uc.ResolveMaterialReport(ctx, reportUUID, moderatorUUID, model.ReportStatusActioned)
+		assert.ErrorIs(t, err, model.ErrForbidden)
+
+		_, err = uc.ModerateMaterial(ctx, materialUUID, moderatorUUID, "", true)
+		assert.ErrorIs(t, err, model.ErrForbidden)
+	})
+
+	t.Run("resolve_report", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		report := &model.MaterialReport{UUID: reportUUID, MaterialUUID: materialUUID, Status: model.ReportStatusDismissed}
+		m.db.EXPECT().ResolveMaterialReport(gomock.Any(), reportUUID, moderatorUUID, model.ReportStatusDismissed).Return(report, nil)
+		m.modKafka.EXPECT().ProduceMessage(gomock.Any(), gomock.Any(), materialUUID).Return(nil)
+
+		result, err := uc.ResolveMaterialReport(moderatorCtx, reportUUID, moderatorUUID, model.ReportStatusDismissed)
+
+		require.NoError(t, err)
+		assert.Equal(t, report, result)
+	})
+
+	t.Run("resolve_already_resolved", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().ResolveMaterialReport(gomock.Any(), reportUUID, moderatorUUID, model.ReportStatusActioned).Return(nil, nil)
+
+		_, err := uc.ResolveMaterialReport(moderatorCtx, reportUUID, moderatorUUID, model.ReportStatusActioned)
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("resolve_unknown_resolution", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		_, err := uc.ResolveMaterialReport(moderatorCtx, reportUUID, moderatorUUID, model.ReportStatusOpen)
+
+		assert.ErrorIs(t, err, model.ErrValidation)
+	})
+
+	t.Run("hide_material", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().HideMaterial(gomock.Any(), materialUUID).Return(int64(1), nil)
+		m.db.EXPECT().ResolveOpenMaterialReports(gomock.Any(), materialUUID, moderatorUUID, model.ReportStatusActioned).Return(int64(2), nil)
+		m.redis.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(nil)
+		m.modKafka.EXPECT().
+			ProduceMessage(gomock.Any(), gomock.Any(), materialUUID).
+			DoAndReturn(func(_ context.Context, message interface{}, _ interface{}) error {
+				msg, ok := message.(*materials.ModerationDecisionMessage)
+				require.True(t, ok)
+				assert.Equal(t, model.ModerationActionHidden, msg.Action)
+				assert.Equal(t, model.ReportStatusActioned, msg.Resolution)
+				assert.Equal(t, "rules violation", msg.Reason)
+				return nil
+			})
+
+		resolved, err := uc.ModerateMaterial(moderatorCtx, materialUUID, moderatorUUID, "rules violation", true)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), resolved)
+	})
+
+	t.Run("unhide_already_visible", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().UnhideMaterial(gomock.Any(), materialUUID).Return(int64(0), nil)
+
+		_, err := uc.ModerateMaterial(moderatorCtx, materialUUID, moderatorUUID, "", false)
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+	})
+}
+
+func TestUseCase_MaterialLists(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+	list := model.MaterialList{{UUID: uuid.New().String(), OwnerUUID: userUUID, Title: "first"}}
+
+	t.Run("all", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetAllMaterials(gomock.Any(), 10, 5, true).Return(&list, nil)
+
+		got, err := uc.GetAllMaterials(ctx, 10, 5, true)
+
+		require.NoError(t, err)
+		assert.Equal(t, list, got)
+	})
+
+	t.Run("deleted_within_retention", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetDeletedMaterials(gomock.Any(), userUUID, gomock.Any(), 0, 10).
+			DoAndReturn(func(_ context.Context, _ string, deletedAfter time.Time, _, _ int) (*model.MaterialList, error) {
+				assert.WithinDuration(t, time.Now().Add(-trashRetention), deletedAfter, time.Minute)
+				return &list, nil
+			})
+
+		got, err := uc.GetDeletedMaterials(ctx, userUUID, 0, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, list, got)
+	})
+
+	t.Run("archived", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetArchivedMaterials(gomock.Any(), userUUID, 0, 10).Return(&list, nil)
+
+		got, err := uc.GetArchivedMaterials(ctx, userUUID, 0, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, list, got)
+	})
+
+	t.Run("repository_error", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetArchivedMaterials(gomock.Any(), userUUID, 0, 10).Return(nil, errors.New("db error"))
+
+		_, err := uc.GetArchivedMaterials(ctx, userUUID, 0, 10)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestUseCase_ListLikers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ownerUUID := uuid.New().String()
+	readerUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+	now := time.Now()
+	published := &model.Material{UUID: materialUUID, OwnerUUID: ownerUUID, Status: "published"}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		likers := model.MaterialLikerList{{Uuid: uuid.New().String(), Nickname: "liker"}}
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(published, nil)
+		m.db.EXPECT().GetMaterialLikers(gomock.Any(), materialUUID, 10, 10).Return(&likers, nil)
+
+		got, err := uc.ListLikers(ctx, materialUUID, "", 10, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, likers, got)
+	})
+
+	t.Run("owner_sees_hidden", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).
+			Return(&model.Material{UUID: materialUUID, OwnerUUID: ownerUUID, Status: "published", HiddenAt: &now}, nil)
+		m.db.EXPECT().GetMaterialLikers(gomock.Any(), materialUUID, 0, 10).Return(&model.MaterialLikerList{}, nil)
+
+		_, err := uc.ListLikers(ctx, materialUUID, ownerUUID, 0, 10)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("moderator_sees_hidden", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).
+			Return(&model.Material{UUID: materialUUID, OwnerUUID: ownerUUID, Status: "published", HiddenAt: &now}, nil)
+		m.db.EXPECT().GetMaterialLikers(gomock.Any(), materialUUID, 0, 10).Return(&model.MaterialLikerList{}, nil)
+
+		moderatorCtx := context.WithValue(ctx, config.KeyRoles, []string{moderatorRole})
+		_, err := uc.ListLikers(moderatorCtx, materialUUID, readerUUID, 0, 10)
+
+		require.NoError(t, err)
+	})
+
+	invisible := map[string]*model.Material{
+		"foreign_draft": {UUID: materialUUID, OwnerUUID: ownerUUID, Status: "draft"},
+		"hidden":        {UUID: materialUUID, OwnerUUID: ownerUUID, Status: "published", HiddenAt: &now},
+		"deleted":       {UUID: materialUUID, OwnerUUID: ownerUUID, Status: "published", DeletedAt: &now},
+	}
+	for name, material := range invisible {
+		material := material
+		t.Run(name+"_is_not_found", func(t *testing.T) {
+			t.Parallel()
+			uc, m := newUseCase(t)
+
+			m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(material, nil)
+
+			_, err := uc.ListLikers(ctx, materialUUID, readerUUID, 0, 10)
+
+			assert.ErrorIs(t, err, model.ErrNotFound)
+		})
+	}
+
+	t.Run("material_not_found", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(nil, model.NotFoundError("material doesn't exist"))
+
+		_, err := uc.ListLikers(ctx, materialUUID, readerUUID, 0, 10)
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("empty_uuid", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		_, err := uc.ListLikers(ctx, "", readerUUID, 0, 10)
+
+		assert.ErrorIs(t, err, model.ErrValidation)
+	})
+}
+
+func TestUseCase_GetTrending(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	first := uuid.New().String()
+	second := uuid.New().String()
+
+	t.Run("keeps_ranking_order_of_visible_materials", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.redis.EXPECT().GetTrending(gomock.Any(), "go", 0, 10).Return([]string{first, second}, nil)
+		m.db.EXPECT().GetPublishedMaterialsByUUIDs(gomock.Any(), []string{first, second}).Return(&model.MaterialList{
+			{UUID: second, Title: "second"},
+			{UUID: first, Title: "first"},
+		}, nil)
+
+		got, err := uc.GetTrending(ctx, " Go ", 0, 10)
+
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, first, got[0].UUID)
+		assert.Equal(t, second, got[1].UUID)
+	})
+
+	t.Run("empty_ranking", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.redis.EXPECT().GetTrending(gomock.Any(), "", 0, 10).Return(nil, nil)
+
+		got, err := uc.GetTrending(ctx, "", 0, 10)
+
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("cache_error", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.redis.EXPECT().GetTrending(gomock.Any(), "", 0, 10).Return(nil, errors.New("redis down"))
+
+		_, err := uc.GetTrending(ctx, "", 0, 10)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestUseCase_GetRelated(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ownerUUID := uuid.New().String()
+	userUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+	now := time.Now()
+	published := &model.Material{UUID: materialUUID, OwnerUUID: ownerUUID, Status: "published"}
+
+	t.Run("excludes_own_materials", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		related := model.MaterialList{{UUID: uuid.New().String(), Title: "related"}}
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(published, nil)
+		m.db.EXPECT().GetRelatedMaterials(gomock.Any(), materialUUID, userUUID, 0, 10).Return(&related, nil)
+
+		got, err := uc.GetRelated(ctx, materialUUID, userUUID, 0, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, related, got)
+	})
+
+	t.Run("owner_draft", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).
+			Return(&model.Material{UUID: materialUUID, OwnerUUID: ownerUUID, Status: "draft"}, nil)
+		m.db.EXPECT().GetRelatedMaterials(gomock.Any(), materialUUID, ownerUUID, 0, 10).Return(&model.MaterialList{}, nil)
+
+		_, err := uc.GetRelated(ctx, materialUUID, ownerUUID, 0, 10)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("moderator_sees_hidden", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).
+			Return(&model.Material{UUID: materialUUID, OwnerUUID: ownerUUID, Status: "published", HiddenAt: &now}, nil)
+		m.db.EXPECT().GetRelatedMaterials(gomock.Any(), materialUUID, userUUID, 0, 10).Return(&model.MaterialList{}, nil)
+
+		moderatorCtx := context.WithValue(ctx, config.KeyRoles, []string{moderatorRole})
+		_, err := uc.GetRelated(moderatorCtx, materialUUID, userUUID, 0, 10)
+
+		require.NoError(t, err)
+	})
+
+	invisible := map[string]*model.Material{
+		"foreign_draft": {UUID: materialUUID, OwnerUUID: ownerUUID, Status: "draft"},
+		"hidden":        {UUID: materialUUID, OwnerUUID: ownerUUID, Status: "published", HiddenAt: &now},
+		"deleted":       {UUID: materialUUID, OwnerUUID: ownerUUID, Status: "published", DeletedAt: &now},
+	}
+	for name, material := range invisible {
+		material := material
+		t.Run(name+"_is_not_found", func(t *testing.T) {
+			t.Parallel()
+			uc, m := newUseCase(t)
+
+			m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(material, nil)
+
+			_, err := uc.GetRelated(ctx, materialUUID, userUUID, 0, 10)
+
+			assert.ErrorIs(t, err, model.ErrNotFound)
+		})
+	}
+
+	t.Run("material_not_found", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(nil, model.NotFoundError("material doesn't exist"))
+
+		_, err := uc.GetRelated(ctx, materialUUID, userUUID, 0, 10)
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("empty_uuid", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		_, err := uc.GetRelated(ctx, "", userUUID, 0, 10)
+
+		assert.ErrorIs(t, err, model.ErrValidation)
+	})
+}
+
+func TestUseCase_UploadCover(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+	data := []byte("image")
+
+	t.Run("success_invalidates_cache", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		cover := &model.Cover{URL: "https://cdn/cover.jpg"}
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.covers.EXPECT().Upload(gomock.Any(), materialUUID, userUUID, data).Return(cover, nil)
+		m.redis.EXPECT().DeleteMaterial(gomock.Any(), materialUUID).Return(errors.New("redis down"))
+
+		got, err := uc.UploadCover(ctx, materialUUID, userUUID, data)
+
+		require.NoError(t, err)
+		assert.Equal(t, cover, got)
+	})
+
+	t.Run("not_owner", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(uuid.New().String(), nil)
+
+		_, err := uc.UploadCover(ctx, materialUUID, userUUID, data)
+
+		assert.ErrorIs(t, err, model.ErrForbidden)
+	})
+
+	t.Run("empty_image", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		_, err := uc.UploadCover(ctx, materialUUID, userUUID, nil)
+
+		assert.ErrorIs(t, err, model.ErrValidation)
+	})
+}
+
+func TestUseCase_Attachments(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+	attachmentUUID := uuid.New().String()
+	file := &model.MaterialAttachment{UUID: attachmentUUID, MaterialUUID: materialUUID, OwnerUUID: userUUID, Filename: "diagram.png"}
+
+	t.Run("upload", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.attachments.EXPECT().Upload(gomock.Any(), materialUUID, userUUID, "diagram.png", []byte("png")).Return(file, nil)
+
+		got, err := uc.UploadAttachment(ctx, materialUUID, userUUID, "diagram.png", []byte("png"))
+
+		require.NoError(t, err)
+		assert.Equal(t, file, got)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.attachments.EXPECT().List(gomock.Any(), materialUUID).Return(model.MaterialAttachmentList{*file}, nil)
+
+		got, err := uc.ListAttachments(ctx, materialUUID, userUUID)
+
+		require.NoError(t, err)
+		assert.Equal(t, model.MaterialAttachmentList{*file}, got)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(userUUID, nil)
+		m.attachments.EXPECT().Delete(gomock.Any(), materialUUID, attachmentUUID).Return(nil)
+
+		require.NoError(t, uc.DeleteAttachment(ctx, materialUUID, attachmentUUID, userUUID))
+	})
+
+	ownerChecked := map[string]func(uc *UseCase) error{
+		"upload": func(uc *UseCase) error {
+			_, err := uc.UploadAttachment(ctx, materialUUID, userUUID, "diagram.png", []byte("png"))
+			return err
+		},
+		"list": func(uc *UseCase) error {
+			_, err := uc.ListAttachments(ctx, materialUUID, userUUID)
+			return err
+		},
+		"delete": func(uc *UseCase) error {
+			return uc.DeleteAttachment(ctx, materialUUID, attachmentUUID, userUUID)
+		},
+	}
+	for name, call := range ownerChecked {
+		call := call
+		t.Run(name+"_not_owner", func(t *testing.T) {
+			t.Parallel()
+			uc, m := newUseCase(t)
+
+			m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return(uuid.New().String(), nil)
+
+			assert.ErrorIs(t, call(uc), model.ErrForbidden)
+		})
+		t.Run(name+"_material_not_found", func(t *testing.T) {
+			t.Parallel()
+			uc, m := newUseCase(t)
+
+			m.db.EXPECT().GetMaterialOwnerUUID(gomock.Any(), materialUUID).Return("", model.NotFoundError("material does not exist"))
+
+			assert.ErrorIs(t, call(uc), model.ErrNotFound)
+		})
+	}
+
+	t.Run("delete_requires_both_uuids", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		assert.ErrorIs(t, uc.DeleteAttachment(ctx, materialUUID, "", userUUID), model.ErrValidation)
+	})
+}
+
+func TestUseCase_OpenAttachment(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ownerUUID := uuid.New().String()
+	readerUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+	attachmentUUID := uuid.New().String()
+	now := time.Now()
+
+	t.Run("published_material", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		file := &model.MaterialAttachment{UUID: attachmentUUID, Filename: "diagram.png"}
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(&model.Material{UUID: materialUUID, OwnerUUID: ownerUUID, Status: "published"}, nil)
+		m.attachments.EXPECT().Open(gomock.Any(), materialUUID, attachmentUUID).Return(file, []byte("png"), nil)
+
+		got, data, err := uc.OpenAttachment(ctx, materialUUID, attachmentUUID, readerUUID)
+
+		require.NoError(t, err)
+		assert.Equal(t, file, got)
+		assert.Equal(t, []byte("png"), data)
+	})
+
+	hidden := map[string]*model.Material{
+		"draft":   {UUID: materialUUID, OwnerUUID: ownerUUID, Status: "draft"},
+		"hidden":  {UUID: materialUUID, OwnerUUID: ownerUUID, Status: "published", HiddenAt: &now},
+		"deleted": {UUID: materialUUID, OwnerUUID: ownerUUID, Status: "published", DeletedAt: &now},
+	}
+	for name, material := range hidden {
+		material := material
+		t.Run(name+"_is_not_found", func(t *testing.T) {
+			t.Parallel()
+			uc, m := newUseCase(t)
+
+			m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(material, nil)
+
+			_, _, err := uc.OpenAttachment(ctx, materialUUID, attachmentUUID, readerUUID)
+
+			assert.ErrorIs(t, err, model.ErrNotFound)
+		})
+	}
+
+	t.Run("missing_material", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(nil, model.NotFoundError("material does not exist"))
+
+		_, _, err := uc.OpenAttachment(ctx, materialUUID, attachmentUUID, readerUUID)
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("moderator_sees_hidden", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetMaterial(gomock.Any(), materialUUID).Return(hidden["hidden"], nil)
+		m.attachments.EXPECT().Open(gomock.Any(), materialUUID, attachmentUUID).Return(&model.MaterialAttachment{}, nil, nil)
+
+		moderatorCtx := context.WithValue(ctx, config.KeyRoles, []string{moderatorRole})
+		_, _, err := uc.OpenAttachment(moderatorCtx, materialUUID, attachmentUUID, readerUUID)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestUseCase_ResolveContent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	materialUUID := uuid.New().String()
+
+	t.Run("without_references", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		got, err := uc.ResolveContent(ctx, materialUUID, "plain text")
+
+		require.NoError(t, err)
+		assert.Equal(t, "plain text", got)
+	})
+
+	t.Run("with_references", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		content := "![diagram](attachment://" + uuid.New().String() + ")"
+		m.attachments.EXPECT().ResolveContent(gomock.Any(), materialUUID, content).Return("resolved", nil)
+
+		got, err := uc.ResolveContent(ctx, materialUUID, content)
+
+		require.NoError(t, err)
+		assert.Equal(t, "resolved", got)
+	})
+}
+
+func exportBatches(batches ...model.MaterialList) func(context.Context, model.ExportFilter, int, func(model.MaterialList) error) error {
+	return func(_ context.Context, _ model.ExportFilter, _ int, fn func(model.MaterialList) error) error {
+		for _, batch := range batches {
+			if err := fn(batch); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func identityContext(userUUID string, roles ...string) context.Context {
+	ctx := context.WithValue(context.Background(), config.KeyUUID, userUUID)
+	return context.WithValue(ctx, config.KeyRoles, roles)
+}
+
+func TestUseCase_ExportMaterials(t *testing.T) {
+	t.Parallel()
+
+	userUUID := uuid.New().String()
+	batch := model.MaterialList{{UUID: uuid.New().String(), OwnerUUID: userUUID, Title: "first"}}
+
+	t.Run("export_role_reads_all_batches", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().ExportMaterials(gomock.Any(), model.ExportFilter{Status: "published"}, exportBatchSize, gomock.Any()).
+			DoAndReturn(exportBatches(batch, batch))
+
+		var received int
+		err := uc.ExportMaterials(identityContext("", exportRole), "", model.ExportFilter{Status: "published"}, func(b model.MaterialList) error {
+			received += len(b)
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, received)
+	})
+
+	t.Run("user_exports_own_materials", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().ExportMaterials(gomock.Any(), model.ExportFilter{OwnerUUID: userUUID}, exportBatchSize, gomock.Any()).
+			DoAndReturn(exportBatches(batch))
+
+		err := uc.ExportMaterials(identityContext(userUUID), userUUID, model.ExportFilter{OwnerUUID: userUUID}, func(model.MaterialList) error {
+			return nil
+		})
+
+		require.NoError(t, err)
+	})
+
+	denied := map[string]struct {
+		ctx      context.Context
+		userUUID string
+		filter   model.ExportFilter
+	}{
+		"anonymous":       {ctx: context.Background(), filter: model.ExportFilter{OwnerUUID: userUUID}},
+		"all_materials":   {ctx: identityContext(userUUID), userUUID: userUUID},
+		"foreign_owner":   {ctx: identityContext(userUUID), userUUID: uuid.New().String(), filter: model.ExportFilter{OwnerUUID: userUUID}},
+		"unrelated_roles": {ctx: identityContext(userUUID, moderatorRole), userUUID: userUUID},
+	}
+	for name, tc := range denied {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			uc, _ := newUseCase(t)
+
+			err := uc.ExportMaterials(tc.ctx, tc.userUUID, tc.filter, func(model.MaterialList) error { return nil })
+
+			assert.ErrorIs(t, err, model.ErrForbidden)
+		})
+	}
+
+	t.Run("invalid_filter", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		err := uc.ExportMaterials(identityContext("", exportRole), "", model.ExportFilter{Status: "deleted"}, func(model.MaterialList) error { return nil })
+
+		assert.ErrorIs(t, err, model.ErrValidation)
+	})
+
+	t.Run("callback_error_stops_export", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().ExportMaterials(gomock.Any(), gomock.Any(), exportBatchSize, gomock.Any()).
+			DoAndReturn(exportBatches(batch, batch))
+
+		sendErr := errors.New("transport is closing")
+		calls := 0
+		err := uc.ExportMaterials(identityContext("", exportRole), "", model.ExportFilter{}, func(model.MaterialList) error {
+			calls++
+			return sendErr
+		})
+
+		assert.ErrorIs(t, err, sendErr)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestUseCase_ExportUserData(t *testing.T) {
+	t.Parallel()
+
+	userUUID := uuid.New().String()
+	materialUUID := uuid.New().String()
+	deletedAt := time.Now()
+	privacyCtx := identityContext("", privacyRole)
+
+	t.Run("collects_everything", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		profile := &model.User{Uuid: userUUID, Nickname: "ivan"}
+		owned := model.MaterialList{
+			{UUID: materialUUID, OwnerUUID: userUUID, Title: "draft"},
+			{UUID: uuid.New().String(), OwnerUUID: userUUID, Title: "trashed", DeletedAt: &deletedAt},
+		}
+		tags := []model.MaterialTag{{MaterialUUID: materialUUID, Tag: "go"}}
+		reactions := model.UserReactionList{{MaterialUUID: uuid.New().String(), Reaction: "like"}}
+		reports := model.MaterialReportList{{UUID: uuid.New().String(), ReporterUUID: userUUID}}
+		attachments := model.MaterialAttachmentList{{UUID: uuid.New().String(), MaterialUUID: materialUUID, OwnerUUID: userUUID}}
+
+		m.db.EXPECT().GetUser(gomock.Any(), userUUID).Return(profile, nil)
+		m.db.EXPECT().ExportMaterials(gomock.Any(), model.ExportFilter{OwnerUUID: userUUID, IncludeDeleted: true}, exportBatchSize, gomock.Any()).
+			DoAndReturn(exportBatches(owned[:1], owned[1:]))
+		m.db.EXPECT().GetMaterialsTags(gomock.Any(), []string{owned[0].UUID, owned[1].UUID}).Return(tags, nil)
+		m.db.EXPECT().GetReactionsByUser(gomock.Any(), userUUID).Return(reactions, nil)
+		m.db.EXPECT().GetReportsByReporter(gomock.Any(), userUUID).Return(reports, nil)
+		m.db.EXPECT().GetAttachmentsByOwner(gomock.Any(), userUUID).Return(attachments, nil)
+
+		data, err := uc.ExportUserData(privacyCtx, userUUID)
+
+		require.NoError(t, err)
+		assert.Equal(t, &model.UserData{
+			UserUUID:    userUUID,
+			Profile:     profile,
+			Materials:   owned,
+			Tags:        tags,
+			Reactions:   reactions,
+			Reports:     reports,
+			Attachments: attachments,
+		}, data)
+	})
+
+	t.Run("erased_user_without_data", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetUser(gomock.Any(), userUUID).Return(nil, model.NotFoundError("user doesn't exist"))
+		m.db.EXPECT().ExportMaterials(gomock.Any(), gomock.Any(), exportBatchSize, gomock.Any()).DoAndReturn(exportBatches())
+		m.db.EXPECT().GetReactionsByUser(gomock.Any(), userUUID).Return(nil, nil)
+		m.db.EXPECT().GetReportsByReporter(gomock.Any(), userUUID).Return(nil, nil)
+		m.db.EXPECT().GetAttachmentsByOwner(gomock.Any(), userUUID).Return(nil, nil)
+
+		_, err := uc.ExportUserData(privacyCtx, userUUID)
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("privacy_role_required", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		// пользователь не выгружает даже собственный архив: выгрузку делает служба по запросу
+		_, err := uc.ExportUserData(identityContext(userUUID, moderatorRole), userUUID)
+
+		assert.ErrorIs(t, err, model.ErrForbidden)
+	})
+
+	t.Run("invalid_uuid", func(t *testing.T) {
+		t.Parallel()
+		uc, _ := newUseCase(t)
+
+		_, err := uc.ExportUserData(privacyCtx, "not-a-uuid")
+
+		assert.ErrorIs(t, err, model.ErrValidation)
+	})
+
+	t.Run("repository_error", func(t *testing.T) {
+		t.Parallel()
+		uc, m := newUseCase(t)
+
+		m.db.EXPECT().GetUser(gomock.Any(), userUUID).Return(nil, errors.New("connection reset"))
+
+		_, err := uc.ExportUserData(privacyCtx, userUUID)
+
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, model.ErrNotFound)
+	})
+}
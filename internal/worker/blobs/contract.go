@@ -0,0 +1,16 @@
+//go:generate mockgen -destination=mock_contract_test.go -package=${GOPACKAGE} -source=contract.go
+package blobs
+
+import (
+	"context"
+)
+
+type DBRepo interface {
+	GetBlobDeletions(ctx context.Context, limit int) ([]string, error)
+	DeleteBlobDeletions(ctx context.Context, keys []string) error
+	WithTx(ctx context.Context, cb func(ctx context.Context) error) (err error)
+}
+
+type Storage interface {
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,92 @@
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	logger_lib "github.com/s21platform/logger-lib"
+
+	"github.com/s21platform/materials-service/internal/config"
+)
+
+type Worker struct {
+	repository DBRepo
+	storage    Storage
+	interval   time.Duration
+	batchSize  int
+}
+
+func New(repo DBRepo, storage Storage, cfg *config.Config) *Worker {
+	return &Worker{
+		repository: repo,
+		storage:    storage,
+		interval:   cfg.Blob.GCInterval,
+		batchSize:  cfg.Blob.GCBatchSize,
+	}
+}
+
+// Run удаляет из хранилища файлы из очереди blob_deletions, пока не будет отменён ctx
+func (w *Worker) Run(ctx context.Context) {
+	ctx = logger_lib.WithField(ctx, "func_name", "BlobsGCWorker")
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			deleted, err := w.deleteBatch(ctx)
+			if err != nil {
+				logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to delete queued blobs: %v", err))
+				break
+			}
+			if deleted < w.batchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Worker) deleteBatch(ctx context.Context) (int, error) {
+	var deleted int
+
+	err := w.repository.WithTx(ctx, func(ctx context.Context) error {
+		keys, err := w.repository.GetBlobDeletions(ctx, w.batchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(keys) == 0 {
+			return nil
+		}
+
+		// файлы удаляются до коммита: при ошибке ключи останутся в очереди до следующего прохода
+		for _, key := range keys {
+			if err = w.storage.Delete(ctx, key); err != nil {
+				return err
+			}
+		}
+
+		if err = w.repository.DeleteBlobDeletions(ctx, keys); err != nil {
+			return err
+		}
+
+		deleted = len(keys)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if deleted > 0 {
+		logger_lib.Info(ctx, fmt.Sprintf("deleted %d queued blobs", deleted))
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,97 @@
+package blobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWorker(ctrl *gomock.Controller, batchSize int) (*Worker, *MockDBRepo, *MockStorage) {
+	repo := NewMockDBRepo(ctrl)
+	storage := NewMockStorage(ctrl)
+
+	repo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+		return cb(ctx)
+	}).AnyTimes()
+
+	return &Worker{repository: repo, storage: storage, batchSize: batchSize}, repo, storage
+}
+
+func TestWorker_DeleteBatch(t *testing.T) {
+	t.Parallel()
+
+	keys := []string{"attachments/m1/a.png", "attachments/m1/b.pdf"}
+
+	t.Run("deletes_files_then_queue_rows", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		worker, repo, storage := newTestWorker(ctrl, 10)
+		gomock.InOrder(
+			repo.EXPECT().GetBlobDeletions(gomock.Any(), 10).Return(keys, nil),
+			storage.EXPECT().Delete(gomock.Any(), keys[0]).Return(nil),
+			storage.EXPECT().Delete(gomock.Any(), keys[1]).Return(nil),
+			repo.EXPECT().DeleteBlobDeletions(gomock.Any(), keys).Return(nil),
+		)
+
+		deleted, err := worker.deleteBatch(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, deleted)
+	})
+
+	t.Run("empty_queue", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		worker, repo, _ := newTestWorker(ctrl, 10)
+		repo.EXPECT().GetBlobDeletions(gomock.Any(), 10).Return(nil, nil)
+
+		deleted, err := worker.deleteBatch(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, deleted)
+	})
+
+	t.Run("storage_error_keeps_queue", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		worker, repo, storage := newTestWorker(ctrl, 10)
+		repo.EXPECT().GetBlobDeletions(gomock.Any(), 10).Return(keys, nil)
+		storage.EXPECT().Delete(gomock.Any(), keys[0]).Return(assert.AnError)
+
+		deleted, err := worker.deleteBatch(context.Background())
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Zero(t, deleted)
+	})
+}
+
+func TestWorker_Run(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	worker, repo, storage := newTestWorker(ctrl, 2)
+	worker.interval = 1 << 62
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// полная пачка означает, что в очереди может быть ещё, поэтому проход повторяется до неполной
+	gomock.InOrder(
+		repo.EXPECT().GetBlobDeletions(gomock.Any(), 2).Return([]string{"a", "b"}, nil),
+		repo.EXPECT().DeleteBlobDeletions(gomock.Any(), []string{"a", "b"}).Return(nil),
+		repo.EXPECT().GetBlobDeletions(gomock.Any(), 2).Return([]string{"c"}, nil),
+		repo.EXPECT().DeleteBlobDeletions(gomock.Any(), []string{"c"}).DoAndReturn(func(context.Context, []string) error {
+			cancel()
+			return nil
+		}),
+	)
+	storage.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+
+	worker.Run(ctx)
+}
@@ -0,0 +1,18 @@
+package covers
+
+import (
+	"context"
+	"time"
+
+	"github.com/s21platform/materials-service/internal/model"
+)
+
+type DBRepo interface {
+	GetUnreferencedCoverUploads(ctx context.Context, createdBefore time.Time, limit int) ([]model.CoverUpload, error)
+	DeleteCoverUploads(ctx context.Context, uuids []string) error
+	WithTx(ctx context.Context, cb func(ctx context.Context) error) (err error)
+}
+
+type Storage interface {
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,99 @@
+package covers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	logger_lib "github.com/s21platform/logger-lib"
+
+	"github.com/s21platform/materials-service/internal/config"
+)
+
+type Worker struct {
+	repository  DBRepo
+	storage     Storage
+	gracePeriod time.Duration
+	interval    time.Duration
+	batchSize   int
+}
+
+func New(repo DBRepo, storage Storage, cfg *config.Config) *Worker {
+	return &Worker{
+		repository:  repo,
+		storage:     storage,
+		gracePeriod: cfg.Covers.GCGracePeriod,
+		interval:    cfg.Covers.GCInterval,
+		batchSize:   cfg.Covers.GCBatchSize,
+	}
+}
+
+// Run удаляет загруженные обложки, на которые не ссылается ни один материал, пока не будет отменён ctx.
+// Загрузки моложе grace period не трогаются, так как они могут ещё не быть записаны в материал.
+func (w *Worker) Run(ctx context.Context) {
+	ctx = logger_lib.WithField(ctx, "func_name", "CoversGCWorker")
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			collected, err := w.collectBatch(ctx)
+			if err != nil {
+				logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to collect unreferenced covers: %v", err))
+				break
+			}
+			if collected < w.batchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Worker) collectBatch(ctx context.Context) (int, error) {
+	var collected int
+
+	err := w.repository.WithTx(ctx, func(ctx context.Context) error {
+		uploads, err := w.repository.GetUnreferencedCoverUploads(ctx, time.Now().Add(-w.gracePeriod), w.batchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(uploads) == 0 {
+			return nil
+		}
+
+		// файлы удаляются до коммита: при ошибке записи останутся и будут удалены в следующий раз
+		uuids := make([]string, 0, len(uploads))
+		for _, upload := range uploads {
+			for _, key := range upload.StorageKeys {
+				if err = w.storage.Delete(ctx, key); err != nil {
+					return err
+				}
+			}
+			uuids = append(uuids, upload.UUID)
+		}
+
+		if err = w.repository.DeleteCoverUploads(ctx, uuids); err != nil {
+			return err
+		}
+
+		collected = len(uuids)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if collected > 0 {
+		logger_lib.Info(ctx, fmt.Sprintf("collected %d unreferenced cover uploads", collected))
+	}
+
+	return collected, nil
+}
@@ -0,0 +1,16 @@
+//go:generate mockgen -destination=mock_contract_test.go -package=${GOPACKAGE} -source=contract.go
+package likes
+
+import (
+	"context"
+)
+
+type DBRepo interface {
+	LockMaterialsForReconcile(ctx context.Context, afterUUID string, limit int) ([]string, error)
+	ReconcileLikesCounts(ctx context.Context, uuids []string) ([]string, error)
+	WithTx(ctx context.Context, cb func(ctx context.Context) error) (err error)
+}
+
+type RedisRepo interface {
+	DeleteMaterials(ctx context.Context, uuids []string) error
+}
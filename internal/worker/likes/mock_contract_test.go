@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+
+// Package likes is a generated GoMock package.
+package likes
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDBRepo is a mock of DBRepo interface.
+type MockDBRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockDBRepoMockRecorder
+}
+
+// MockDBRepoMockRecorder is the mock recorder for MockDBRepo.
+type MockDBRepoMockRecorder struct {
+	mock *MockDBRepo
+}
+
+// NewMockDBRepo creates a new mock instance.
+func NewMockDBRepo(ctrl *gomock.Controller) *MockDBRepo {
+	mock := &MockDBRepo{ctrl: ctrl}
+	mock.recorder = &MockDBRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDBRepo) EXPECT() *MockDBRepoMockRecorder {
+	return m.recorder
+}
+
+// LockMaterialsForReconcile mocks base method.
+func (m *MockDBRepo) LockMaterialsForReconcile(ctx context.Context, afterUUID string, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockMaterialsForReconcile", ctx, afterUUID, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockMaterialsForReconcile indicates an expected call of LockMaterialsForReconcile.
+func (mr *MockDBRepoMockRecorder) LockMaterialsForReconcile(ctx, afterUUID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockMaterialsForReconcile", reflect.TypeOf((*MockDBRepo)(nil).LockMaterialsForReconcile), ctx, afterUUID, limit)
+}
+
+// ReconcileLikesCounts mocks base method.
+func (m *MockDBRepo) ReconcileLikesCounts(ctx context.Context, uuids []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileLikesCounts", ctx, uuids)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileLikesCounts indicates an expected call of ReconcileLikesCounts.
+func (mr *MockDBRepoMockRecorder) ReconcileLikesCounts(ctx, uuids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileLikesCounts", reflect.TypeOf((*MockDBRepo)(nil).ReconcileLikesCounts), ctx, uuids)
+}
+
+// WithTx mocks base method.
+func (m *MockDBRepo) WithTx(ctx context.Context, cb func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", ctx, cb)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockDBRepoMockRecorder) WithTx(ctx, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockDBRepo)(nil).WithTx), ctx, cb)
+}
+
+// MockRedisRepo is a mock of RedisRepo interface.
+type MockRedisRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockRedisRepoMockRecorder
+}
+
+// MockRedisRepoMockRecorder is the mock recorder for MockRedisRepo.
+type MockRedisRepoMockRecorder struct {
+	mock *MockRedisRepo
+}
+
+// NewMockRedisRepo creates a new mock instance.
+func NewMockRedisRepo(ctrl *gomock.Controller) *MockRedisRepo {
+	mock := &MockRedisRepo{ctrl: ctrl}
+	mock.recorder = &MockRedisRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRedisRepo) EXPECT() *MockRedisRepoMockRecorder {
+	return m.recorder
+}
+
+// DeleteMaterials mocks base method.
+func (m *MockRedisRepo) DeleteMaterials(ctx context.Context, uuids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMaterials", ctx, uuids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMaterials indicates an expected call of DeleteMaterials.
+func (mr *MockRedisRepoMockRecorder) DeleteMaterials(ctx, uuids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMaterials", reflect.TypeOf((*MockRedisRepo)(nil).DeleteMaterials), ctx, uuids)
+}
@@ -0,0 +1,108 @@
+package likes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	logger_lib "github.com/s21platform/logger-lib"
+
+	"github.com/s21platform/materials-service/internal/config"
+)
+
+const firstUUID = "00000000-0000-0000-0000-000000000000"
+
+type Worker struct {
+	repository DBRepo
+	redis      RedisRepo
+	interval   time.Duration
+	batchSize  int
+}
+
+func New(repo DBRepo, redis RedisRepo, cfg *config.Config) *Worker {
+	return &Worker{
+		repository: repo,
+		redis:      redis,
+		interval:   cfg.Likes.ReconcileInterval,
+		batchSize:  cfg.Likes.ReconcileBatchSize,
+	}
+}
+
+// Run периодически сверяет likes_count и reaction_counts с material_reactions и исправляет расхождения,
+// пока не будет отменён ctx.
+func (w *Worker) Run(ctx context.Context) {
+	ctx = logger_lib.WithField(ctx, "func_name", "LikesReconcileWorker")
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.reconcile(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Worker) reconcile(ctx context.Context) {
+	var total int
+
+	after := firstUUID
+	for ctx.Err() == nil {
+		last, fixed, err := w.reconcileBatch(ctx, after)
+		if err != nil {
+			logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to reconcile likes counts: %v", err))
+			return
+		}
+		total += fixed
+
+		if last == "" {
+			break
+		}
+		after = last
+	}
+
+	if total > 0 {
+		logger_lib.Info(ctx, fmt.Sprintf("reconciled likes count of %d materials", total))
+	}
+}
+
+// reconcileBatch блокирует пачку материалов и пересчитывает их счётчики в отдельном запросе той же транзакции:
+// так лайки, закоммиченные до блокировки, попадают в подсчёт, а остальные ждут и применяют ±1 поверх.
+func (w *Worker) reconcileBatch(ctx context.Context, after string) (string, int, error) {
+	var locked, fixed []string
+
+	err := w.repository.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		locked, err = w.repository.LockMaterialsForReconcile(ctx, after, w.batchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(locked) == 0 {
+			return nil
+		}
+
+		fixed, err = w.repository.ReconcileLikesCounts(ctx, locked)
+		return err
+	})
+	if err != nil {
+		return "", 0, err
+	}
+
+	if len(fixed) > 0 {
+		if err := w.redis.DeleteMaterials(ctx, fixed); err != nil {
+			logger_lib.Error(logger_lib.WithError(ctx, err), "failed to invalidate reconciled materials cache")
+		}
+	}
+
+	// SKIP LOCKED может вернуть неполную пачку посреди таблицы, поэтому конец — только пустая пачка
+	if len(locked) == 0 {
+		return "", 0, nil
+	}
+
+	return locked[len(locked)-1], len(fixed), nil
+}
@@ -0,0 +1,70 @@
+package likes
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+func newTestWorker(ctrl *gomock.Controller, batchSize int) (*Worker, *MockDBRepo, *MockRedisRepo) {
+	repo := NewMockDBRepo(ctrl)
+	redis := NewMockRedisRepo(ctrl)
+
+	repo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+		return cb(ctx)
+	}).AnyTimes()
+
+	return &Worker{repository: repo, redis: redis, batchSize: batchSize}, repo, redis
+}
+
+func TestWorker_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("short_batch_does_not_stop_scan", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		worker, repo, redis := newTestWorker(ctrl, 3)
+		gomock.InOrder(
+			// m2 заблокирован другой транзакцией и пропущен, пачка неполная
+			repo.EXPECT().LockMaterialsForReconcile(gomock.Any(), firstUUID, 3).Return([]string{"m1", "m3"}, nil),
+			repo.EXPECT().ReconcileLikesCounts(gomock.Any(), []string{"m1", "m3"}).Return([]string{"m3"}, nil),
+			redis.EXPECT().DeleteMaterials(gomock.Any(), []string{"m3"}).Return(nil),
+			repo.EXPECT().LockMaterialsForReconcile(gomock.Any(), "m3", 3).Return([]string{"m4", "m5", "m6"}, nil),
+			repo.EXPECT().ReconcileLikesCounts(gomock.Any(), []string{"m4", "m5", "m6"}).Return(nil, nil),
+			repo.EXPECT().LockMaterialsForReconcile(gomock.Any(), "m6", 3).Return(nil, nil),
+		)
+
+		worker.reconcile(context.Background())
+	})
+
+	t.Run("cache_error_does_not_stop_scan", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		worker, repo, redis := newTestWorker(ctrl, 2)
+		gomock.InOrder(
+			repo.EXPECT().LockMaterialsForReconcile(gomock.Any(), firstUUID, 2).Return([]string{"m1"}, nil),
+			repo.EXPECT().ReconcileLikesCounts(gomock.Any(), []string{"m1"}).Return([]string{"m1"}, nil),
+			redis.EXPECT().DeleteMaterials(gomock.Any(), []string{"m1"}).Return(errors.New("redis down")),
+			repo.EXPECT().LockMaterialsForReconcile(gomock.Any(), "m1", 2).Return(nil, nil),
+		)
+
+		worker.reconcile(context.Background())
+	})
+
+	t.Run("db_error_stops_scan", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		worker, repo, _ := newTestWorker(ctrl, 2)
+		repo.EXPECT().LockMaterialsForReconcile(gomock.Any(), firstUUID, 2).Return(nil, errors.New("db down"))
+
+		worker.reconcile(context.Background())
+	})
+}
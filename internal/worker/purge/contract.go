@@ -0,0 +1,20 @@
+//go:generate mockgen -destination=mock_contract_test.go -package=${GOPACKAGE} -source=contract.go
+package purge
+
+import (
+	"context"
+	"time"
+)
+
+type DBRepo interface {
+	GetExpiredDeletedMaterials(ctx context.Context, deletedBefore time.Time, limit int) ([]string, error)
+	GetAttachmentStorageKeys(ctx context.Context, materialUUIDs []string) ([]string, error)
+	PurgeMaterials(ctx context.Context, uuids []string) error
+	EnqueueBlobDeletions(ctx context.Context, keys []string) error
+	WithTx(ctx context.Context, cb func(ctx context.Context) error) (err error)
+}
+
+type RedisRepo interface {
+	DeleteMaterial(ctx context.Context, uuid string) error
+	DeleteAutosave(ctx context.Context, materialUUID string) error
+}
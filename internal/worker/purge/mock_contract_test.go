@@ -0,0 +1,159 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+
+// Package purge is a generated GoMock package.
+package purge
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDBRepo is a mock of DBRepo interface.
+type MockDBRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockDBRepoMockRecorder
+}
+
+// MockDBRepoMockRecorder is the mock recorder for MockDBRepo.
+type MockDBRepoMockRecorder struct {
+	mock *MockDBRepo
+}
+
+// NewMockDBRepo creates a new mock instance.
+func NewMockDBRepo(ctrl *gomock.Controller) *MockDBRepo {
+	mock := &MockDBRepo{ctrl: ctrl}
+	mock.recorder = &MockDBRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDBRepo) EXPECT() *MockDBRepoMockRecorder {
+	return m.recorder
+}
+
+// EnqueueBlobDeletions mocks base method.
+func (m *MockDBRepo) EnqueueBlobDeletions(ctx context.Context, keys []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueBlobDeletions", ctx, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueBlobDeletions indicates an expected call of EnqueueBlobDeletions.
+func (mr *MockDBRepoMockRecorder) EnqueueBlobDeletions(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueBlobDeletions", reflect.TypeOf((*MockDBRepo)(nil).EnqueueBlobDeletions), ctx, keys)
+}
+
+// GetAttachmentStorageKeys mocks base method.
+func (m *MockDBRepo) GetAttachmentStorageKeys(ctx context.Context, materialUUIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentStorageKeys", ctx, materialUUIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentStorageKeys indicates an expected call of GetAttachmentStorageKeys.
+func (mr *MockDBRepoMockRecorder) GetAttachmentStorageKeys(ctx, materialUUIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentStorageKeys", reflect.TypeOf((*MockDBRepo)(nil).GetAttachmentStorageKeys), ctx, materialUUIDs)
+}
+
+// GetExpiredDeletedMaterials mocks base method.
+func (m *MockDBRepo) GetExpiredDeletedMaterials(ctx context.Context, deletedBefore time.Time, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredDeletedMaterials", ctx, deletedBefore, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredDeletedMaterials indicates an expected call of GetExpiredDeletedMaterials.
+func (mr *MockDBRepoMockRecorder) GetExpiredDeletedMaterials(ctx, deletedBefore, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredDeletedMaterials", reflect.TypeOf((*MockDBRepo)(nil).GetExpiredDeletedMaterials), ctx, deletedBefore, limit)
+}
+
+// PurgeMaterials mocks base method.
+func (m *MockDBRepo) PurgeMaterials(ctx context.Context, uuids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeMaterials", ctx, uuids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeMaterials indicates an expected call of PurgeMaterials.
+func (mr *MockDBRepoMockRecorder) PurgeMaterials(ctx, uuids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeMaterials", reflect.TypeOf((*MockDBRepo)(nil).PurgeMaterials), ctx, uuids)
+}
+
+// WithTx mocks base method.
+func (m *MockDBRepo) WithTx(ctx context.Context, cb func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", ctx, cb)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockDBRepoMockRecorder) WithTx(ctx, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockDBRepo)(nil).WithTx), ctx, cb)
+}
+
+// MockRedisRepo is a mock of RedisRepo interface.
+type MockRedisRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockRedisRepoMockRecorder
+}
+
+// MockRedisRepoMockRecorder is the mock recorder for MockRedisRepo.
+type MockRedisRepoMockRecorder struct {
+	mock *MockRedisRepo
+}
+
+// NewMockRedisRepo creates a new mock instance.
+func NewMockRedisRepo(ctrl *gomock.Controller) *MockRedisRepo {
+	mock := &MockRedisRepo{ctrl: ctrl}
+	mock.recorder = &MockRedisRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRedisRepo) EXPECT() *MockRedisRepoMockRecorder {
+	return m.recorder
+}
+
+// DeleteAutosave mocks base method.
+func (m *MockRedisRepo) DeleteAutosave(ctx context.Context, materialUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAutosave", ctx, materialUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAutosave indicates an expected call of DeleteAutosave.
+func (mr *MockRedisRepoMockRecorder) DeleteAutosave(ctx, materialUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutosave", reflect.TypeOf((*MockRedisRepo)(nil).DeleteAutosave), ctx, materialUUID)
+}
+
+// DeleteMaterial mocks base method.
+func (m *MockRedisRepo) DeleteMaterial(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMaterial", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMaterial indicates an expected call of DeleteMaterial.
+func (mr *MockRedisRepoMockRecorder) DeleteMaterial(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMaterial", reflect.TypeOf((*MockRedisRepo)(nil).DeleteMaterial), ctx, uuid)
+}
@@ -0,0 +1,102 @@
+package purge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	logger_lib "github.com/s21platform/logger-lib"
+
+	"github.com/s21platform/materials-service/internal/config"
+)
+
+type Worker struct {
+	repository DBRepo
+	redis      RedisRepo
+	retention  time.Duration
+	interval   time.Duration
+	batchSize  int
+}
+
+func New(repo DBRepo, redis RedisRepo, cfg *config.Config) *Worker {
+	return &Worker{
+		repository: repo,
+		redis:      redis,
+		retention:  cfg.Trash.RetentionPeriod,
+		interval:   cfg.Trash.PurgeInterval,
+		batchSize:  cfg.Trash.PurgeBatchSize,
+	}
+}
+
+// Run окончательно удаляет материалы, пролежавшие в корзине дольше срока хранения,
+// пока не будет отменён ctx.
+func (w *Worker) Run(ctx context.Context) {
+	ctx = logger_lib.WithField(ctx, "func_name", "PurgeWorker")
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			purged, err := w.purgeBatch(ctx)
+			if err != nil {
+				logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to purge deleted materials: %v", err))
+				break
+			}
+			if purged < w.batchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Worker) purgeBatch(ctx context.Context) (int, error) {
+	var uuids []string
+
+	err := w.repository.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		uuids, err = w.repository.GetExpiredDeletedMaterials(ctx, time.Now().Add(-w.retention), w.batchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(uuids) == 0 {
+			return nil
+		}
+
+		// файлы удаляет воркер очереди blob_deletions с повторами, а не этот воркер после коммита
+		attachmentKeys, err := w.repository.GetAttachmentStorageKeys(ctx, uuids)
+		if err != nil {
+			return err
+		}
+		if err = w.repository.EnqueueBlobDeletions(ctx, attachmentKeys); err != nil {
+			return err
+		}
+
+		return w.repository.PurgeMaterials(ctx, uuids)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, uuid := range uuids {
+		if err := w.redis.DeleteMaterial(ctx, uuid); err != nil {
+			logger_lib.Error(logger_lib.WithError(ctx, err), "failed to invalidate purged material cache")
+		}
+		if err := w.redis.DeleteAutosave(ctx, uuid); err != nil {
+			logger_lib.Error(logger_lib.WithError(ctx, err), "failed to delete purged material autosave")
+		}
+	}
+
+	if len(uuids) > 0 {
+		logger_lib.Info(ctx, fmt.Sprintf("purged %d deleted materials", len(uuids)))
+	}
+
+	return len(uuids), nil
+}
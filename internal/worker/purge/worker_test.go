@@ -0,0 +1,79 @@
+package purge
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWorker(ctrl *gomock.Controller) (*Worker, *MockDBRepo, *MockRedisRepo) {
+	repo := NewMockDBRepo(ctrl)
+	redis := NewMockRedisRepo(ctrl)
+
+	repo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+		return cb(ctx)
+	}).AnyTimes()
+
+	return &Worker{repository: repo, redis: redis, retention: 30 * 24 * time.Hour, batchSize: 10}, repo, redis
+}
+
+func TestWorker_PurgeBatch(t *testing.T) {
+	t.Parallel()
+
+	uuids := []string{"m1", "m2"}
+	keys := []string{"attachments/m1/a.png", "attachments/m2/b.pdf"}
+
+	t.Run("queues_attachments_and_purges", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		worker, repo, redis := newTestWorker(ctrl)
+		gomock.InOrder(
+			repo.EXPECT().GetExpiredDeletedMaterials(gomock.Any(), gomock.Any(), 10).Return(uuids, nil),
+			repo.EXPECT().GetAttachmentStorageKeys(gomock.Any(), uuids).Return(keys, nil),
+			repo.EXPECT().EnqueueBlobDeletions(gomock.Any(), keys).Return(nil),
+			repo.EXPECT().PurgeMaterials(gomock.Any(), uuids).Return(nil),
+		)
+		for _, uuid := range uuids {
+			redis.EXPECT().DeleteMaterial(gomock.Any(), uuid).Return(nil)
+			redis.EXPECT().DeleteAutosave(gomock.Any(), uuid).Return(nil)
+		}
+
+		purged, err := worker.purgeBatch(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, purged)
+	})
+
+	t.Run("enqueue_error_keeps_materials", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		worker, repo, _ := newTestWorker(ctrl)
+		repo.EXPECT().GetExpiredDeletedMaterials(gomock.Any(), gomock.Any(), 10).Return(uuids, nil)
+		repo.EXPECT().GetAttachmentStorageKeys(gomock.Any(), uuids).Return(keys, nil)
+		repo.EXPECT().EnqueueBlobDeletions(gomock.Any(), keys).Return(errors.New("db down"))
+
+		_, err := worker.purgeBatch(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("nothing_expired", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		worker, repo, _ := newTestWorker(ctrl)
+		repo.EXPECT().GetExpiredDeletedMaterials(gomock.Any(), gomock.Any(), 10).Return(nil, nil)
+
+		purged, err := worker.purgeBatch(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, purged)
+	})
+}
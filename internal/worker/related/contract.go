@@ -0,0 +1,16 @@
+//go:generate mockgen -destination=mock_contract_test.go -package=${GOPACKAGE} -source=contract.go
+package related
+
+import (
+	"context"
+
+	"github.com/s21platform/materials-service/internal/model"
+)
+
+type DBRepo interface {
+	GetRelatedCandidates(ctx context.Context) ([]model.RelatedCandidate, error)
+	GetLikeCooccurrences(ctx context.Context, limit int) ([]model.LikeCooccurrence, error)
+	GetMaterialsTags(ctx context.Context, uuids []string) ([]model.MaterialTag, error)
+	ReplaceRelatedMaterials(ctx context.Context, related []model.RelatedMaterial) error
+	WithTx(ctx context.Context, cb func(ctx context.Context) error) (err error)
+}
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+
+// Package related is a generated GoMock package.
+package related
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/s21platform/materials-service/internal/model"
+)
+
+// MockDBRepo is a mock of DBRepo interface.
+type MockDBRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockDBRepoMockRecorder
+}
+
+// MockDBRepoMockRecorder is the mock recorder for MockDBRepo.
+type MockDBRepoMockRecorder struct {
+	mock *MockDBRepo
+}
+
+// NewMockDBRepo creates a new mock instance.
+func NewMockDBRepo(ctrl *gomock.Controller) *MockDBRepo {
+	mock := &MockDBRepo{ctrl: ctrl}
+	mock.recorder = &MockDBRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDBRepo) EXPECT() *MockDBRepoMockRecorder {
+	return m.recorder
+}
+
+// GetLikeCooccurrences mocks base method.
+func (m *MockDBRepo) GetLikeCooccurrences(ctx context.Context, limit int) ([]model.LikeCooccurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLikeCooccurrences", ctx, limit)
+	ret0, _ := ret[0].([]model.LikeCooccurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLikeCooccurrences indicates an expected call of GetLikeCooccurrences.
+func (mr *MockDBRepoMockRecorder) GetLikeCooccurrences(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikeCooccurrences", reflect.TypeOf((*MockDBRepo)(nil).GetLikeCooccurrences), ctx, limit)
+}
+
+// GetMaterialsTags mocks base method.
+func (m *MockDBRepo) GetMaterialsTags(ctx context.Context, uuids []string) ([]model.MaterialTag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaterialsTags", ctx, uuids)
+	ret0, _ := ret[0].([]model.MaterialTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaterialsTags indicates an expected call of GetMaterialsTags.
+func (mr *MockDBRepoMockRecorder) GetMaterialsTags(ctx, uuids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaterialsTags", reflect.TypeOf((*MockDBRepo)(nil).GetMaterialsTags), ctx, uuids)
+}
+
+// GetRelatedCandidates mocks base method.
+func (m *MockDBRepo) GetRelatedCandidates(ctx context.Context) ([]model.RelatedCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelatedCandidates", ctx)
+	ret0, _ := ret[0].([]model.RelatedCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelatedCandidates indicates an expected call of GetRelatedCandidates.
+func (mr *MockDBRepoMockRecorder) GetRelatedCandidates(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelatedCandidates", reflect.TypeOf((*MockDBRepo)(nil).GetRelatedCandidates), ctx)
+}
+
+// ReplaceRelatedMaterials mocks base method.
+func (m *MockDBRepo) ReplaceRelatedMaterials(ctx context.Context, related []model.RelatedMaterial) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceRelatedMaterials", ctx, related)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceRelatedMaterials indicates an expected call of ReplaceRelatedMaterials.
+func (mr *MockDBRepoMockRecorder) ReplaceRelatedMaterials(ctx, related interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceRelatedMaterials", reflect.TypeOf((*MockDBRepo)(nil).ReplaceRelatedMaterials), ctx, related)
+}
+
+// WithTx mocks base method.
+func (m *MockDBRepo) WithTx(ctx context.Context, cb func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", ctx, cb)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockDBRepoMockRecorder) WithTx(ctx, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockDBRepo)(nil).WithTx), ctx, cb)
+}
@@ -0,0 +1,77 @@
+package related
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	minTokenLength = 3
+	// термины из более чем 1/commonTermShare материалов (и не менее commonTermMinDocs) не используются для поиска кандидатов
+	commonTermShare   = 10
+	commonTermMinDocs = 50
+)
+
+type document struct {
+	uuid      string
+	likes     int32
+	tags      map[string]struct{}
+	tokens    map[string]struct{}
+	likedWith map[string]int32
+}
+
+// index — обратный индекс термин → материалы для отбора кандидатов без перебора всех пар
+type index map[string][]string
+
+func buildIndex(docs []*document, terms func(doc *document) map[string]struct{}) index {
+	idx := make(index)
+	for _, doc := range docs {
+		for term := range terms(doc) {
+			idx[term] = append(idx[term], doc.uuid)
+		}
+	}
+
+	limit := max(commonTermMinDocs, len(docs)/commonTermShare)
+	for term, uuids := range idx {
+		if len(uuids) > limit {
+			delete(idx, term)
+		}
+	}
+
+	return idx
+}
+
+func (idx index) collect(terms map[string]struct{}, into map[string]struct{}) {
+	for term := range terms {
+		for _, uuid := range idx[term] {
+			into[uuid] = struct{}{}
+		}
+	}
+}
+
+func tokenize(text string) map[string]struct{} {
+	tokens := make(map[string]struct{})
+	for _, token := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if len([]rune(token)) >= minTokenLength {
+			tokens[token] = struct{}{}
+		}
+	}
+	return tokens
+}
+
+func jaccard(a, b map[string]struct{}) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	var intersection int
+	for term := range a {
+		if _, ok := b[term]; ok {
+			intersection++
+		}
+	}
+
+	return float64(intersection) / float64(len(a)+len(b)-intersection)
+}
@@ -0,0 +1,185 @@
+package related
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	logger_lib "github.com/s21platform/logger-lib"
+
+	"github.com/s21platform/materials-service/internal/config"
+	"github.com/s21platform/materials-service/internal/model"
+)
+
+// ограничение на размер IN-списка при загрузке тегов
+const tagsBatchSize = 1000
+
+type Worker struct {
+	repository        DBRepo
+	interval          time.Duration
+	size              int
+	cooccurrenceLimit int
+	coldStartLikes    int
+	tagWeight         float64
+	textWeight        float64
+}
+
+func New(repo DBRepo, cfg *config.Config) *Worker {
+	return &Worker{
+		repository:        repo,
+		interval:          cfg.Related.RefreshInterval,
+		size:              cfg.Related.Size,
+		cooccurrenceLimit: cfg.Related.CooccurrenceLimit,
+		coldStartLikes:    cfg.Related.ColdStartLikes,
+		tagWeight:         cfg.Related.TagWeight,
+		textWeight:        cfg.Related.TextWeight,
+	}
+}
+
+// Run периодически пересчитывает похожие материалы и сохраняет их в related_materials,
+// пока не будет отменён ctx.
+func (w *Worker) Run(ctx context.Context) {
+	ctx = logger_lib.WithField(ctx, "func_name", "RelatedWorker")
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.refresh(ctx); err != nil {
+			logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to refresh related materials: %v", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Worker) refresh(ctx context.Context) error {
+	candidates, err := w.repository.GetRelatedCandidates(ctx)
+	if err != nil {
+		return err
+	}
+
+	cooccurrences, err := w.repository.GetLikeCooccurrences(ctx, w.cooccurrenceLimit)
+	if err != nil {
+		return err
+	}
+
+	docs := make([]*document, 0, len(candidates))
+	byUUID := make(map[string]*document, len(candidates))
+	for _, candidate := range candidates {
+		doc := &document{
+			uuid:      candidate.UUID,
+			likes:     candidate.LikesCount,
+			tags:      make(map[string]struct{}),
+			tokens:    tokenize(candidate.Title + " " + candidate.Description),
+			likedWith: make(map[string]int32),
+		}
+		docs = append(docs, doc)
+		byUUID[doc.uuid] = doc
+	}
+
+	for start := 0; start < len(docs); start += tagsBatchSize {
+		end := min(start+tagsBatchSize, len(docs))
+		uuids := make([]string, 0, end-start)
+		for _, doc := range docs[start:end] {
+			uuids = append(uuids, doc.uuid)
+		}
+
+		tags, err := w.repository.GetMaterialsTags(ctx, uuids)
+		if err != nil {
+			return err
+		}
+		for _, item := range tags {
+			byUUID[item.MaterialUUID].tags[item.Tag] = struct{}{}
+		}
+	}
+
+	for _, pair := range cooccurrences {
+		if doc, ok := byUUID[pair.MaterialUUID]; ok {
+			if _, ok = byUUID[pair.RelatedUUID]; ok {
+				doc.likedWith[pair.RelatedUUID] = pair.Count
+			}
+		}
+	}
+
+	related := w.rank(docs, byUUID)
+
+	err = w.repository.WithTx(ctx, func(ctx context.Context) error {
+		return w.repository.ReplaceRelatedMaterials(ctx, related)
+	})
+	if err != nil {
+		return err
+	}
+
+	logger_lib.Info(ctx, fmt.Sprintf("computed %d related pairs for %d materials", len(related), len(docs)))
+	return nil
+}
+
+// rank оставляет для каждого материала size самых похожих. Сходство по лайкам — косинусная мера
+// co/√(likesA·likesB); для материалов с малым числом лайков она смешивается со сходством тегов и текста
+// с весом, убывающим по мере набора лайков: confidence = likes/(likes+coldStartLikes).
+func (w *Worker) rank(docs []*document, byUUID map[string]*document) []model.RelatedMaterial {
+	tagIndex := buildIndex(docs, func(doc *document) map[string]struct{} { return doc.tags })
+	tokenIndex := buildIndex(docs, func(doc *document) map[string]struct{} { return doc.tokens })
+
+	var related []model.RelatedMaterial
+	for _, doc := range docs {
+		neighbours := make(map[string]struct{}, len(doc.likedWith))
+		for uuid := range doc.likedWith {
+			neighbours[uuid] = struct{}{}
+		}
+		tagIndex.collect(doc.tags, neighbours)
+		tokenIndex.collect(doc.tokens, neighbours)
+		delete(neighbours, doc.uuid)
+
+		confidence := 1.0
+		if w.coldStartLikes > 0 {
+			confidence = float64(doc.likes) / float64(doc.likes+int32(w.coldStartLikes))
+		}
+
+		scored := make([]model.RelatedMaterial, 0, len(neighbours))
+		for uuid := range neighbours {
+			other := byUUID[uuid]
+			score := confidence*likeSimilarity(doc, other) + (1-confidence)*w.contentSimilarity(doc, other)
+			if score > 0 {
+				scored = append(scored, model.RelatedMaterial{MaterialUUID: doc.uuid, RelatedUUID: uuid, Score: score})
+			}
+		}
+
+		sort.Slice(scored, func(i, j int) bool {
+			if scored[i].Score != scored[j].Score {
+				return scored[i].Score > scored[j].Score
+			}
+			return scored[i].RelatedUUID < scored[j].RelatedUUID
+		})
+		if len(scored) > w.size {
+			scored = scored[:w.size]
+		}
+
+		related = append(related, scored...)
+	}
+
+	return related
+}
+
+func likeSimilarity(a, b *document) float64 {
+	count := a.likedWith[b.uuid]
+	if count == 0 || a.likes <= 0 || b.likes <= 0 {
+		return 0
+	}
+	return math.Min(1, float64(count)/math.Sqrt(float64(a.likes)*float64(b.likes)))
+}
+
+func (w *Worker) contentSimilarity(a, b *document) float64 {
+	total := w.tagWeight + w.textWeight
+	if total <= 0 {
+		return 0
+	}
+	return (w.tagWeight*jaccard(a.tags, b.tags) + w.textWeight*jaccard(a.tokens, b.tokens)) / total
+}
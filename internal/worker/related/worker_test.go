@@ -0,0 +1,219 @@
+package related
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/s21platform/materials-service/internal/model"
+)
+
+const (
+	uuidA = "0b9c2f4e-1d3a-4c5b-8e7f-6a5b4c3d2e1f"
+	uuidB = "1c8d3e5f-2e4b-4d6c-9f8e-7b6c5d4e3f2a"
+	uuidC = "2d7e4f6a-3f5c-4e7d-af9e-8c7d6e5f4a3b"
+)
+
+func newTestWorker(ctrl *gomock.Controller) (*Worker, *MockDBRepo) {
+	repo := NewMockDBRepo(ctrl)
+
+	repo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+		return cb(ctx)
+	}).AnyTimes()
+
+	return &Worker{
+		repository:        repo,
+		size:              5,
+		cooccurrenceLimit: 200,
+		coldStartLikes:    10,
+		tagWeight:         0.6,
+		textWeight:        0.4,
+	}, repo
+}
+
+func newDocument(uuid string, likes int32, text string, tags ...string) *document {
+	doc := &document{
+		uuid:      uuid,
+		likes:     likes,
+		tags:      make(map[string]struct{}),
+		tokens:    tokenize(text),
+		likedWith: make(map[string]int32),
+	}
+	for _, tag := range tags {
+		doc.tags[tag] = struct{}{}
+	}
+	return doc
+}
+
+func TestWorker_Refresh(t *testing.T) {
+	t.Parallel()
+
+	candidates := []model.RelatedCandidate{
+		{UUID: uuidA, Title: "Go concurrency", Description: "patterns", LikesCount: 100},
+		{UUID: uuidB, Title: "Go concurrency", LikesCount: 100},
+		{UUID: uuidC, Title: "Cooking pasta", LikesCount: 0},
+	}
+	tags := []model.MaterialTag{
+		{MaterialUUID: uuidA, Tag: "go"},
+		{MaterialUUID: uuidB, Tag: "go"},
+		{MaterialUUID: uuidC, Tag: "cooking"},
+	}
+
+	t.Run("ranks_and_replaces", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		worker, repo := newTestWorker(ctrl)
+		repo.EXPECT().GetRelatedCandidates(gomock.Any()).Return(candidates, nil)
+		repo.EXPECT().GetLikeCooccurrences(gomock.Any(), 200).Return([]model.LikeCooccurrence{
+			{MaterialUUID: uuidA, RelatedUUID: uuidB, Count: 50},
+			{MaterialUUID: uuidB, RelatedUUID: uuidA, Count: 50},
+			// материал, снятый с публикации между запросами, пропускается
+			{MaterialUUID: uuidA, RelatedUUID: "9f9f9f9f-9f9f-4f9f-9f9f-9f9f9f9f9f9f", Count: 70},
+		}, nil)
+		repo.EXPECT().GetMaterialsTags(gomock.Any(), []string{uuidA, uuidB, uuidC}).Return(tags, nil)
+
+		var saved []model.RelatedMaterial
+		repo.EXPECT().ReplaceRelatedMaterials(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, related []model.RelatedMaterial) error {
+			saved = related
+			return nil
+		})
+
+		require.NoError(t, worker.refresh(context.Background()))
+
+		// confidence = 100/110, лайки: 50/√(100·100) = 0.5, теги: 1, текст: 1/2 → контент 0.8
+		expected := 100.0/110*0.5 + 10.0/110*0.8
+		require.Len(t, saved, 2)
+		assert.Equal(t, uuidA, saved[0].MaterialUUID)
+		assert.Equal(t, uuidB, saved[0].RelatedUUID)
+		assert.InDelta(t, expected, saved[0].Score, 1e-9)
+		assert.Equal(t, uuidB, saved[1].MaterialUUID)
+		assert.Equal(t, uuidA, saved[1].RelatedUUID)
+		assert.InDelta(t, expected, saved[1].Score, 1e-9)
+	})
+
+	t.Run("candidates_error", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		worker, repo := newTestWorker(ctrl)
+		repo.EXPECT().GetRelatedCandidates(gomock.Any()).Return(nil, errors.New("db down"))
+
+		assert.Error(t, worker.refresh(context.Background()))
+	})
+
+	t.Run("replace_error", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		worker, repo := newTestWorker(ctrl)
+		repo.EXPECT().GetRelatedCandidates(gomock.Any()).Return(candidates, nil)
+		repo.EXPECT().GetLikeCooccurrences(gomock.Any(), 200).Return(nil, nil)
+		repo.EXPECT().GetMaterialsTags(gomock.Any(), gomock.Any()).Return(tags, nil)
+		repo.EXPECT().ReplaceRelatedMaterials(gomock.Any(), gomock.Any()).Return(errors.New("db down"))
+
+		assert.Error(t, worker.refresh(context.Background()))
+	})
+}
+
+func TestWorker_Rank(t *testing.T) {
+	t.Parallel()
+
+	rank := func(worker *Worker, docs ...*document) []model.RelatedMaterial {
+		byUUID := make(map[string]*document, len(docs))
+		for _, doc := range docs {
+			byUUID[doc.uuid] = doc
+		}
+		return worker.rank(docs, byUUID)
+	}
+
+	t.Run("cold_start_uses_content_only", func(t *testing.T) {
+		t.Parallel()
+
+		worker, _ := newTestWorker(gomock.NewController(t))
+		a := newDocument(uuidA, 0, "postgres indexes", "db")
+		b := newDocument(uuidB, 0, "postgres vacuum", "db")
+
+		related := rank(worker, a, b)
+		require.Len(t, related, 2)
+		// теги: 1, текст: 1/3
+		assert.InDelta(t, 0.6*1+0.4*(1.0/3), related[0].Score, 1e-9)
+	})
+
+	t.Run("likes_outweigh_content_when_confident", func(t *testing.T) {
+		t.Parallel()
+
+		worker, _ := newTestWorker(gomock.NewController(t))
+		a := newDocument(uuidA, 1000, "kubernetes operators", "k8s")
+		b := newDocument(uuidB, 1000, "kubernetes operators", "k8s")
+		c := newDocument(uuidC, 1000, "sourdough baking")
+		a.likedWith[uuidC] = 900
+
+		related := rank(worker, a, b, c)
+		require.NotEmpty(t, related)
+		assert.Equal(t, uuidA, related[0].MaterialUUID)
+		assert.Equal(t, uuidC, related[0].RelatedUUID)
+		assert.Equal(t, uuidB, related[1].RelatedUUID)
+	})
+
+	t.Run("keeps_size_best_ordered_by_score_then_uuid", func(t *testing.T) {
+		t.Parallel()
+
+		worker, _ := newTestWorker(gomock.NewController(t))
+		worker.size = 2
+
+		docs := []*document{newDocument(uuidA, 0, "", "shared")}
+		for i := 0; i < 4; i++ {
+			docs = append(docs, newDocument(fmt.Sprintf("ffffffff-0000-4000-8000-00000000000%d", i), 0, "", "shared"))
+		}
+
+		var fromA []model.RelatedMaterial
+		for _, item := range rank(worker, docs...) {
+			if item.MaterialUUID == uuidA {
+				fromA = append(fromA, item)
+			}
+		}
+		require.Len(t, fromA, 2)
+		assert.Equal(t, "ffffffff-0000-4000-8000-000000000000", fromA[0].RelatedUUID)
+		assert.Equal(t, "ffffffff-0000-4000-8000-000000000001", fromA[1].RelatedUUID)
+	})
+
+	t.Run("no_overlap_no_pairs", func(t *testing.T) {
+		t.Parallel()
+
+		worker, _ := newTestWorker(gomock.NewController(t))
+		related := rank(worker, newDocument(uuidA, 5, "rust lifetimes", "rust"), newDocument(uuidB, 5, "css grid", "css"))
+		assert.Empty(t, related)
+	})
+}
+
+func TestBuildIndex(t *testing.T) {
+	t.Parallel()
+
+	var docs []*document
+	for i := 0; i < commonTermMinDocs+10; i++ {
+		tags := []string{"common"}
+		if i < 2 {
+			tags = append(tags, "rare")
+		}
+		docs = append(docs, newDocument(fmt.Sprintf("doc-%d", i), 0, "", tags...))
+	}
+
+	idx := buildIndex(docs, func(doc *document) map[string]struct{} { return doc.tags })
+	assert.NotContains(t, idx, "common")
+	assert.Equal(t, []string{"doc-0", "doc-1"}, idx["rare"])
+}
+
+func TestTokenize(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, map[string]struct{}{"горутины": {}, "channels": {}, "2025": {}}, tokenize("Go: горутины, channels и go v1.24 (2025)"))
+}
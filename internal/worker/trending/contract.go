@@ -0,0 +1,20 @@
+//go:generate mockgen -destination=mock_contract_test.go -package=${GOPACKAGE} -source=contract.go
+package trending
+
+import (
+	"context"
+	"time"
+
+	"github.com/s21platform/materials-service/internal/model"
+)
+
+type DBRepo interface {
+	GetLikeScores(ctx context.Context, window, halfLife time.Duration) ([]model.TrendingScore, error)
+	FilterPublishedMaterials(ctx context.Context, uuids []string) ([]string, error)
+	GetMaterialsTags(ctx context.Context, uuids []string) ([]model.MaterialTag, error)
+}
+
+type RedisRepo interface {
+	GetMaterialViews(ctx context.Context, day time.Time) (map[string]int64, error)
+	SaveTrending(ctx context.Context, ranking []model.TrendingScore, tags map[string][]string) error
+}
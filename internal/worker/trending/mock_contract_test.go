@@ -0,0 +1,134 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+
+// Package trending is a generated GoMock package.
+package trending
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/s21platform/materials-service/internal/model"
+)
+
+// MockDBRepo is a mock of DBRepo interface.
+type MockDBRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockDBRepoMockRecorder
+}
+
+// MockDBRepoMockRecorder is the mock recorder for MockDBRepo.
+type MockDBRepoMockRecorder struct {
+	mock *MockDBRepo
+}
+
+// NewMockDBRepo creates a new mock instance.
+func NewMockDBRepo(ctrl *gomock.Controller) *MockDBRepo {
+	mock := &MockDBRepo{ctrl: ctrl}
+	mock.recorder = &MockDBRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDBRepo) EXPECT() *MockDBRepoMockRecorder {
+	return m.recorder
+}
+
+// FilterPublishedMaterials mocks base method.
+func (m *MockDBRepo) FilterPublishedMaterials(ctx context.Context, uuids []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterPublishedMaterials", ctx, uuids)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterPublishedMaterials indicates an expected call of FilterPublishedMaterials.
+func (mr *MockDBRepoMockRecorder) FilterPublishedMaterials(ctx, uuids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterPublishedMaterials", reflect.TypeOf((*MockDBRepo)(nil).FilterPublishedMaterials), ctx, uuids)
+}
+
+// GetLikeScores mocks base method.
+func (m *MockDBRepo) GetLikeScores(ctx context.Context, window, halfLife time.Duration) ([]model.TrendingScore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLikeScores", ctx, window, halfLife)
+	ret0, _ := ret[0].([]model.TrendingScore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLikeScores indicates an expected call of GetLikeScores.
+func (mr *MockDBRepoMockRecorder) GetLikeScores(ctx, window, halfLife interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikeScores", reflect.TypeOf((*MockDBRepo)(nil).GetLikeScores), ctx, window, halfLife)
+}
+
+// GetMaterialsTags mocks base method.
+func (m *MockDBRepo) GetMaterialsTags(ctx context.Context, uuids []string) ([]model.MaterialTag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaterialsTags", ctx, uuids)
+	ret0, _ := ret[0].([]model.MaterialTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaterialsTags indicates an expected call of GetMaterialsTags.
+func (mr *MockDBRepoMockRecorder) GetMaterialsTags(ctx, uuids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaterialsTags", reflect.TypeOf((*MockDBRepo)(nil).GetMaterialsTags), ctx, uuids)
+}
+
+// MockRedisRepo is a mock of RedisRepo interface.
+type MockRedisRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockRedisRepoMockRecorder
+}
+
+// MockRedisRepoMockRecorder is the mock recorder for MockRedisRepo.
+type MockRedisRepoMockRecorder struct {
+	mock *MockRedisRepo
+}
+
+// NewMockRedisRepo creates a new mock instance.
+func NewMockRedisRepo(ctrl *gomock.Controller) *MockRedisRepo {
+	mock := &MockRedisRepo{ctrl: ctrl}
+	mock.recorder = &MockRedisRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRedisRepo) EXPECT() *MockRedisRepoMockRecorder {
+	return m.recorder
+}
+
+// GetMaterialViews mocks base method.
+func (m *MockRedisRepo) GetMaterialViews(ctx context.Context, day time.Time) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaterialViews", ctx, day)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaterialViews indicates an expected call of GetMaterialViews.
+func (mr *MockRedisRepoMockRecorder) GetMaterialViews(ctx, day interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaterialViews", reflect.TypeOf((*MockRedisRepo)(nil).GetMaterialViews), ctx, day)
+}
+
+// SaveTrending mocks base method.
+func (m *MockRedisRepo) SaveTrending(ctx context.Context, ranking []model.TrendingScore, tags map[string][]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTrending", ctx, ranking, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTrending indicates an expected call of SaveTrending.
+func (mr *MockRedisRepoMockRecorder) SaveTrending(ctx, ranking, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTrending", reflect.TypeOf((*MockRedisRepo)(nil).SaveTrending), ctx, ranking, tags)
+}
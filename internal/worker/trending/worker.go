@@ -0,0 +1,166 @@
+package trending
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	logger_lib "github.com/s21platform/logger-lib"
+
+	"github.com/s21platform/materials-service/internal/config"
+	"github.com/s21platform/materials-service/internal/model"
+)
+
+const (
+	day = 24 * time.Hour
+	// ограничение на размер IN-списка при проверке видимости материалов
+	filterBatchSize = 1000
+)
+
+type Worker struct {
+	repository DBRepo
+	redis      RedisRepo
+	window     time.Duration
+	halfLife   time.Duration
+	likeWeight float64
+	viewWeight float64
+	interval   time.Duration
+	size       int
+}
+
+func New(repo DBRepo, redis RedisRepo, cfg *config.Config) *Worker {
+	return &Worker{
+		repository: repo,
+		redis:      redis,
+		window:     cfg.Trending.Window,
+		halfLife:   cfg.Trending.HalfLife,
+		likeWeight: cfg.Trending.LikeWeight,
+		viewWeight: cfg.Trending.ViewWeight,
+		interval:   cfg.Trending.RefreshInterval,
+		size:       cfg.Trending.Size,
+	}
+}
+
+// Run периодически пересчитывает рейтинг популярных материалов и сохраняет его в Redis,
+// пока не будет отменён ctx.
+func (w *Worker) Run(ctx context.Context) {
+	ctx = logger_lib.WithField(ctx, "func_name", "TrendingWorker")
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.refresh(ctx); err != nil {
+			logger_lib.Error(logger_lib.WithError(ctx, err), fmt.Sprintf("failed to refresh trending materials: %v", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// refresh считает рейтинг как сумму лайков и просмотров за окно, каждый из которых
+// затухает вдвое за halfLife: score = likeWeight·Σ0.5^(age/halfLife) + viewWeight·Σ0.5^(age/halfLife)
+func (w *Worker) refresh(ctx context.Context) error {
+	likeScores, err := w.repository.GetLikeScores(ctx, w.window, w.halfLife)
+	if err != nil {
+		return err
+	}
+
+	scores := make(map[string]float64, len(likeScores))
+	for _, item := range likeScores {
+		scores[item.UUID] += w.likeWeight * item.Score
+	}
+
+	viewScores, err := w.viewScores(ctx)
+	if err != nil {
+		return err
+	}
+
+	// материалы только с просмотрами ещё не проверены на видимость
+	var viewOnly []string
+	for uuid := range viewScores {
+		if _, ok := scores[uuid]; !ok {
+			viewOnly = append(viewOnly, uuid)
+		}
+	}
+
+	visible := make(map[string]struct{}, len(scores)+len(viewOnly))
+	for uuid := range scores {
+		visible[uuid] = struct{}{}
+	}
+	for start := 0; start < len(viewOnly); start += filterBatchSize {
+		end := min(start+filterBatchSize, len(viewOnly))
+		published, err := w.repository.FilterPublishedMaterials(ctx, viewOnly[start:end])
+		if err != nil {
+			return err
+		}
+		for _, uuid := range published {
+			visible[uuid] = struct{}{}
+		}
+	}
+
+	for uuid, score := range viewScores {
+		if _, ok := visible[uuid]; ok {
+			scores[uuid] += w.viewWeight * score
+		}
+	}
+
+	ranking := make([]model.TrendingScore, 0, len(scores))
+	for uuid, score := range scores {
+		ranking = append(ranking, model.TrendingScore{UUID: uuid, Score: score})
+	}
+	sort.Slice(ranking, func(i, j int) bool {
+		if ranking[i].Score != ranking[j].Score {
+			return ranking[i].Score > ranking[j].Score
+		}
+		return ranking[i].UUID < ranking[j].UUID
+	})
+	if len(ranking) > w.size {
+		ranking = ranking[:w.size]
+	}
+
+	tags := make(map[string][]string)
+	if len(ranking) > 0 {
+		uuids := make([]string, 0, len(ranking))
+		for _, item := range ranking {
+			uuids = append(uuids, item.UUID)
+		}
+
+		materialTags, err := w.repository.GetMaterialsTags(ctx, uuids)
+		if err != nil {
+			return err
+		}
+		for _, item := range materialTags {
+			tags[item.Tag] = append(tags[item.Tag], item.MaterialUUID)
+		}
+	}
+
+	return w.redis.SaveTrending(ctx, ranking, tags)
+}
+
+// viewScores собирает дневные счётчики просмотров за окно, возраст просмотров дня считается от его середины
+func (w *Worker) viewScores(ctx context.Context) (map[string]float64, error) {
+	now := time.Now().UTC()
+	scores := make(map[string]float64)
+
+	for bucket := now.Truncate(day); now.Sub(bucket) < w.window; bucket = bucket.Add(-day) {
+		views, err := w.redis.GetMaterialViews(ctx, bucket)
+		if err != nil {
+			return nil, err
+		}
+
+		age := max(now.Sub(bucket.Add(day/2)), 0)
+		decay := math.Pow(0.5, age.Seconds()/w.halfLife.Seconds())
+		for uuid, count := range views {
+			scores[uuid] += float64(count) * decay
+		}
+	}
+
+	return scores, nil
+}
@@ -0,0 +1,189 @@
+package trending
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/s21platform/materials-service/internal/model"
+)
+
+const (
+	uuidA = "0b9c2f4e-1d3a-4c5b-8e7f-6a5b4c3d2e1f"
+	uuidB = "1c8d3e5f-2e4b-4d6c-9f8e-7b6c5d4e3f2a"
+	uuidC = "2d7e4f6a-3f5c-4e7d-af9e-8c7d6e5f4a3b"
+	uuidD = "3e6f5a7b-4a6d-4f8e-bfad-9d8e7f6a5b4c"
+)
+
+// затухание, практически не меняющее счёт за окно теста
+const noDecay = 1_000_000 * time.Hour
+
+func newTestWorker(ctrl *gomock.Controller) (*Worker, *MockDBRepo, *MockRedisRepo) {
+	repo := NewMockDBRepo(ctrl)
+	redis := NewMockRedisRepo(ctrl)
+
+	return &Worker{
+		repository: repo,
+		redis:      redis,
+		window:     72 * time.Hour,
+		halfLife:   noDecay,
+		likeWeight: 1,
+		viewWeight: 0.05,
+		size:       2,
+	}, repo, redis
+}
+
+// viewsOn отдаёт просмотры только за день daysAgo, остальные дни окна пустые
+func viewsOn(daysAgo int, views map[string]int64) func(ctx context.Context, bucket time.Time) (map[string]int64, error) {
+	target := time.Now().UTC().Truncate(day).Add(-time.Duration(daysAgo) * day)
+	return func(_ context.Context, bucket time.Time) (map[string]int64, error) {
+		if bucket.Equal(target) {
+			return views, nil
+		}
+		return nil, nil
+	}
+}
+
+func TestWorker_Refresh(t *testing.T) {
+	t.Parallel()
+
+	t.Run("combines_likes_and_visible_views", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		worker, repo, redis := newTestWorker(ctrl)
+		repo.EXPECT().GetLikeScores(gomock.Any(), 72*time.Hour, noDecay).Return([]model.TrendingScore{
+			{UUID: uuidA, Score: 10},
+			{UUID: uuidB, Score: 4},
+		}, nil)
+		redis.EXPECT().GetMaterialViews(gomock.Any(), gomock.Any()).DoAndReturn(viewsOn(0, map[string]int64{
+			uuidB: 100,
+			uuidC: 50,
+			uuidD: 1000,
+		})).Times(3)
+		// uuidD снят с публикации и не попадает в рейтинг, несмотря на просмотры
+		repo.EXPECT().FilterPublishedMaterials(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, uuids []string) ([]string, error) {
+			assert.ElementsMatch(t, []string{uuidC, uuidD}, uuids)
+			return []string{uuidC}, nil
+		})
+		repo.EXPECT().GetMaterialsTags(gomock.Any(), []string{uuidA, uuidB}).Return([]model.MaterialTag{
+			{MaterialUUID: uuidA, Tag: "go"},
+			{MaterialUUID: uuidB, Tag: "go"},
+			{MaterialUUID: uuidB, Tag: "sql"},
+		}, nil)
+
+		var ranking []model.TrendingScore
+		redis.EXPECT().SaveTrending(gomock.Any(), gomock.Any(), map[string][]string{
+			"go":  {uuidA, uuidB},
+			"sql": {uuidB},
+		}).DoAndReturn(func(_ context.Context, saved []model.TrendingScore, _ map[string][]string) error {
+			ranking = saved
+			return nil
+		})
+
+		require.NoError(t, worker.refresh(context.Background()))
+
+		// A = 10, B = 4 + 0.05·100 = 9, C = 0.05·50 = 2.5 отсекается размером рейтинга
+		require.Len(t, ranking, 2)
+		assert.Equal(t, uuidA, ranking[0].UUID)
+		assert.InDelta(t, 10, ranking[0].Score, 1e-3)
+		assert.Equal(t, uuidB, ranking[1].UUID)
+		assert.InDelta(t, 9, ranking[1].Score, 1e-3)
+	})
+
+	t.Run("ties_ordered_by_uuid", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		worker, repo, redis := newTestWorker(ctrl)
+		repo.EXPECT().GetLikeScores(gomock.Any(), gomock.Any(), gomock.Any()).Return([]model.TrendingScore{
+			{UUID: uuidC, Score: 3},
+			{UUID: uuidB, Score: 3},
+			{UUID: uuidA, Score: 1},
+		}, nil)
+		redis.EXPECT().GetMaterialViews(gomock.Any(), gomock.Any()).Return(nil, nil).Times(3)
+		repo.EXPECT().GetMaterialsTags(gomock.Any(), []string{uuidB, uuidC}).Return(nil, nil)
+		redis.EXPECT().SaveTrending(gomock.Any(), []model.TrendingScore{{UUID: uuidB, Score: 3}, {UUID: uuidC, Score: 3}}, map[string][]string{}).Return(nil)
+
+		require.NoError(t, worker.refresh(context.Background()))
+	})
+
+	t.Run("empty_ranking_clears_trending", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		worker, repo, redis := newTestWorker(ctrl)
+		repo.EXPECT().GetLikeScores(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		redis.EXPECT().GetMaterialViews(gomock.Any(), gomock.Any()).Return(nil, nil).Times(3)
+		redis.EXPECT().SaveTrending(gomock.Any(), []model.TrendingScore{}, map[string][]string{}).Return(nil)
+
+		require.NoError(t, worker.refresh(context.Background()))
+	})
+
+	t.Run("views_error", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		worker, repo, redis := newTestWorker(ctrl)
+		repo.EXPECT().GetLikeScores(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		redis.EXPECT().GetMaterialViews(gomock.Any(), gomock.Any()).Return(nil, errors.New("redis down"))
+
+		assert.Error(t, worker.refresh(context.Background()))
+	})
+}
+
+func TestWorker_ViewScores(t *testing.T) {
+	t.Parallel()
+
+	t.Run("older_days_decay", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		worker, _, redis := newTestWorker(ctrl)
+		worker.halfLife = 24 * time.Hour
+
+		today := viewsOn(0, map[string]int64{uuidA: 100})
+		older := viewsOn(2, map[string]int64{uuidB: 100})
+		redis.EXPECT().GetMaterialViews(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, bucket time.Time) (map[string]int64, error) {
+			if views, _ := today(ctx, bucket); views != nil {
+				return views, nil
+			}
+			return older(ctx, bucket)
+		}).Times(3)
+
+		scores, err := worker.viewScores(context.Background())
+		require.NoError(t, err)
+
+		// возраст дня считается от его середины: сегодня 0..12ч, позавчера 36..60ч
+		assert.InDelta(t, 100*math.Pow(0.5, 0.25), scores[uuidA], 100*(1-math.Pow(0.5, 0.25)))
+		assert.GreaterOrEqual(t, scores[uuidB], 100*math.Pow(0.5, 2.5))
+		assert.LessOrEqual(t, scores[uuidB], 100*math.Pow(0.5, 1.5))
+		assert.Greater(t, scores[uuidA], scores[uuidB])
+	})
+
+	t.Run("reads_only_days_inside_window", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		worker, _, redis := newTestWorker(ctrl)
+		worker.window = 24 * time.Hour
+
+		redis.EXPECT().GetMaterialViews(gomock.Any(), time.Now().UTC().Truncate(day)).Return(map[string]int64{uuidA: 7}, nil)
+
+		scores, err := worker.viewScores(context.Background())
+		require.NoError(t, err)
+		assert.InDelta(t, 7, scores[uuidA], 1e-3)
+	})
+}
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE materials
+    ADD COLUMN IF NOT EXISTS forked_from_uuid UUID REFERENCES materials (uuid),
+    ADD COLUMN IF NOT EXISTS forks_count      INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_materials_forked_from_uuid ON materials (forked_from_uuid);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_materials_forked_from_uuid;
+
+ALTER TABLE materials
+    DROP COLUMN IF EXISTS forks_count,
+    DROP COLUMN IF EXISTS forked_from_uuid;
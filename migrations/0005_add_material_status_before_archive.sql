@@ -0,0 +1,18 @@
+-- +goose Up
+ALTER TABLE materials
+    ADD COLUMN IF NOT EXISTS status_before_archive material_status;
+
+UPDATE materials
+SET status_before_archive = status,
+    status                = 'archived'
+WHERE archived_at IS NOT NULL
+  AND status <> 'archived';
+
+-- +goose Down
+UPDATE materials
+SET status = status_before_archive
+WHERE status = 'archived'
+  AND status_before_archive IS NOT NULL;
+
+ALTER TABLE materials
+    DROP COLUMN IF EXISTS status_before_archive;
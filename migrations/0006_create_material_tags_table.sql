@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS material_tags
+(
+    material_uuid UUID      NOT NULL REFERENCES materials (uuid),
+    tag           TEXT      NOT NULL,
+    created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (material_uuid, tag)
+);
+
+CREATE INDEX IF NOT EXISTS idx_material_tags_tag ON material_tags (tag);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_material_tags_tag;
+DROP TABLE IF EXISTS material_tags;
@@ -0,0 +1,42 @@
+-- +goose Up
+ALTER TABLE material_likes
+    RENAME TO material_reactions;
+
+ALTER TABLE material_reactions
+    ADD COLUMN IF NOT EXISTS reaction TEXT NOT NULL DEFAULT 'like';
+
+ALTER TABLE material_reactions
+    ALTER COLUMN reaction DROP DEFAULT;
+
+ALTER TABLE material_reactions
+    DROP CONSTRAINT IF EXISTS unique_material_user;
+
+ALTER TABLE material_reactions
+    ADD CONSTRAINT unique_material_user_reaction UNIQUE (material_uuid, user_uuid, reaction);
+
+ALTER TABLE materials
+    ADD COLUMN IF NOT EXISTS reaction_counts JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+UPDATE materials
+SET reaction_counts = jsonb_build_object('like', likes_count)
+WHERE likes_count > 0;
+
+-- +goose Down
+DELETE
+FROM material_reactions
+WHERE reaction <> 'like';
+
+ALTER TABLE materials
+    DROP COLUMN IF EXISTS reaction_counts;
+
+ALTER TABLE material_reactions
+    DROP CONSTRAINT IF EXISTS unique_material_user_reaction;
+
+ALTER TABLE material_reactions
+    DROP COLUMN IF EXISTS reaction;
+
+ALTER TABLE material_reactions
+    ADD CONSTRAINT unique_material_user UNIQUE (material_uuid, user_uuid);
+
+ALTER TABLE material_reactions
+    RENAME TO material_likes;
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS related_materials
+(
+    material_uuid UUID             NOT NULL REFERENCES materials (uuid) ON DELETE CASCADE,
+    related_uuid  UUID             NOT NULL REFERENCES materials (uuid) ON DELETE CASCADE,
+    score         DOUBLE PRECISION NOT NULL,
+    computed_at   TIMESTAMP        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (material_uuid, related_uuid)
+);
+
+CREATE INDEX IF NOT EXISTS idx_related_materials_score ON related_materials (material_uuid, score DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_related_materials_score;
+DROP TABLE IF EXISTS related_materials;
@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS material_reports
+(
+    uuid          UUID PRIMARY KEY   DEFAULT gen_random_uuid(),
+    material_uuid UUID      NOT NULL REFERENCES materials (uuid) ON DELETE CASCADE,
+    reporter_uuid UUID      NOT NULL,
+    reason        TEXT      NOT NULL,
+    comment       TEXT      NOT NULL DEFAULT '',
+    status        TEXT      NOT NULL DEFAULT 'open',
+    created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    resolved_at   TIMESTAMP,
+    resolved_by   UUID
+);
+
+-- одна открытая жалоба от пользователя на материал
+CREATE UNIQUE INDEX IF NOT EXISTS unique_open_material_report ON material_reports (material_uuid, reporter_uuid) WHERE status = 'open';
+CREATE INDEX IF NOT EXISTS idx_material_reports_open ON material_reports (created_at) WHERE status = 'open';
+
+ALTER TABLE materials ADD COLUMN IF NOT EXISTS hidden_at TIMESTAMP;
+
+-- +goose Down
+ALTER TABLE materials DROP COLUMN IF EXISTS hidden_at;
+
+DROP INDEX IF EXISTS idx_material_reports_open;
+DROP INDEX IF EXISTS unique_open_material_report;
+DROP TABLE IF EXISTS material_reports;
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS cover_uploads
+(
+    uuid          UUID PRIMARY KEY   DEFAULT gen_random_uuid(),
+    owner_uuid    UUID      NOT NULL,
+    material_uuid UUID REFERENCES materials (uuid) ON DELETE SET NULL,
+    url           TEXT      NOT NULL,
+    storage_keys  TEXT[]    NOT NULL,
+    created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_cover_uploads_created_at ON cover_uploads (created_at);
+-- для проверки, ссылается ли какой-нибудь материал на загрузку
+CREATE INDEX IF NOT EXISTS idx_materials_cover_image_url ON materials (cover_image_url);
+
+ALTER TABLE materials ADD COLUMN IF NOT EXISTS cover_thumbnails JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+-- +goose Down
+ALTER TABLE materials DROP COLUMN IF EXISTS cover_thumbnails;
+
+DROP INDEX IF EXISTS idx_materials_cover_image_url;
+DROP INDEX IF EXISTS idx_cover_uploads_created_at;
+DROP TABLE IF EXISTS cover_uploads;
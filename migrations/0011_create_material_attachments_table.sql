@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS material_attachments
+(
+    uuid          UUID PRIMARY KEY,
+    material_uuid UUID      NOT NULL REFERENCES materials (uuid) ON DELETE CASCADE,
+    owner_uuid    UUID      NOT NULL,
+    filename      TEXT      NOT NULL,
+    content_type  TEXT      NOT NULL,
+    size_bytes    BIGINT    NOT NULL,
+    storage_key   TEXT      NOT NULL,
+    created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_material_attachments_material_uuid ON material_attachments (material_uuid, created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_material_attachments_material_uuid;
+DROP TABLE IF EXISTS material_attachments;
@@ -0,0 +1,35 @@
+-- +goose Up
+ALTER TABLE materials
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP;
+
+UPDATE materials
+SET updated_at = COALESCE(GREATEST(created_at, edited_at, published_at, archived_at, deleted_at, hidden_at), CURRENT_TIMESTAMP);
+
+ALTER TABLE materials
+    ALTER COLUMN updated_at SET DEFAULT CURRENT_TIMESTAMP,
+    ALTER COLUMN updated_at SET NOT NULL;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION set_materials_updated_at() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER trg_materials_updated_at
+    BEFORE UPDATE ON materials
+    FOR EACH ROW
+EXECUTE FUNCTION set_materials_updated_at();
+
+CREATE INDEX IF NOT EXISTS idx_materials_updated_at ON materials (updated_at, uuid);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_materials_updated_at;
+DROP TRIGGER IF EXISTS trg_materials_updated_at ON materials;
+DROP FUNCTION IF EXISTS set_materials_updated_at();
+
+ALTER TABLE materials
+    DROP COLUMN IF EXISTS updated_at;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- анонимный владелец материалов удалённых пользователей, см. model.DeletedUserUUID
+INSERT INTO users (uuid, nickname, avatar_link, name, surname)
+VALUES ('00000000-0000-0000-0000-000000000000', 'deleted', '', '', '')
+ON CONFLICT (uuid) DO NOTHING;
+
+CREATE INDEX IF NOT EXISTS idx_materials_owner_uuid ON materials (owner_uuid);
+CREATE INDEX IF NOT EXISTS idx_material_reactions_user_uuid ON material_reactions (user_uuid);
+CREATE INDEX IF NOT EXISTS idx_material_reports_reporter_uuid ON material_reports (reporter_uuid);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_material_reports_reporter_uuid;
+DROP INDEX IF EXISTS idx_material_reactions_user_uuid;
+DROP INDEX IF EXISTS idx_materials_owner_uuid;
+
+DELETE FROM users WHERE uuid = '00000000-0000-0000-0000-000000000000';
@@ -0,0 +1,15 @@
+-- +goose Up
+-- при политике mark материалы удалённого пользователя остаются за ним с отметкой,
+-- а строка users остаётся без данных профиля, чтобы не нарушать внешний ключ
+ALTER TABLE materials
+    ADD COLUMN IF NOT EXISTS owner_deleted_at TIMESTAMP;
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+-- +goose Down
+ALTER TABLE users
+    DROP COLUMN IF EXISTS deleted_at;
+
+ALTER TABLE materials
+    DROP COLUMN IF EXISTS owner_deleted_at;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- updated_at двигается только при изменении самого материала: счётчики лайков, реакций и форков
+-- меняются постоянно и не должны попадать в инкрементальную выгрузку
+DROP TRIGGER IF EXISTS trg_materials_updated_at ON materials;
+
+CREATE TRIGGER trg_materials_updated_at
+    BEFORE UPDATE ON materials
+    FOR EACH ROW
+    WHEN ((OLD.owner_uuid, OLD.title, OLD.cover_image_url, OLD.description, OLD.content, OLD.read_time_minutes,
+           OLD.status, OLD.edited_at, OLD.published_at, OLD.archived_at, OLD.deleted_at, OLD.hidden_at,
+           OLD.cover_thumbnails, OLD.owner_deleted_at, OLD.forked_from_uuid)
+          IS DISTINCT FROM
+          (NEW.owner_uuid, NEW.title, NEW.cover_image_url, NEW.description, NEW.content, NEW.read_time_minutes,
+           NEW.status, NEW.edited_at, NEW.published_at, NEW.archived_at, NEW.deleted_at, NEW.hidden_at,
+           NEW.cover_thumbnails, NEW.owner_deleted_at, NEW.forked_from_uuid))
+EXECUTE FUNCTION set_materials_updated_at();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS trg_materials_updated_at ON materials;
+
+CREATE TRIGGER trg_materials_updated_at
+    BEFORE UPDATE ON materials
+    FOR EACH ROW
+EXECUTE FUNCTION set_materials_updated_at();
@@ -0,0 +1,14 @@
+-- +goose Up
+-- очередь файлов на удаление: её пополняют процессы без доступа к хранилищу (consumer удаления пользователей),
+-- а разбирает воркер сервиса, которому хранилище принадлежит
+CREATE TABLE IF NOT EXISTS blob_deletions
+(
+    storage_key TEXT PRIMARY KEY,
+    created_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_blob_deletions_created_at ON blob_deletions (created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_blob_deletions_created_at;
+DROP TABLE IF EXISTS blob_deletions;
@@ -0,0 +1,23 @@
+-- +goose Up
+-- CURRENT_TIMESTAMP — время начала транзакции: строка из долгой транзакции получала updated_at в прошлом
+-- и после коммита оказывалась позади курсора выгрузки, которая уже прошла это место
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION set_materials_updated_at() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = clock_timestamp();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION set_materials_updated_at() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
@@ -231,6 +231,94 @@ func (x *GetMaterialOut) GetMaterial() *Material {
 	return nil
 }
 
+type GetMaterialsByUUIDsIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuids         []string               `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"` // UUID материалов, не больше 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaterialsByUUIDsIn) Reset() {
+	*x = GetMaterialsByUUIDsIn{}
+	mi := &file_api_materials_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaterialsByUUIDsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaterialsByUUIDsIn) ProtoMessage() {}
+
+func (x *GetMaterialsByUUIDsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaterialsByUUIDsIn.ProtoReflect.Descriptor instead.
+func (*GetMaterialsByUUIDsIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetMaterialsByUUIDsIn) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+type GetMaterialsByUUIDsOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Materials     []*Material            `protobuf:"bytes,1,rep,name=materials,proto3" json:"materials,omitempty"` // Материалы в порядке запроса, отсутствующие и недоступные пропущены
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaterialsByUUIDsOut) Reset() {
+	*x = GetMaterialsByUUIDsOut{}
+	mi := &file_api_materials_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaterialsByUUIDsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaterialsByUUIDsOut) ProtoMessage() {}
+
+func (x *GetMaterialsByUUIDsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaterialsByUUIDsOut.ProtoReflect.Descriptor instead.
+func (*GetMaterialsByUUIDsOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetMaterialsByUUIDsOut) GetMaterials() []*Material {
+	if x != nil {
+		return x.Materials
+	}
+	return nil
+}
+
 type Material struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Uuid            string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
@@ -247,13 +335,20 @@ type Material struct {
 	ArchivedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`                  // Время архивации
 	DeletedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`                     // Время удаления
 	LikesCount      int32                  `protobuf:"varint,14,opt,name=likes_count,json=likesCount,proto3" json:"likes_count,omitempty"`                 // Количество лайков
+	ForkedFromUuid  string                 `protobuf:"bytes,15,opt,name=forked_from_uuid,json=forkedFromUuid,proto3" json:"forked_from_uuid,omitempty"`    // UUID исходного материала, если это копия
+	ForksCount      int32                  `protobuf:"varint,16,opt,name=forks_count,json=forksCount,proto3" json:"forks_count,omitempty"`                 // Количество копий материала
+	Reactions       []*ReactionCount       `protobuf:"bytes,17,rep,name=reactions,proto3" json:"reactions,omitempty"`                                      // Счётчики реакций по типам
+	MyReactions     []string               `protobuf:"bytes,18,rep,name=my_reactions,json=myReactions,proto3" json:"my_reactions,omitempty"`               // Реакции текущего пользователя
+	CoverThumbnails []*CoverThumbnail      `protobuf:"bytes,19,rep,name=cover_thumbnails,json=coverThumbnails,proto3" json:"cover_thumbnails,omitempty"`   // Уменьшенные копии загруженной обложки
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                     // Время последнего изменения строки материала
+	OwnerDeletedAt  *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=owner_deleted_at,json=ownerDeletedAt,proto3" json:"owner_deleted_at,omitempty"`    // Время удаления владельца, если материал помечен, а не передан
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Material) Reset() {
 	*x = Material{}
-	mi := &file_api_materials_proto_msgTypes[4]
+	mi := &file_api_materials_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -265,7 +360,7 @@ func (x *Material) String() string {
 func (*Material) ProtoMessage() {}
 
 func (x *Material) ProtoReflect() protoreflect.Message {
-	mi := &file_api_materials_proto_msgTypes[4]
+	mi := &file_api_materials_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -278,7 +373,7 @@ func (x *Material) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Material.ProtoReflect.Descriptor instead.
 func (*Material) Descriptor() ([]byte, []int) {
-	return file_api_materials_proto_rawDescGZIP(), []int{4}
+	return file_api_materials_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Material) GetUuid() string {
@@ -379,6 +474,55 @@ func (x *Material) GetLikesCount() int32 {
 	return 0
 }
 
+func (x *Material) GetForkedFromUuid() string {
+	if x != nil {
+		return x.ForkedFromUuid
+	}
+	return ""
+}
+
+func (x *Material) GetForksCount() int32 {
+	if x != nil {
+		return x.ForksCount
+	}
+	return 0
+}
+
+func (x *Material) GetReactions() []*ReactionCount {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *Material) GetMyReactions() []string {
+	if x != nil {
+		return x.MyReactions
+	}
+	return nil
+}
+
+func (x *Material) GetCoverThumbnails() []*CoverThumbnail {
+	if x != nil {
+		return x.CoverThumbnails
+	}
+	return nil
+}
+
+func (x *Material) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Material) GetOwnerDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OwnerDeletedAt
+	}
+	return nil
+}
+
 type GetAllMaterialsOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MaterialList  []*Material            `protobuf:"bytes,1,rep,name=material_list,json=materialList,proto3" json:"material_list,omitempty"`
@@ -388,7 +532,7 @@ type GetAllMaterialsOut struct {
 
 func (x *GetAllMaterialsOut) Reset() {
 	*x = GetAllMaterialsOut{}
-	mi := &file_api_materials_proto_msgTypes[5]
+	mi := &file_api_materials_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -400,7 +544,7 @@ func (x *GetAllMaterialsOut) String() string {
 func (*GetAllMaterialsOut) ProtoMessage() {}
 
 func (x *GetAllMaterialsOut) ProtoReflect() protoreflect.Message {
-	mi := &file_api_materials_proto_msgTypes[5]
+	mi := &file_api_materials_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -413,7 +557,7 @@ func (x *GetAllMaterialsOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllMaterialsOut.ProtoReflect.Descriptor instead.
 func (*GetAllMaterialsOut) Descriptor() ([]byte, []int) {
-	return file_api_materials_proto_rawDescGZIP(), []int{5}
+	return file_api_materials_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetAllMaterialsOut) GetMaterialList() []*Material {
@@ -437,7 +581,7 @@ type EditMaterialIn struct {
 
 func (x *EditMaterialIn) Reset() {
 	*x = EditMaterialIn{}
-	mi := &file_api_materials_proto_msgTypes[6]
+	mi := &file_api_materials_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -449,7 +593,7 @@ func (x *EditMaterialIn) String() string {
 func (*EditMaterialIn) ProtoMessage() {}
 
 func (x *EditMaterialIn) ProtoReflect() protoreflect.Message {
-	mi := &file_api_materials_proto_msgTypes[6]
+	mi := &file_api_materials_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,7 +606,7 @@ func (x *EditMaterialIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditMaterialIn.ProtoReflect.Descriptor instead.
 func (*EditMaterialIn) Descriptor() ([]byte, []int) {
-	return file_api_materials_proto_rawDescGZIP(), []int{6}
+	return file_api_materials_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *EditMaterialIn) GetUuid() string {
@@ -516,7 +660,7 @@ type EditMaterialOut struct {
 
 func (x *EditMaterialOut) Reset() {
 	*x = EditMaterialOut{}
-	mi := &file_api_materials_proto_msgTypes[7]
+	mi := &file_api_materials_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -528,7 +672,7 @@ func (x *EditMaterialOut) String() string {
 func (*EditMaterialOut) ProtoMessage() {}
 
 func (x *EditMaterialOut) ProtoReflect() protoreflect.Message {
-	mi := &file_api_materials_proto_msgTypes[7]
+	mi := &file_api_materials_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -541,7 +685,7 @@ func (x *EditMaterialOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditMaterialOut.ProtoReflect.Descriptor instead.
 func (*EditMaterialOut) Descriptor() ([]byte, []int) {
-	return file_api_materials_proto_rawDescGZIP(), []int{7}
+	return file_api_materials_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *EditMaterialOut) GetMaterial() *Material {
@@ -560,7 +704,7 @@ type DeleteMaterialIn struct {
 
 func (x *DeleteMaterialIn) Reset() {
 	*x = DeleteMaterialIn{}
-	mi := &file_api_materials_proto_msgTypes[8]
+	mi := &file_api_materials_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -572,7 +716,7 @@ func (x *DeleteMaterialIn) String() string {
 func (*DeleteMaterialIn) ProtoMessage() {}
 
 func (x *DeleteMaterialIn) ProtoReflect() protoreflect.Message {
-	mi := &file_api_materials_proto_msgTypes[8]
+	mi := &file_api_materials_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -585,7 +729,7 @@ func (x *DeleteMaterialIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMaterialIn.ProtoReflect.Descriptor instead.
 func (*DeleteMaterialIn) Descriptor() ([]byte, []int) {
-	return file_api_materials_proto_rawDescGZIP(), []int{8}
+	return file_api_materials_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteMaterialIn) GetUuid() string {
@@ -604,7 +748,7 @@ type PublishMaterialIn struct {
 
 func (x *PublishMaterialIn) Reset() {
 	*x = PublishMaterialIn{}
-	mi := &file_api_materials_proto_msgTypes[9]
+	mi := &file_api_materials_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -616,7 +760,7 @@ func (x *PublishMaterialIn) String() string {
 func (*PublishMaterialIn) ProtoMessage() {}
 
 func (x *PublishMaterialIn) ProtoReflect() protoreflect.Message {
-	mi := &file_api_materials_proto_msgTypes[9]
+	mi := &file_api_materials_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,7 +773,7 @@ func (x *PublishMaterialIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishMaterialIn.ProtoReflect.Descriptor instead.
 func (*PublishMaterialIn) Descriptor() ([]byte, []int) {
-	return file_api_materials_proto_rawDescGZIP(), []int{9}
+	return file_api_materials_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PublishMaterialIn) GetUuid() string {
@@ -648,7 +792,7 @@ type PublishMaterialOut struct {
 
 func (x *PublishMaterialOut) Reset() {
 	*x = PublishMaterialOut{}
-	mi := &file_api_materials_proto_msgTypes[10]
+	mi := &file_api_materials_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -660,7 +804,7 @@ func (x *PublishMaterialOut) String() string {
 func (*PublishMaterialOut) ProtoMessage() {}
 
 func (x *PublishMaterialOut) ProtoReflect() protoreflect.Message {
-	mi := &file_api_materials_proto_msgTypes[10]
+	mi := &file_api_materials_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -673,7 +817,7 @@ func (x *PublishMaterialOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishMaterialOut.ProtoReflect.Descriptor instead.
 func (*PublishMaterialOut) Descriptor() ([]byte, []int) {
-	return file_api_materials_proto_rawDescGZIP(), []int{10}
+	return file_api_materials_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PublishMaterialOut) GetMaterial() *Material {
@@ -692,7 +836,7 @@ type ArchivedMaterialIn struct {
 
 func (x *ArchivedMaterialIn) Reset() {
 	*x = ArchivedMaterialIn{}
-	mi := &file_api_materials_proto_msgTypes[11]
+	mi := &file_api_materials_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -704,7 +848,7 @@ func (x *ArchivedMaterialIn) String() string {
 func (*ArchivedMaterialIn) ProtoMessage() {}
 
 func (x *ArchivedMaterialIn) ProtoReflect() protoreflect.Message {
-	mi := &file_api_materials_proto_msgTypes[11]
+	mi := &file_api_materials_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -717,7 +861,7 @@ func (x *ArchivedMaterialIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchivedMaterialIn.ProtoReflect.Descriptor instead.
 func (*ArchivedMaterialIn) Descriptor() ([]byte, []int) {
-	return file_api_materials_proto_rawDescGZIP(), []int{11}
+	return file_api_materials_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ArchivedMaterialIn) GetUuid() string {
@@ -736,7 +880,7 @@ type ToggleLikeIn struct {
 
 func (x *ToggleLikeIn) Reset() {
 	*x = ToggleLikeIn{}
-	mi := &file_api_materials_proto_msgTypes[12]
+	mi := &file_api_materials_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -748,7 +892,7 @@ func (x *ToggleLikeIn) String() string {
 func (*ToggleLikeIn) ProtoMessage() {}
 
 func (x *ToggleLikeIn) ProtoReflect() protoreflect.Message {
-	mi := &file_api_materials_proto_msgTypes[12]
+	mi := &file_api_materials_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -761,7 +905,7 @@ func (x *ToggleLikeIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToggleLikeIn.ProtoReflect.Descriptor instead.
 func (*ToggleLikeIn) Descriptor() ([]byte, []int) {
-	return file_api_materials_proto_rawDescGZIP(), []int{12}
+	return file_api_materials_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ToggleLikeIn) GetMaterialUuid() string {
@@ -781,7 +925,7 @@ type ToggleLikeOut struct {
 
 func (x *ToggleLikeOut) Reset() {
 	*x = ToggleLikeOut{}
-	mi := &file_api_materials_proto_msgTypes[13]
+	mi := &file_api_materials_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -793,7 +937,7 @@ func (x *ToggleLikeOut) String() string {
 func (*ToggleLikeOut) ProtoMessage() {}
 
 func (x *ToggleLikeOut) ProtoReflect() protoreflect.Message {
-	mi := &file_api_materials_proto_msgTypes[13]
+	mi := &file_api_materials_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -806,7 +950,7 @@ func (x *ToggleLikeOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToggleLikeOut.ProtoReflect.Descriptor instead.
 func (*ToggleLikeOut) Descriptor() ([]byte, []int) {
-	return file_api_materials_proto_rawDescGZIP(), []int{13}
+	return file_api_materials_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ToggleLikeOut) GetIsLiked() bool {
@@ -823,30 +967,33 @@ func (x *ToggleLikeOut) GetLikesCount() int32 {
 	return 0
 }
 
-type MaterialDeletedMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	OwnerUuid     string                 `protobuf:"bytes,2,opt,name=owner_uuid,json=ownerUuid,proto3" json:"owner_uuid,omitempty"`
-	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AutosaveDraftIn struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Uuid            string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                                                 // UUID материала
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                                               // Заголовок материала
+	CoverImageUrl   string                 `protobuf:"bytes,3,opt,name=cover_image_url,json=coverImageUrl,proto3" json:"cover_image_url,omitempty"`        // URL обложки материала
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                                   // Описание материала
+	Content         string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`                                           // Содержимое материала
+	ReadTimeMinutes int32                  `protobuf:"varint,6,opt,name=read_time_minutes,json=readTimeMinutes,proto3" json:"read_time_minutes,omitempty"` // Время чтения в минутах
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *MaterialDeletedMessage) Reset() {
-	*x = MaterialDeletedMessage{}
-	mi := &file_api_materials_proto_msgTypes[14]
+func (x *AutosaveDraftIn) Reset() {
+	*x = AutosaveDraftIn{}
+	mi := &file_api_materials_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MaterialDeletedMessage) String() string {
+func (x *AutosaveDraftIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaterialDeletedMessage) ProtoMessage() {}
+func (*AutosaveDraftIn) ProtoMessage() {}
 
-func (x *MaterialDeletedMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_api_materials_proto_msgTypes[14]
+func (x *AutosaveDraftIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -857,54 +1004,75 @@ func (x *MaterialDeletedMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MaterialDeletedMessage.ProtoReflect.Descriptor instead.
-func (*MaterialDeletedMessage) Descriptor() ([]byte, []int) {
-	return file_api_materials_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use AutosaveDraftIn.ProtoReflect.Descriptor instead.
+func (*AutosaveDraftIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *MaterialDeletedMessage) GetUuid() string {
+func (x *AutosaveDraftIn) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-func (x *MaterialDeletedMessage) GetOwnerUuid() string {
+func (x *AutosaveDraftIn) GetTitle() string {
 	if x != nil {
-		return x.OwnerUuid
+		return x.Title
 	}
 	return ""
 }
 
-func (x *MaterialDeletedMessage) GetDeletedAt() *timestamppb.Timestamp {
+func (x *AutosaveDraftIn) GetCoverImageUrl() string {
 	if x != nil {
-		return x.DeletedAt
+		return x.CoverImageUrl
 	}
-	return nil
+	return ""
 }
 
-type CreatedMaterial struct {
+func (x *AutosaveDraftIn) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AutosaveDraftIn) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *AutosaveDraftIn) GetReadTimeMinutes() int32 {
+	if x != nil {
+		return x.ReadTimeMinutes
+	}
+	return 0
+}
+
+type AutosaveDraftOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Material      *Material              `protobuf:"bytes,1,opt,name=material,proto3" json:"material,omitempty"`
+	SavedAt       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=saved_at,json=savedAt,proto3" json:"saved_at,omitempty"` // Время автосохранения
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatedMaterial) Reset() {
-	*x = CreatedMaterial{}
-	mi := &file_api_materials_proto_msgTypes[15]
+func (x *AutosaveDraftOut) Reset() {
+	*x = AutosaveDraftOut{}
+	mi := &file_api_materials_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatedMaterial) String() string {
+func (x *AutosaveDraftOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatedMaterial) ProtoMessage() {}
+func (*AutosaveDraftOut) ProtoMessage() {}
 
-func (x *CreatedMaterial) ProtoReflect() protoreflect.Message {
-	mi := &file_api_materials_proto_msgTypes[15]
+func (x *AutosaveDraftOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -915,42 +1083,40 @@ func (x *CreatedMaterial) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatedMaterial.ProtoReflect.Descriptor instead.
-func (*CreatedMaterial) Descriptor() ([]byte, []int) {
-	return file_api_materials_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use AutosaveDraftOut.ProtoReflect.Descriptor instead.
+func (*AutosaveDraftOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CreatedMaterial) GetMaterial() *Material {
+func (x *AutosaveDraftOut) GetSavedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Material
+		return x.SavedAt
 	}
 	return nil
 }
 
-type ToggleLikeMessage struct {
+type PromoteAutosaveIn struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MaterialUuid  string                 `protobuf:"bytes,1,opt,name=material_uuid,json=materialUuid,proto3" json:"material_uuid,omitempty"`
-	IsLiked       bool                   `protobuf:"varint,2,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
-	LikesCount    int32                  `protobuf:"varint,3,opt,name=likes_count,json=likesCount,proto3" json:"likes_count,omitempty"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"` // UUID материала
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToggleLikeMessage) Reset() {
-	*x = ToggleLikeMessage{}
-	mi := &file_api_materials_proto_msgTypes[16]
+func (x *PromoteAutosaveIn) Reset() {
+	*x = PromoteAutosaveIn{}
+	mi := &file_api_materials_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToggleLikeMessage) String() string {
+func (x *PromoteAutosaveIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleLikeMessage) ProtoMessage() {}
+func (*PromoteAutosaveIn) ProtoMessage() {}
 
-func (x *ToggleLikeMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_api_materials_proto_msgTypes[16]
+func (x *PromoteAutosaveIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -961,23 +1127,3201 @@ func (x *ToggleLikeMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleLikeMessage.ProtoReflect.Descriptor instead.
-func (*ToggleLikeMessage) Descriptor() ([]byte, []int) {
-	return file_api_materials_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use PromoteAutosaveIn.ProtoReflect.Descriptor instead.
+func (*PromoteAutosaveIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ToggleLikeMessage) GetMaterialUuid() string {
+func (x *PromoteAutosaveIn) GetUuid() string {
 	if x != nil {
-		return x.MaterialUuid
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *ToggleLikeMessage) GetIsLiked() bool {
-	if x != nil {
-		return x.IsLiked
-	}
-	return false
+type PromoteAutosaveOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Material      *Material              `protobuf:"bytes,1,opt,name=material,proto3" json:"material,omitempty"` // Весь материал
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoteAutosaveOut) Reset() {
+	*x = PromoteAutosaveOut{}
+	mi := &file_api_materials_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteAutosaveOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteAutosaveOut) ProtoMessage() {}
+
+func (x *PromoteAutosaveOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteAutosaveOut.ProtoReflect.Descriptor instead.
+func (*PromoteAutosaveOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PromoteAutosaveOut) GetMaterial() *Material {
+	if x != nil {
+		return x.Material
+	}
+	return nil
+}
+
+type DuplicateMaterialIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"` // UUID исходного материала
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateMaterialIn) Reset() {
+	*x = DuplicateMaterialIn{}
+	mi := &file_api_materials_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateMaterialIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateMaterialIn) ProtoMessage() {}
+
+func (x *DuplicateMaterialIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateMaterialIn.ProtoReflect.Descriptor instead.
+func (*DuplicateMaterialIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DuplicateMaterialIn) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type DuplicateMaterialOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Material      *Material              `protobuf:"bytes,1,opt,name=material,proto3" json:"material,omitempty"` // Новый черновик
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateMaterialOut) Reset() {
+	*x = DuplicateMaterialOut{}
+	mi := &file_api_materials_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateMaterialOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateMaterialOut) ProtoMessage() {}
+
+func (x *DuplicateMaterialOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateMaterialOut.ProtoReflect.Descriptor instead.
+func (*DuplicateMaterialOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DuplicateMaterialOut) GetMaterial() *Material {
+	if x != nil {
+		return x.Material
+	}
+	return nil
+}
+
+type GetDeletedMaterialsIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`   // Номер страницы, начиная с 1
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Количество материалов на странице
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeletedMaterialsIn) Reset() {
+	*x = GetDeletedMaterialsIn{}
+	mi := &file_api_materials_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeletedMaterialsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeletedMaterialsIn) ProtoMessage() {}
+
+func (x *GetDeletedMaterialsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeletedMaterialsIn.ProtoReflect.Descriptor instead.
+func (*GetDeletedMaterialsIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetDeletedMaterialsIn) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetDeletedMaterialsIn) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetDeletedMaterialsOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialList  []*Material            `protobuf:"bytes,1,rep,name=material_list,json=materialList,proto3" json:"material_list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeletedMaterialsOut) Reset() {
+	*x = GetDeletedMaterialsOut{}
+	mi := &file_api_materials_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeletedMaterialsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeletedMaterialsOut) ProtoMessage() {}
+
+func (x *GetDeletedMaterialsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeletedMaterialsOut.ProtoReflect.Descriptor instead.
+func (*GetDeletedMaterialsOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetDeletedMaterialsOut) GetMaterialList() []*Material {
+	if x != nil {
+		return x.MaterialList
+	}
+	return nil
+}
+
+type RestoreMaterialIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"` // UUID материала
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreMaterialIn) Reset() {
+	*x = RestoreMaterialIn{}
+	mi := &file_api_materials_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreMaterialIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreMaterialIn) ProtoMessage() {}
+
+func (x *RestoreMaterialIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreMaterialIn.ProtoReflect.Descriptor instead.
+func (*RestoreMaterialIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RestoreMaterialIn) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type RestoreMaterialOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Material      *Material              `protobuf:"bytes,1,opt,name=material,proto3" json:"material,omitempty"` // Восстановленный материал
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreMaterialOut) Reset() {
+	*x = RestoreMaterialOut{}
+	mi := &file_api_materials_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreMaterialOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreMaterialOut) ProtoMessage() {}
+
+func (x *RestoreMaterialOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreMaterialOut.ProtoReflect.Descriptor instead.
+func (*RestoreMaterialOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RestoreMaterialOut) GetMaterial() *Material {
+	if x != nil {
+		return x.Material
+	}
+	return nil
+}
+
+type UnarchiveMaterialIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"` // UUID материала
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveMaterialIn) Reset() {
+	*x = UnarchiveMaterialIn{}
+	mi := &file_api_materials_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveMaterialIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveMaterialIn) ProtoMessage() {}
+
+func (x *UnarchiveMaterialIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveMaterialIn.ProtoReflect.Descriptor instead.
+func (*UnarchiveMaterialIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UnarchiveMaterialIn) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type UnarchiveMaterialOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Material      *Material              `protobuf:"bytes,1,opt,name=material,proto3" json:"material,omitempty"` // Материал с восстановленным статусом
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveMaterialOut) Reset() {
+	*x = UnarchiveMaterialOut{}
+	mi := &file_api_materials_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveMaterialOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveMaterialOut) ProtoMessage() {}
+
+func (x *UnarchiveMaterialOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveMaterialOut.ProtoReflect.Descriptor instead.
+func (*UnarchiveMaterialOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UnarchiveMaterialOut) GetMaterial() *Material {
+	if x != nil {
+		return x.Material
+	}
+	return nil
+}
+
+type GetArchivedMaterialsIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`   // Номер страницы, начиная с 1
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Количество материалов на странице
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArchivedMaterialsIn) Reset() {
+	*x = GetArchivedMaterialsIn{}
+	mi := &file_api_materials_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArchivedMaterialsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchivedMaterialsIn) ProtoMessage() {}
+
+func (x *GetArchivedMaterialsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchivedMaterialsIn.ProtoReflect.Descriptor instead.
+func (*GetArchivedMaterialsIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetArchivedMaterialsIn) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetArchivedMaterialsIn) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetArchivedMaterialsOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialList  []*Material            `protobuf:"bytes,1,rep,name=material_list,json=materialList,proto3" json:"material_list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArchivedMaterialsOut) Reset() {
+	*x = GetArchivedMaterialsOut{}
+	mi := &file_api_materials_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArchivedMaterialsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchivedMaterialsOut) ProtoMessage() {}
+
+func (x *GetArchivedMaterialsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchivedMaterialsOut.ProtoReflect.Descriptor instead.
+func (*GetArchivedMaterialsOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetArchivedMaterialsOut) GetMaterialList() []*Material {
+	if x != nil {
+		return x.MaterialList
+	}
+	return nil
+}
+
+type BulkMaterialsIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuids         []string               `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"` // UUID материалов, не более 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkMaterialsIn) Reset() {
+	*x = BulkMaterialsIn{}
+	mi := &file_api_materials_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkMaterialsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkMaterialsIn) ProtoMessage() {}
+
+func (x *BulkMaterialsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkMaterialsIn.ProtoReflect.Descriptor instead.
+func (*BulkMaterialsIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BulkMaterialsIn) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+type BulkTagMaterialsIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuids         []string               `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"` // UUID материалов, не более 100
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`   // Добавляемые теги
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkTagMaterialsIn) Reset() {
+	*x = BulkTagMaterialsIn{}
+	mi := &file_api_materials_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkTagMaterialsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkTagMaterialsIn) ProtoMessage() {}
+
+func (x *BulkTagMaterialsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkTagMaterialsIn.ProtoReflect.Descriptor instead.
+func (*BulkTagMaterialsIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BulkTagMaterialsIn) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *BulkTagMaterialsIn) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type BulkItemResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"` // Причина отказа, если success = false
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkItemResult) Reset() {
+	*x = BulkItemResult{}
+	mi := &file_api_materials_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkItemResult) ProtoMessage() {}
+
+func (x *BulkItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkItemResult.ProtoReflect.Descriptor instead.
+func (*BulkItemResult) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BulkItemResult) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *BulkItemResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BulkItemResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BulkMaterialsOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BulkItemResult      `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // Результаты в порядке входного списка
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkMaterialsOut) Reset() {
+	*x = BulkMaterialsOut{}
+	mi := &file_api_materials_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkMaterialsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkMaterialsOut) ProtoMessage() {}
+
+func (x *BulkMaterialsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkMaterialsOut.ProtoReflect.Descriptor instead.
+func (*BulkMaterialsOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BulkMaterialsOut) GetResults() []*BulkItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type SetLikeIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialUuid  string                 `protobuf:"bytes,1,opt,name=material_uuid,json=materialUuid,proto3" json:"material_uuid,omitempty"` // UUID материала
+	Liked         bool                   `protobuf:"varint,2,opt,name=liked,proto3" json:"liked,omitempty"`                                  // Желаемое состояние лайка
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLikeIn) Reset() {
+	*x = SetLikeIn{}
+	mi := &file_api_materials_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLikeIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLikeIn) ProtoMessage() {}
+
+func (x *SetLikeIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLikeIn.ProtoReflect.Descriptor instead.
+func (*SetLikeIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetLikeIn) GetMaterialUuid() string {
+	if x != nil {
+		return x.MaterialUuid
+	}
+	return ""
+}
+
+func (x *SetLikeIn) GetLiked() bool {
+	if x != nil {
+		return x.Liked
+	}
+	return false
+}
+
+type SetLikeOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsLiked       bool                   `protobuf:"varint,1,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`          // Состояние лайка
+	LikesCount    int32                  `protobuf:"varint,2,opt,name=likes_count,json=likesCount,proto3" json:"likes_count,omitempty"` // Количество лайков
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLikeOut) Reset() {
+	*x = SetLikeOut{}
+	mi := &file_api_materials_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLikeOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLikeOut) ProtoMessage() {}
+
+func (x *SetLikeOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLikeOut.ProtoReflect.Descriptor instead.
+func (*SetLikeOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetLikeOut) GetIsLiked() bool {
+	if x != nil {
+		return x.IsLiked
+	}
+	return false
+}
+
+func (x *SetLikeOut) GetLikesCount() int32 {
+	if x != nil {
+		return x.LikesCount
+	}
+	return 0
+}
+
+type ListMaterialLikersIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialUuid  string                 `protobuf:"bytes,1,opt,name=material_uuid,json=materialUuid,proto3" json:"material_uuid,omitempty"` // UUID материала
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                                    // Номер страницы, начиная с 1
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                  // Количество пользователей на странице
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMaterialLikersIn) Reset() {
+	*x = ListMaterialLikersIn{}
+	mi := &file_api_materials_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMaterialLikersIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaterialLikersIn) ProtoMessage() {}
+
+func (x *ListMaterialLikersIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaterialLikersIn.ProtoReflect.Descriptor instead.
+func (*ListMaterialLikersIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListMaterialLikersIn) GetMaterialUuid() string {
+	if x != nil {
+		return x.MaterialUuid
+	}
+	return ""
+}
+
+func (x *ListMaterialLikersIn) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMaterialLikersIn) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type UserSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	AvatarLink    string                 `protobuf:"bytes,3,opt,name=avatar_link,json=avatarLink,proto3" json:"avatar_link,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Surname       string                 `protobuf:"bytes,5,opt,name=surname,proto3" json:"surname,omitempty"`
+	LikedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=liked_at,json=likedAt,proto3" json:"liked_at,omitempty"` // Время лайка
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSummary) Reset() {
+	*x = UserSummary{}
+	mi := &file_api_materials_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSummary) ProtoMessage() {}
+
+func (x *UserSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSummary.ProtoReflect.Descriptor instead.
+func (*UserSummary) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UserSummary) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UserSummary) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *UserSummary) GetAvatarLink() string {
+	if x != nil {
+		return x.AvatarLink
+	}
+	return ""
+}
+
+func (x *UserSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserSummary) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *UserSummary) GetLikedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LikedAt
+	}
+	return nil
+}
+
+type ListMaterialLikersOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Likers        []*UserSummary         `protobuf:"bytes,1,rep,name=likers,proto3" json:"likers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMaterialLikersOut) Reset() {
+	*x = ListMaterialLikersOut{}
+	mi := &file_api_materials_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMaterialLikersOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaterialLikersOut) ProtoMessage() {}
+
+func (x *ListMaterialLikersOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaterialLikersOut.ProtoReflect.Descriptor instead.
+func (*ListMaterialLikersOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListMaterialLikersOut) GetLikers() []*UserSummary {
+	if x != nil {
+		return x.Likers
+	}
+	return nil
+}
+
+type ReactionCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reaction      string                 `protobuf:"bytes,1,opt,name=reaction,proto3" json:"reaction,omitempty"` // Тип реакции
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`      // Количество реакций этого типа
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionCount) Reset() {
+	*x = ReactionCount{}
+	mi := &file_api_materials_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionCount) ProtoMessage() {}
+
+func (x *ReactionCount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionCount.ProtoReflect.Descriptor instead.
+func (*ReactionCount) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReactionCount) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+func (x *ReactionCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SetReactionIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialUuid  string                 `protobuf:"bytes,1,opt,name=material_uuid,json=materialUuid,proto3" json:"material_uuid,omitempty"` // UUID материала
+	Reaction      string                 `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`                             // Тип реакции
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReactionIn) Reset() {
+	*x = SetReactionIn{}
+	mi := &file_api_materials_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReactionIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReactionIn) ProtoMessage() {}
+
+func (x *SetReactionIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReactionIn.ProtoReflect.Descriptor instead.
+func (*SetReactionIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetReactionIn) GetMaterialUuid() string {
+	if x != nil {
+		return x.MaterialUuid
+	}
+	return ""
+}
+
+func (x *SetReactionIn) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+type ClearReactionIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialUuid  string                 `protobuf:"bytes,1,opt,name=material_uuid,json=materialUuid,proto3" json:"material_uuid,omitempty"` // UUID материала
+	Reaction      string                 `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`                             // Тип реакции
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearReactionIn) Reset() {
+	*x = ClearReactionIn{}
+	mi := &file_api_materials_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearReactionIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearReactionIn) ProtoMessage() {}
+
+func (x *ClearReactionIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearReactionIn.ProtoReflect.Descriptor instead.
+func (*ClearReactionIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ClearReactionIn) GetMaterialUuid() string {
+	if x != nil {
+		return x.MaterialUuid
+	}
+	return ""
+}
+
+func (x *ClearReactionIn) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+type ReactionsOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reactions     []*ReactionCount       `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`                        // Счётчики реакций по типам
+	MyReactions   []string               `protobuf:"bytes,2,rep,name=my_reactions,json=myReactions,proto3" json:"my_reactions,omitempty"` // Реакции текущего пользователя
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionsOut) Reset() {
+	*x = ReactionsOut{}
+	mi := &file_api_materials_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionsOut) ProtoMessage() {}
+
+func (x *ReactionsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionsOut.ProtoReflect.Descriptor instead.
+func (*ReactionsOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReactionsOut) GetReactions() []*ReactionCount {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *ReactionsOut) GetMyReactions() []string {
+	if x != nil {
+		return x.MyReactions
+	}
+	return nil
+}
+
+type GetTrendingMaterialsIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`   // Номер страницы, начиная с 1
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Количество материалов на странице
+	Tag           string                 `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`      // Фильтр по тегу, пустой — без фильтра
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendingMaterialsIn) Reset() {
+	*x = GetTrendingMaterialsIn{}
+	mi := &file_api_materials_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingMaterialsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingMaterialsIn) ProtoMessage() {}
+
+func (x *GetTrendingMaterialsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingMaterialsIn.ProtoReflect.Descriptor instead.
+func (*GetTrendingMaterialsIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetTrendingMaterialsIn) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetTrendingMaterialsIn) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetTrendingMaterialsIn) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type GetTrendingMaterialsOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialList  []*Material            `protobuf:"bytes,1,rep,name=material_list,json=materialList,proto3" json:"material_list,omitempty"` // Материалы по убыванию рейтинга
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendingMaterialsOut) Reset() {
+	*x = GetTrendingMaterialsOut{}
+	mi := &file_api_materials_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingMaterialsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingMaterialsOut) ProtoMessage() {}
+
+func (x *GetTrendingMaterialsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingMaterialsOut.ProtoReflect.Descriptor instead.
+func (*GetTrendingMaterialsOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetTrendingMaterialsOut) GetMaterialList() []*Material {
+	if x != nil {
+		return x.MaterialList
+	}
+	return nil
+}
+
+type GetRelatedMaterialsIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialUuid  string                 `protobuf:"bytes,1,opt,name=material_uuid,json=materialUuid,proto3" json:"material_uuid,omitempty"` // UUID материала
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                                    // Номер страницы, начиная с 1
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                  // Количество материалов на странице
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelatedMaterialsIn) Reset() {
+	*x = GetRelatedMaterialsIn{}
+	mi := &file_api_materials_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelatedMaterialsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedMaterialsIn) ProtoMessage() {}
+
+func (x *GetRelatedMaterialsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedMaterialsIn.ProtoReflect.Descriptor instead.
+func (*GetRelatedMaterialsIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetRelatedMaterialsIn) GetMaterialUuid() string {
+	if x != nil {
+		return x.MaterialUuid
+	}
+	return ""
+}
+
+func (x *GetRelatedMaterialsIn) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetRelatedMaterialsIn) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetRelatedMaterialsOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialList  []*Material            `protobuf:"bytes,1,rep,name=material_list,json=materialList,proto3" json:"material_list,omitempty"` // Похожие материалы по убыванию сходства
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelatedMaterialsOut) Reset() {
+	*x = GetRelatedMaterialsOut{}
+	mi := &file_api_materials_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelatedMaterialsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedMaterialsOut) ProtoMessage() {}
+
+func (x *GetRelatedMaterialsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedMaterialsOut.ProtoReflect.Descriptor instead.
+func (*GetRelatedMaterialsOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetRelatedMaterialsOut) GetMaterialList() []*Material {
+	if x != nil {
+		return x.MaterialList
+	}
+	return nil
+}
+
+type ReportMaterialIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialUuid  string                 `protobuf:"bytes,1,opt,name=material_uuid,json=materialUuid,proto3" json:"material_uuid,omitempty"` // UUID материала
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                                 // Код причины: spam, abuse, copyright, misinformation или other
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`                               // Комментарий, не более 1000 символов
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportMaterialIn) Reset() {
+	*x = ReportMaterialIn{}
+	mi := &file_api_materials_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportMaterialIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportMaterialIn) ProtoMessage() {}
+
+func (x *ReportMaterialIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportMaterialIn.ProtoReflect.Descriptor instead.
+func (*ReportMaterialIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ReportMaterialIn) GetMaterialUuid() string {
+	if x != nil {
+		return x.MaterialUuid
+	}
+	return ""
+}
+
+func (x *ReportMaterialIn) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReportMaterialIn) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type ReportMaterialOut struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ReportUuid     string                 `protobuf:"bytes,1,opt,name=report_uuid,json=reportUuid,proto3" json:"report_uuid,omitempty"`              // UUID жалобы
+	MaterialHidden bool                   `protobuf:"varint,2,opt,name=material_hidden,json=materialHidden,proto3" json:"material_hidden,omitempty"` // Материал скрыт после достижения порога жалоб
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReportMaterialOut) Reset() {
+	*x = ReportMaterialOut{}
+	mi := &file_api_materials_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportMaterialOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportMaterialOut) ProtoMessage() {}
+
+func (x *ReportMaterialOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportMaterialOut.ProtoReflect.Descriptor instead.
+func (*ReportMaterialOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ReportMaterialOut) GetReportUuid() string {
+	if x != nil {
+		return x.ReportUuid
+	}
+	return ""
+}
+
+func (x *ReportMaterialOut) GetMaterialHidden() bool {
+	if x != nil {
+		return x.MaterialHidden
+	}
+	return false
+}
+
+type MaterialReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	MaterialUuid  string                 `protobuf:"bytes,2,opt,name=material_uuid,json=materialUuid,proto3" json:"material_uuid,omitempty"`
+	ReporterUuid  string                 `protobuf:"bytes,3,opt,name=reporter_uuid,json=reporterUuid,proto3" json:"reporter_uuid,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // open, actioned или dismissed
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ResolvedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	ResolvedBy    string                 `protobuf:"bytes,9,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"` // UUID модератора
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaterialReport) Reset() {
+	*x = MaterialReport{}
+	mi := &file_api_materials_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaterialReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaterialReport) ProtoMessage() {}
+
+func (x *MaterialReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaterialReport.ProtoReflect.Descriptor instead.
+func (*MaterialReport) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MaterialReport) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *MaterialReport) GetMaterialUuid() string {
+	if x != nil {
+		return x.MaterialUuid
+	}
+	return ""
+}
+
+func (x *MaterialReport) GetReporterUuid() string {
+	if x != nil {
+		return x.ReporterUuid
+	}
+	return ""
+}
+
+func (x *MaterialReport) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MaterialReport) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *MaterialReport) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MaterialReport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MaterialReport) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+func (x *MaterialReport) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+type ListMaterialReportsIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialUuid  string                 `protobuf:"bytes,1,opt,name=material_uuid,json=materialUuid,proto3" json:"material_uuid,omitempty"` // Фильтр по материалу, пустой — все открытые жалобы
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                                    // Номер страницы, начиная с 1
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                  // Количество жалоб на странице
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMaterialReportsIn) Reset() {
+	*x = ListMaterialReportsIn{}
+	mi := &file_api_materials_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMaterialReportsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaterialReportsIn) ProtoMessage() {}
+
+func (x *ListMaterialReportsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaterialReportsIn.ProtoReflect.Descriptor instead.
+func (*ListMaterialReportsIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListMaterialReportsIn) GetMaterialUuid() string {
+	if x != nil {
+		return x.MaterialUuid
+	}
+	return ""
+}
+
+func (x *ListMaterialReportsIn) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMaterialReportsIn) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListMaterialReportsOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reports       []*MaterialReport      `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMaterialReportsOut) Reset() {
+	*x = ListMaterialReportsOut{}
+	mi := &file_api_materials_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMaterialReportsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaterialReportsOut) ProtoMessage() {}
+
+func (x *ListMaterialReportsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaterialReportsOut.ProtoReflect.Descriptor instead.
+func (*ListMaterialReportsOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListMaterialReportsOut) GetReports() []*MaterialReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+type ResolveMaterialReportIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportUuid    string                 `protobuf:"bytes,1,opt,name=report_uuid,json=reportUuid,proto3" json:"report_uuid,omitempty"` // UUID жалобы
+	Resolution    string                 `protobuf:"bytes,2,opt,name=resolution,proto3" json:"resolution,omitempty"`                   // actioned или dismissed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveMaterialReportIn) Reset() {
+	*x = ResolveMaterialReportIn{}
+	mi := &file_api_materials_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveMaterialReportIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveMaterialReportIn) ProtoMessage() {}
+
+func (x *ResolveMaterialReportIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveMaterialReportIn.ProtoReflect.Descriptor instead.
+func (*ResolveMaterialReportIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ResolveMaterialReportIn) GetReportUuid() string {
+	if x != nil {
+		return x.ReportUuid
+	}
+	return ""
+}
+
+func (x *ResolveMaterialReportIn) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+type ResolveMaterialReportOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *MaterialReport        `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveMaterialReportOut) Reset() {
+	*x = ResolveMaterialReportOut{}
+	mi := &file_api_materials_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveMaterialReportOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveMaterialReportOut) ProtoMessage() {}
+
+func (x *ResolveMaterialReportOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveMaterialReportOut.ProtoReflect.Descriptor instead.
+func (*ResolveMaterialReportOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ResolveMaterialReportOut) GetReport() *MaterialReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type HideMaterialIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialUuid  string                 `protobuf:"bytes,1,opt,name=material_uuid,json=materialUuid,proto3" json:"material_uuid,omitempty"` // UUID материала
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                                 // Причина скрытия
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HideMaterialIn) Reset() {
+	*x = HideMaterialIn{}
+	mi := &file_api_materials_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HideMaterialIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HideMaterialIn) ProtoMessage() {}
+
+func (x *HideMaterialIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HideMaterialIn.ProtoReflect.Descriptor instead.
+func (*HideMaterialIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *HideMaterialIn) GetMaterialUuid() string {
+	if x != nil {
+		return x.MaterialUuid
+	}
+	return ""
+}
+
+func (x *HideMaterialIn) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UnhideMaterialIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialUuid  string                 `protobuf:"bytes,1,opt,name=material_uuid,json=materialUuid,proto3" json:"material_uuid,omitempty"` // UUID материала
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnhideMaterialIn) Reset() {
+	*x = UnhideMaterialIn{}
+	mi := &file_api_materials_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnhideMaterialIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnhideMaterialIn) ProtoMessage() {}
+
+func (x *UnhideMaterialIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnhideMaterialIn.ProtoReflect.Descriptor instead.
+func (*UnhideMaterialIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UnhideMaterialIn) GetMaterialUuid() string {
+	if x != nil {
+		return x.MaterialUuid
+	}
+	return ""
+}
+
+type ModerateMaterialOut struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ResolvedReports int32                  `protobuf:"varint,1,opt,name=resolved_reports,json=resolvedReports,proto3" json:"resolved_reports,omitempty"` // Количество закрытых вместе с решением открытых жалоб
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ModerateMaterialOut) Reset() {
+	*x = ModerateMaterialOut{}
+	mi := &file_api_materials_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateMaterialOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateMaterialOut) ProtoMessage() {}
+
+func (x *ModerateMaterialOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateMaterialOut.ProtoReflect.Descriptor instead.
+func (*ModerateMaterialOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ModerateMaterialOut) GetResolvedReports() int32 {
+	if x != nil {
+		return x.ResolvedReports
+	}
+	return 0
+}
+
+type CoverThumbnail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Width         int32                  `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"` // Ширина миниатюры в пикселях
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`      // URL миниатюры
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoverThumbnail) Reset() {
+	*x = CoverThumbnail{}
+	mi := &file_api_materials_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoverThumbnail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoverThumbnail) ProtoMessage() {}
+
+func (x *CoverThumbnail) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoverThumbnail.ProtoReflect.Descriptor instead.
+func (*CoverThumbnail) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CoverThumbnail) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *CoverThumbnail) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// Первое сообщение потока должно содержать material_uuid, далее передаются части файла
+type UploadCoverIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialUuid  string                 `protobuf:"bytes,1,opt,name=material_uuid,json=materialUuid,proto3" json:"material_uuid,omitempty"` // UUID материала
+	Chunk         []byte                 `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`                                   // Часть файла изображения (jpeg или png)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadCoverIn) Reset() {
+	*x = UploadCoverIn{}
+	mi := &file_api_materials_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadCoverIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCoverIn) ProtoMessage() {}
+
+func (x *UploadCoverIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCoverIn.ProtoReflect.Descriptor instead.
+func (*UploadCoverIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UploadCoverIn) GetMaterialUuid() string {
+	if x != nil {
+		return x.MaterialUuid
+	}
+	return ""
+}
+
+func (x *UploadCoverIn) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type UploadCoverOut struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CoverImageUrl   string                 `protobuf:"bytes,1,opt,name=cover_image_url,json=coverImageUrl,proto3" json:"cover_image_url,omitempty"`     // URL обложки
+	CoverThumbnails []*CoverThumbnail      `protobuf:"bytes,2,rep,name=cover_thumbnails,json=coverThumbnails,proto3" json:"cover_thumbnails,omitempty"` // Уменьшенные копии обложки
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UploadCoverOut) Reset() {
+	*x = UploadCoverOut{}
+	mi := &file_api_materials_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadCoverOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCoverOut) ProtoMessage() {}
+
+func (x *UploadCoverOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCoverOut.ProtoReflect.Descriptor instead.
+func (*UploadCoverOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UploadCoverOut) GetCoverImageUrl() string {
+	if x != nil {
+		return x.CoverImageUrl
+	}
+	return ""
+}
+
+func (x *UploadCoverOut) GetCoverThumbnails() []*CoverThumbnail {
+	if x != nil {
+		return x.CoverThumbnails
+	}
+	return nil
+}
+
+// Вложение материала, в content на него ссылаются как attachment://<uuid>
+type MaterialAttachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	MaterialUuid  string                 `protobuf:"bytes,2,opt,name=material_uuid,json=materialUuid,proto3" json:"material_uuid,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`                          // Исходное имя файла
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // MIME-тип, определённый по содержимому файла
+	SizeBytes     int64                  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`      // Размер файла в байтах
+	Url           string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`                                    // URL файла
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaterialAttachment) Reset() {
+	*x = MaterialAttachment{}
+	mi := &file_api_materials_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaterialAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaterialAttachment) ProtoMessage() {}
+
+func (x *MaterialAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaterialAttachment.ProtoReflect.Descriptor instead.
+func (*MaterialAttachment) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *MaterialAttachment) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *MaterialAttachment) GetMaterialUuid() string {
+	if x != nil {
+		return x.MaterialUuid
+	}
+	return ""
+}
+
+func (x *MaterialAttachment) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *MaterialAttachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *MaterialAttachment) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *MaterialAttachment) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MaterialAttachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Первое сообщение потока должно содержать material_uuid и filename, далее передаются части файла
+type UploadAttachmentIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialUuid  string                 `protobuf:"bytes,1,opt,name=material_uuid,json=materialUuid,proto3" json:"material_uuid,omitempty"` // UUID материала
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`                             // Имя файла
+	Chunk         []byte                 `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`                                   // Часть файла
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentIn) Reset() {
+	*x = UploadAttachmentIn{}
+	mi := &file_api_materials_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentIn) ProtoMessage() {}
+
+func (x *UploadAttachmentIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentIn.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *UploadAttachmentIn) GetMaterialUuid() string {
+	if x != nil {
+		return x.MaterialUuid
+	}
+	return ""
+}
+
+func (x *UploadAttachmentIn) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *UploadAttachmentIn) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type UploadAttachmentOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *MaterialAttachment    `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentOut) Reset() {
+	*x = UploadAttachmentOut{}
+	mi := &file_api_materials_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentOut) ProtoMessage() {}
+
+func (x *UploadAttachmentOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentOut.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UploadAttachmentOut) GetAttachment() *MaterialAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type ListMaterialAttachmentsIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialUuid  string                 `protobuf:"bytes,1,opt,name=material_uuid,json=materialUuid,proto3" json:"material_uuid,omitempty"` // UUID материала
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMaterialAttachmentsIn) Reset() {
+	*x = ListMaterialAttachmentsIn{}
+	mi := &file_api_materials_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMaterialAttachmentsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaterialAttachmentsIn) ProtoMessage() {}
+
+func (x *ListMaterialAttachmentsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaterialAttachmentsIn.ProtoReflect.Descriptor instead.
+func (*ListMaterialAttachmentsIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListMaterialAttachmentsIn) GetMaterialUuid() string {
+	if x != nil {
+		return x.MaterialUuid
+	}
+	return ""
+}
+
+type ListMaterialAttachmentsOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachments   []*MaterialAttachment  `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMaterialAttachmentsOut) Reset() {
+	*x = ListMaterialAttachmentsOut{}
+	mi := &file_api_materials_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMaterialAttachmentsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaterialAttachmentsOut) ProtoMessage() {}
+
+func (x *ListMaterialAttachmentsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaterialAttachmentsOut.ProtoReflect.Descriptor instead.
+func (*ListMaterialAttachmentsOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListMaterialAttachmentsOut) GetAttachments() []*MaterialAttachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type DeleteMaterialAttachmentIn struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MaterialUuid   string                 `protobuf:"bytes,1,opt,name=material_uuid,json=materialUuid,proto3" json:"material_uuid,omitempty"`       // UUID материала
+	AttachmentUuid string                 `protobuf:"bytes,2,opt,name=attachment_uuid,json=attachmentUuid,proto3" json:"attachment_uuid,omitempty"` // UUID вложения
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteMaterialAttachmentIn) Reset() {
+	*x = DeleteMaterialAttachmentIn{}
+	mi := &file_api_materials_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMaterialAttachmentIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMaterialAttachmentIn) ProtoMessage() {}
+
+func (x *DeleteMaterialAttachmentIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMaterialAttachmentIn.ProtoReflect.Descriptor instead.
+func (*DeleteMaterialAttachmentIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DeleteMaterialAttachmentIn) GetMaterialUuid() string {
+	if x != nil {
+		return x.MaterialUuid
+	}
+	return ""
+}
+
+func (x *DeleteMaterialAttachmentIn) GetAttachmentUuid() string {
+	if x != nil {
+		return x.AttachmentUuid
+	}
+	return ""
+}
+
+type ExportMaterialsIn struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OwnerUuid      string                 `protobuf:"bytes,1,opt,name=owner_uuid,json=ownerUuid,proto3" json:"owner_uuid,omitempty"`                 // Только материалы владельца, пусто — все
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                                        // draft, published или archived, пусто — любой статус
+	UpdatedSince   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_since,json=updatedSince,proto3" json:"updated_since,omitempty"`        // Только изменённые начиная с этого момента включительно
+	IncludeDeleted bool                   `protobuf:"varint,4,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // Выгружать и материалы из корзины
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExportMaterialsIn) Reset() {
+	*x = ExportMaterialsIn{}
+	mi := &file_api_materials_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMaterialsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMaterialsIn) ProtoMessage() {}
+
+func (x *ExportMaterialsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMaterialsIn.ProtoReflect.Descriptor instead.
+func (*ExportMaterialsIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ExportMaterialsIn) GetOwnerUuid() string {
+	if x != nil {
+		return x.OwnerUuid
+	}
+	return ""
+}
+
+func (x *ExportMaterialsIn) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExportMaterialsIn) GetUpdatedSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedSince
+	}
+	return nil
+}
+
+func (x *ExportMaterialsIn) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ExportMaterialIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialUuid  string                 `protobuf:"bytes,1,opt,name=material_uuid,json=materialUuid,proto3" json:"material_uuid,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // markdown, html или zip, пусто — markdown
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMaterialIn) Reset() {
+	*x = ExportMaterialIn{}
+	mi := &file_api_materials_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMaterialIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMaterialIn) ProtoMessage() {}
+
+func (x *ExportMaterialIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMaterialIn.ProtoReflect.Descriptor instead.
+func (*ExportMaterialIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ExportMaterialIn) GetMaterialUuid() string {
+	if x != nil {
+		return x.MaterialUuid
+	}
+	return ""
+}
+
+func (x *ExportMaterialIn) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportMaterialOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMaterialOut) Reset() {
+	*x = ExportMaterialOut{}
+	mi := &file_api_materials_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMaterialOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMaterialOut) ProtoMessage() {}
+
+func (x *ExportMaterialOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMaterialOut.ProtoReflect.Descriptor instead.
+func (*ExportMaterialOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ExportMaterialOut) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportMaterialOut) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportMaterialOut) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExportUserDataIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid      string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataIn) Reset() {
+	*x = ExportUserDataIn{}
+	mi := &file_api_materials_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataIn) ProtoMessage() {}
+
+func (x *ExportUserDataIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataIn.ProtoReflect.Descriptor instead.
+func (*ExportUserDataIn) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ExportUserDataIn) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+type ExportUserDataOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"` // UserDataArchive в JSON
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataOut) Reset() {
+	*x = ExportUserDataOut{}
+	mi := &file_api_materials_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataOut) ProtoMessage() {}
+
+func (x *ExportUserDataOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataOut.ProtoReflect.Descriptor instead.
+func (*ExportUserDataOut) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ExportUserDataOut) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportUserDataOut) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportUserDataOut) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Выгрузка всех данных пользователя, которые хранит сервис
+type UserDataArchive struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid      string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	ExportedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+	Profile       *UserProfile           `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`         // Зеркало профиля, пусто, если его нет
+	Materials     []*UserDataMaterial    `protobuf:"bytes,4,rep,name=materials,proto3" json:"materials,omitempty"`     // Материалы пользователя, включая черновики и корзину
+	Reactions     []*UserReaction        `protobuf:"bytes,5,rep,name=reactions,proto3" json:"reactions,omitempty"`     // Реакции пользователя на материалы
+	Reports       []*MaterialReport      `protobuf:"bytes,6,rep,name=reports,proto3" json:"reports,omitempty"`         // Жалобы, поданные пользователем
+	Attachments   []*MaterialAttachment  `protobuf:"bytes,7,rep,name=attachments,proto3" json:"attachments,omitempty"` // Загруженные пользователем вложения
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDataArchive) Reset() {
+	*x = UserDataArchive{}
+	mi := &file_api_materials_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataArchive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataArchive) ProtoMessage() {}
+
+func (x *UserDataArchive) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataArchive.ProtoReflect.Descriptor instead.
+func (*UserDataArchive) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UserDataArchive) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *UserDataArchive) GetExportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return nil
+}
+
+func (x *UserDataArchive) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *UserDataArchive) GetMaterials() []*UserDataMaterial {
+	if x != nil {
+		return x.Materials
+	}
+	return nil
+}
+
+func (x *UserDataArchive) GetReactions() []*UserReaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *UserDataArchive) GetReports() []*MaterialReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *UserDataArchive) GetAttachments() []*MaterialAttachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type UserProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	AvatarLink    string                 `protobuf:"bytes,3,opt,name=avatar_link,json=avatarLink,proto3" json:"avatar_link,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Surname       string                 `protobuf:"bytes,5,opt,name=surname,proto3" json:"surname,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
+	mi := &file_api_materials_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfile) ProtoMessage() {}
+
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UserProfile) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UserProfile) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *UserProfile) GetAvatarLink() string {
+	if x != nil {
+		return x.AvatarLink
+	}
+	return ""
+}
+
+func (x *UserProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserProfile) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+type UserDataMaterial struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Material      *Material              `protobuf:"bytes,1,opt,name=material,proto3" json:"material,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDataMaterial) Reset() {
+	*x = UserDataMaterial{}
+	mi := &file_api_materials_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataMaterial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataMaterial) ProtoMessage() {}
+
+func (x *UserDataMaterial) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataMaterial.ProtoReflect.Descriptor instead.
+func (*UserDataMaterial) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UserDataMaterial) GetMaterial() *Material {
+	if x != nil {
+		return x.Material
+	}
+	return nil
+}
+
+func (x *UserDataMaterial) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type UserReaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialUuid  string                 `protobuf:"bytes,1,opt,name=material_uuid,json=materialUuid,proto3" json:"material_uuid,omitempty"`
+	Reaction      string                 `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserReaction) Reset() {
+	*x = UserReaction{}
+	mi := &file_api_materials_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserReaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserReaction) ProtoMessage() {}
+
+func (x *UserReaction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserReaction.ProtoReflect.Descriptor instead.
+func (*UserReaction) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UserReaction) GetMaterialUuid() string {
+	if x != nil {
+		return x.MaterialUuid
+	}
+	return ""
+}
+
+func (x *UserReaction) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+func (x *UserReaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type MaterialDeletedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	OwnerUuid     string                 `protobuf:"bytes,2,opt,name=owner_uuid,json=ownerUuid,proto3" json:"owner_uuid,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaterialDeletedMessage) Reset() {
+	*x = MaterialDeletedMessage{}
+	mi := &file_api_materials_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaterialDeletedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaterialDeletedMessage) ProtoMessage() {}
+
+func (x *MaterialDeletedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaterialDeletedMessage.ProtoReflect.Descriptor instead.
+func (*MaterialDeletedMessage) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *MaterialDeletedMessage) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *MaterialDeletedMessage) GetOwnerUuid() string {
+	if x != nil {
+		return x.OwnerUuid
+	}
+	return ""
+}
+
+func (x *MaterialDeletedMessage) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type CreatedMaterial struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Material      *Material              `protobuf:"bytes,1,opt,name=material,proto3" json:"material,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatedMaterial) Reset() {
+	*x = CreatedMaterial{}
+	mi := &file_api_materials_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatedMaterial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatedMaterial) ProtoMessage() {}
+
+func (x *CreatedMaterial) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatedMaterial.ProtoReflect.Descriptor instead.
+func (*CreatedMaterial) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CreatedMaterial) GetMaterial() *Material {
+	if x != nil {
+		return x.Material
+	}
+	return nil
+}
+
+type ToggleLikeMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialUuid  string                 `protobuf:"bytes,1,opt,name=material_uuid,json=materialUuid,proto3" json:"material_uuid,omitempty"`
+	IsLiked       bool                   `protobuf:"varint,2,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
+	LikesCount    int32                  `protobuf:"varint,3,opt,name=likes_count,json=likesCount,proto3" json:"likes_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleLikeMessage) Reset() {
+	*x = ToggleLikeMessage{}
+	mi := &file_api_materials_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleLikeMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleLikeMessage) ProtoMessage() {}
+
+func (x *ToggleLikeMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleLikeMessage.ProtoReflect.Descriptor instead.
+func (*ToggleLikeMessage) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ToggleLikeMessage) GetMaterialUuid() string {
+	if x != nil {
+		return x.MaterialUuid
+	}
+	return ""
+}
+
+func (x *ToggleLikeMessage) GetIsLiked() bool {
+	if x != nil {
+		return x.IsLiked
+	}
+	return false
 }
 
 func (x *ToggleLikeMessage) GetLikesCount() int32 {
@@ -999,7 +4343,7 @@ type EditMaterialMessage struct {
 
 func (x *EditMaterialMessage) Reset() {
 	*x = EditMaterialMessage{}
-	mi := &file_api_materials_proto_msgTypes[17]
+	mi := &file_api_materials_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1011,7 +4355,7 @@ func (x *EditMaterialMessage) String() string {
 func (*EditMaterialMessage) ProtoMessage() {}
 
 func (x *EditMaterialMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_api_materials_proto_msgTypes[17]
+	mi := &file_api_materials_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1024,7 +4368,7 @@ func (x *EditMaterialMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditMaterialMessage.ProtoReflect.Descriptor instead.
 func (*EditMaterialMessage) Descriptor() ([]byte, []int) {
-	return file_api_materials_proto_rawDescGZIP(), []int{17}
+	return file_api_materials_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *EditMaterialMessage) GetUuid() string {
@@ -1055,6 +4399,174 @@ func (x *EditMaterialMessage) GetEditedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+type BulkOperationMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"` // delete, archive, publish, tag, unarchive или restore
+	OwnerUuid     string                 `protobuf:"bytes,2,opt,name=owner_uuid,json=ownerUuid,proto3" json:"owner_uuid,omitempty"`
+	Uuids         []string               `protobuf:"bytes,3,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	ProcessedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=processed_at,json=processedAt,proto3" json:"processed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkOperationMessage) Reset() {
+	*x = BulkOperationMessage{}
+	mi := &file_api_materials_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkOperationMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkOperationMessage) ProtoMessage() {}
+
+func (x *BulkOperationMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkOperationMessage.ProtoReflect.Descriptor instead.
+func (*BulkOperationMessage) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *BulkOperationMessage) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *BulkOperationMessage) GetOwnerUuid() string {
+	if x != nil {
+		return x.OwnerUuid
+	}
+	return ""
+}
+
+func (x *BulkOperationMessage) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *BulkOperationMessage) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *BulkOperationMessage) GetProcessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProcessedAt
+	}
+	return nil
+}
+
+type ModerationDecisionMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"` // auto_hidden, hidden, unhidden или report_resolved
+	MaterialUuid  string                 `protobuf:"bytes,2,opt,name=material_uuid,json=materialUuid,proto3" json:"material_uuid,omitempty"`
+	ReportUuid    string                 `protobuf:"bytes,3,opt,name=report_uuid,json=reportUuid,proto3" json:"report_uuid,omitempty"`
+	ModeratorUuid string                 `protobuf:"bytes,4,opt,name=moderator_uuid,json=moderatorUuid,proto3" json:"moderator_uuid,omitempty"` // пусто для автоматического скрытия
+	Resolution    string                 `protobuf:"bytes,5,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	DecidedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerationDecisionMessage) Reset() {
+	*x = ModerationDecisionMessage{}
+	mi := &file_api_materials_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationDecisionMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationDecisionMessage) ProtoMessage() {}
+
+func (x *ModerationDecisionMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_materials_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationDecisionMessage.ProtoReflect.Descriptor instead.
+func (*ModerationDecisionMessage) Descriptor() ([]byte, []int) {
+	return file_api_materials_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ModerationDecisionMessage) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ModerationDecisionMessage) GetMaterialUuid() string {
+	if x != nil {
+		return x.MaterialUuid
+	}
+	return ""
+}
+
+func (x *ModerationDecisionMessage) GetReportUuid() string {
+	if x != nil {
+		return x.ReportUuid
+	}
+	return ""
+}
+
+func (x *ModerationDecisionMessage) GetModeratorUuid() string {
+	if x != nil {
+		return x.ModeratorUuid
+	}
+	return ""
+}
+
+func (x *ModerationDecisionMessage) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *ModerationDecisionMessage) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ModerationDecisionMessage) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
 var File_api_materials_proto protoreflect.FileDescriptor
 
 const file_api_materials_proto_rawDesc = "" +
@@ -1071,7 +4583,11 @@ const file_api_materials_proto_rawDesc = "" +
 	"\rGetMaterialIn\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"7\n" +
 	"\x0eGetMaterialOut\x12%\n" +
-	"\bmaterial\x18\x01 \x01(\v2\t.MaterialR\bmaterial\"\xc7\x04\n" +
+	"\bmaterial\x18\x01 \x01(\v2\t.MaterialR\bmaterial\"-\n" +
+	"\x15GetMaterialsByUUIDsIn\x12\x14\n" +
+	"\x05uuids\x18\x01 \x03(\tR\x05uuids\"A\n" +
+	"\x16GetMaterialsByUUIDsOut\x12'\n" +
+	"\tmaterials\x18\x01 \x03(\v2\t.MaterialR\tmaterials\"\xa0\a\n" +
 	"\bMaterial\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1d\n" +
 	"\n" +
@@ -1092,7 +4608,16 @@ const file_api_materials_proto_rawDesc = "" +
 	"\n" +
 	"deleted_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1f\n" +
 	"\vlikes_count\x18\x0e \x01(\x05R\n" +
-	"likesCount\"D\n" +
+	"likesCount\x12(\n" +
+	"\x10forked_from_uuid\x18\x0f \x01(\tR\x0eforkedFromUuid\x12\x1f\n" +
+	"\vforks_count\x18\x10 \x01(\x05R\n" +
+	"forksCount\x12,\n" +
+	"\treactions\x18\x11 \x03(\v2\x0e.ReactionCountR\treactions\x12!\n" +
+	"\fmy_reactions\x18\x12 \x03(\tR\vmyReactions\x12:\n" +
+	"\x10cover_thumbnails\x18\x13 \x03(\v2\x0f.CoverThumbnailR\x0fcoverThumbnails\x129\n" +
+	"\n" +
+	"updated_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12D\n" +
+	"\x10owner_deleted_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\x0eownerDeletedAt\"D\n" +
 	"\x12GetAllMaterialsOut\x12.\n" +
 	"\rmaterial_list\x18\x01 \x03(\v2\t.MaterialR\fmaterialList\"\xca\x01\n" +
 	"\x0eEditMaterialIn\x12\x12\n" +
@@ -1117,7 +4642,218 @@ const file_api_materials_proto_rawDesc = "" +
 	"\rToggleLikeOut\x12\x19\n" +
 	"\bis_liked\x18\x01 \x01(\bR\aisLiked\x12\x1f\n" +
 	"\vlikes_count\x18\x02 \x01(\x05R\n" +
-	"likesCount\"\x86\x01\n" +
+	"likesCount\"\xcb\x01\n" +
+	"\x0fAutosaveDraftIn\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12&\n" +
+	"\x0fcover_image_url\x18\x03 \x01(\tR\rcoverImageUrl\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12*\n" +
+	"\x11read_time_minutes\x18\x06 \x01(\x05R\x0freadTimeMinutes\"I\n" +
+	"\x10AutosaveDraftOut\x125\n" +
+	"\bsaved_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\asavedAt\"'\n" +
+	"\x11PromoteAutosaveIn\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\";\n" +
+	"\x12PromoteAutosaveOut\x12%\n" +
+	"\bmaterial\x18\x01 \x01(\v2\t.MaterialR\bmaterial\")\n" +
+	"\x13DuplicateMaterialIn\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"=\n" +
+	"\x14DuplicateMaterialOut\x12%\n" +
+	"\bmaterial\x18\x01 \x01(\v2\t.MaterialR\bmaterial\"A\n" +
+	"\x15GetDeletedMaterialsIn\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"H\n" +
+	"\x16GetDeletedMaterialsOut\x12.\n" +
+	"\rmaterial_list\x18\x01 \x03(\v2\t.MaterialR\fmaterialList\"'\n" +
+	"\x11RestoreMaterialIn\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\";\n" +
+	"\x12RestoreMaterialOut\x12%\n" +
+	"\bmaterial\x18\x01 \x01(\v2\t.MaterialR\bmaterial\")\n" +
+	"\x13UnarchiveMaterialIn\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"=\n" +
+	"\x14UnarchiveMaterialOut\x12%\n" +
+	"\bmaterial\x18\x01 \x01(\v2\t.MaterialR\bmaterial\"B\n" +
+	"\x16GetArchivedMaterialsIn\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"I\n" +
+	"\x17GetArchivedMaterialsOut\x12.\n" +
+	"\rmaterial_list\x18\x01 \x03(\v2\t.MaterialR\fmaterialList\"'\n" +
+	"\x0fBulkMaterialsIn\x12\x14\n" +
+	"\x05uuids\x18\x01 \x03(\tR\x05uuids\">\n" +
+	"\x12BulkTagMaterialsIn\x12\x14\n" +
+	"\x05uuids\x18\x01 \x03(\tR\x05uuids\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\"T\n" +
+	"\x0eBulkItemResult\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"=\n" +
+	"\x10BulkMaterialsOut\x12)\n" +
+	"\aresults\x18\x01 \x03(\v2\x0f.BulkItemResultR\aresults\"F\n" +
+	"\tSetLikeIn\x12#\n" +
+	"\rmaterial_uuid\x18\x01 \x01(\tR\fmaterialUuid\x12\x14\n" +
+	"\x05liked\x18\x02 \x01(\bR\x05liked\"H\n" +
+	"\n" +
+	"SetLikeOut\x12\x19\n" +
+	"\bis_liked\x18\x01 \x01(\bR\aisLiked\x12\x1f\n" +
+	"\vlikes_count\x18\x02 \x01(\x05R\n" +
+	"likesCount\"e\n" +
+	"\x14ListMaterialLikersIn\x12#\n" +
+	"\rmaterial_uuid\x18\x01 \x01(\tR\fmaterialUuid\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xc3\x01\n" +
+	"\vUserSummary\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1a\n" +
+	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x1f\n" +
+	"\vavatar_link\x18\x03 \x01(\tR\n" +
+	"avatarLink\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x18\n" +
+	"\asurname\x18\x05 \x01(\tR\asurname\x125\n" +
+	"\bliked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\alikedAt\"=\n" +
+	"\x15ListMaterialLikersOut\x12$\n" +
+	"\x06likers\x18\x01 \x03(\v2\f.UserSummaryR\x06likers\"A\n" +
+	"\rReactionCount\x12\x1a\n" +
+	"\breaction\x18\x01 \x01(\tR\breaction\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"P\n" +
+	"\rSetReactionIn\x12#\n" +
+	"\rmaterial_uuid\x18\x01 \x01(\tR\fmaterialUuid\x12\x1a\n" +
+	"\breaction\x18\x02 \x01(\tR\breaction\"R\n" +
+	"\x0fClearReactionIn\x12#\n" +
+	"\rmaterial_uuid\x18\x01 \x01(\tR\fmaterialUuid\x12\x1a\n" +
+	"\breaction\x18\x02 \x01(\tR\breaction\"_\n" +
+	"\fReactionsOut\x12,\n" +
+	"\treactions\x18\x01 \x03(\v2\x0e.ReactionCountR\treactions\x12!\n" +
+	"\fmy_reactions\x18\x02 \x03(\tR\vmyReactions\"T\n" +
+	"\x16GetTrendingMaterialsIn\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x10\n" +
+	"\x03tag\x18\x03 \x01(\tR\x03tag\"I\n" +
+	"\x17GetTrendingMaterialsOut\x12.\n" +
+	"\rmaterial_list\x18\x01 \x03(\v2\t.MaterialR\fmaterialList\"f\n" +
+	"\x15GetRelatedMaterialsIn\x12#\n" +
+	"\rmaterial_uuid\x18\x01 \x01(\tR\fmaterialUuid\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"H\n" +
+	"\x16GetRelatedMaterialsOut\x12.\n" +
+	"\rmaterial_list\x18\x01 \x03(\v2\t.MaterialR\fmaterialList\"i\n" +
+	"\x10ReportMaterialIn\x12#\n" +
+	"\rmaterial_uuid\x18\x01 \x01(\tR\fmaterialUuid\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\"]\n" +
+	"\x11ReportMaterialOut\x12\x1f\n" +
+	"\vreport_uuid\x18\x01 \x01(\tR\n" +
+	"reportUuid\x12'\n" +
+	"\x0fmaterial_hidden\x18\x02 \x01(\bR\x0ematerialHidden\"\xd1\x02\n" +
+	"\x0eMaterialReport\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12#\n" +
+	"\rmaterial_uuid\x18\x02 \x01(\tR\fmaterialUuid\x12#\n" +
+	"\rreporter_uuid\x18\x03 \x01(\tR\freporterUuid\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vresolved_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\x12\x1f\n" +
+	"\vresolved_by\x18\t \x01(\tR\n" +
+	"resolvedBy\"f\n" +
+	"\x15ListMaterialReportsIn\x12#\n" +
+	"\rmaterial_uuid\x18\x01 \x01(\tR\fmaterialUuid\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"C\n" +
+	"\x16ListMaterialReportsOut\x12)\n" +
+	"\areports\x18\x01 \x03(\v2\x0f.MaterialReportR\areports\"Z\n" +
+	"\x17ResolveMaterialReportIn\x12\x1f\n" +
+	"\vreport_uuid\x18\x01 \x01(\tR\n" +
+	"reportUuid\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x02 \x01(\tR\n" +
+	"resolution\"C\n" +
+	"\x18ResolveMaterialReportOut\x12'\n" +
+	"\x06report\x18\x01 \x01(\v2\x0f.MaterialReportR\x06report\"M\n" +
+	"\x0eHideMaterialIn\x12#\n" +
+	"\rmaterial_uuid\x18\x01 \x01(\tR\fmaterialUuid\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"7\n" +
+	"\x10UnhideMaterialIn\x12#\n" +
+	"\rmaterial_uuid\x18\x01 \x01(\tR\fmaterialUuid\"@\n" +
+	"\x13ModerateMaterialOut\x12)\n" +
+	"\x10resolved_reports\x18\x01 \x01(\x05R\x0fresolvedReports\"8\n" +
+	"\x0eCoverThumbnail\x12\x14\n" +
+	"\x05width\x18\x01 \x01(\x05R\x05width\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"J\n" +
+	"\rUploadCoverIn\x12#\n" +
+	"\rmaterial_uuid\x18\x01 \x01(\tR\fmaterialUuid\x12\x14\n" +
+	"\x05chunk\x18\x02 \x01(\fR\x05chunk\"t\n" +
+	"\x0eUploadCoverOut\x12&\n" +
+	"\x0fcover_image_url\x18\x01 \x01(\tR\rcoverImageUrl\x12:\n" +
+	"\x10cover_thumbnails\x18\x02 \x03(\v2\x0f.CoverThumbnailR\x0fcoverThumbnails\"\xf8\x01\n" +
+	"\x12MaterialAttachment\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12#\n" +
+	"\rmaterial_uuid\x18\x02 \x01(\tR\fmaterialUuid\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x05 \x01(\x03R\tsizeBytes\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"k\n" +
+	"\x12UploadAttachmentIn\x12#\n" +
+	"\rmaterial_uuid\x18\x01 \x01(\tR\fmaterialUuid\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x14\n" +
+	"\x05chunk\x18\x03 \x01(\fR\x05chunk\"J\n" +
+	"\x13UploadAttachmentOut\x123\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x13.MaterialAttachmentR\n" +
+	"attachment\"@\n" +
+	"\x19ListMaterialAttachmentsIn\x12#\n" +
+	"\rmaterial_uuid\x18\x01 \x01(\tR\fmaterialUuid\"S\n" +
+	"\x1aListMaterialAttachmentsOut\x125\n" +
+	"\vattachments\x18\x01 \x03(\v2\x13.MaterialAttachmentR\vattachments\"j\n" +
+	"\x1aDeleteMaterialAttachmentIn\x12#\n" +
+	"\rmaterial_uuid\x18\x01 \x01(\tR\fmaterialUuid\x12'\n" +
+	"\x0fattachment_uuid\x18\x02 \x01(\tR\x0eattachmentUuid\"\xb4\x01\n" +
+	"\x11ExportMaterialsIn\x12\x1d\n" +
+	"\n" +
+	"owner_uuid\x18\x01 \x01(\tR\townerUuid\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12?\n" +
+	"\rupdated_since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fupdatedSince\x12'\n" +
+	"\x0finclude_deleted\x18\x04 \x01(\bR\x0eincludeDeleted\"O\n" +
+	"\x10ExportMaterialIn\x12#\n" +
+	"\rmaterial_uuid\x18\x01 \x01(\tR\fmaterialUuid\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"f\n" +
+	"\x11ExportMaterialOut\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"/\n" +
+	"\x10ExportUserDataIn\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\"f\n" +
+	"\x11ExportUserDataOut\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"\xd3\x02\n" +
+	"\x0fUserDataArchive\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12;\n" +
+	"\vexported_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"exportedAt\x12&\n" +
+	"\aprofile\x18\x03 \x01(\v2\f.UserProfileR\aprofile\x12/\n" +
+	"\tmaterials\x18\x04 \x03(\v2\x11.UserDataMaterialR\tmaterials\x12+\n" +
+	"\treactions\x18\x05 \x03(\v2\r.UserReactionR\treactions\x12)\n" +
+	"\areports\x18\x06 \x03(\v2\x0f.MaterialReportR\areports\x125\n" +
+	"\vattachments\x18\a \x03(\v2\x13.MaterialAttachmentR\vattachments\"\x8c\x01\n" +
+	"\vUserProfile\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1a\n" +
+	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x1f\n" +
+	"\vavatar_link\x18\x03 \x01(\tR\n" +
+	"avatarLink\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x18\n" +
+	"\asurname\x18\x05 \x01(\tR\asurname\"M\n" +
+	"\x10UserDataMaterial\x12%\n" +
+	"\bmaterial\x18\x01 \x01(\v2\t.MaterialR\bmaterial\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\"\x8a\x01\n" +
+	"\fUserReaction\x12#\n" +
+	"\rmaterial_uuid\x18\x01 \x01(\tR\fmaterialUuid\x12\x1a\n" +
+	"\breaction\x18\x02 \x01(\tR\breaction\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x86\x01\n" +
 	"\x16MaterialDeletedMessage\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1d\n" +
 	"\n" +
@@ -1136,17 +4872,67 @@ const file_api_materials_proto_rawDesc = "" +
 	"\n" +
 	"owner_uuid\x18\x02 \x01(\tR\townerUuid\x12\x14\n" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x127\n" +
-	"\tedited_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt2\xee\x03\n" +
+	"\tedited_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\"\xb6\x01\n" +
+	"\x14BulkOperationMessage\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1d\n" +
+	"\n" +
+	"owner_uuid\x18\x02 \x01(\tR\townerUuid\x12\x14\n" +
+	"\x05uuids\x18\x03 \x03(\tR\x05uuids\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12=\n" +
+	"\fprocessed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vprocessedAt\"\x93\x02\n" +
+	"\x19ModerationDecisionMessage\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12#\n" +
+	"\rmaterial_uuid\x18\x02 \x01(\tR\fmaterialUuid\x12\x1f\n" +
+	"\vreport_uuid\x18\x03 \x01(\tR\n" +
+	"reportUuid\x12%\n" +
+	"\x0emoderator_uuid\x18\x04 \x01(\tR\rmoderatorUuid\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x05 \x01(\tR\n" +
+	"resolution\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"decided_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt2\x84\x13\n" +
 	"\x10MaterialsService\x12B\n" +
 	"\x11SaveDraftMaterial\x12\x14.SaveDraftMaterialIn\x1a\x15.SaveDraftMaterialOut\"\x00\x120\n" +
-	"\vGetMaterial\x12\x0e.GetMaterialIn\x1a\x0f.GetMaterialOut\"\x00\x12@\n" +
+	"\vGetMaterial\x12\x0e.GetMaterialIn\x1a\x0f.GetMaterialOut\"\x00\x12H\n" +
+	"\x13GetMaterialsByUUIDs\x12\x16.GetMaterialsByUUIDsIn\x1a\x17.GetMaterialsByUUIDsOut\"\x00\x12@\n" +
 	"\x0fGetAllMaterials\x12\x16.google.protobuf.Empty\x1a\x13.GetAllMaterialsOut\"\x00\x123\n" +
 	"\fEditMaterial\x12\x0f.EditMaterialIn\x1a\x10.EditMaterialOut\"\x00\x12<\n" +
 	"\x0fPublishMaterial\x12\x12.PublishMaterialIn\x1a\x13.PublishMaterialOut\"\x00\x12=\n" +
 	"\x0eDeleteMaterial\x12\x11.DeleteMaterialIn\x1a\x16.google.protobuf.Empty\"\x00\x12A\n" +
 	"\x10ArchivedMaterial\x12\x13.ArchivedMaterialIn\x1a\x16.google.protobuf.Empty\"\x00\x12-\n" +
 	"\n" +
-	"ToggleLike\x12\r.ToggleLikeIn\x1a\x0e.ToggleLikeOut\"\x00B\x0fZ\rpkg/materialsb\x06proto3"
+	"ToggleLike\x12\r.ToggleLikeIn\x1a\x0e.ToggleLikeOut\"\x00\x126\n" +
+	"\rAutosaveDraft\x12\x10.AutosaveDraftIn\x1a\x11.AutosaveDraftOut\"\x00\x12<\n" +
+	"\x0fPromoteAutosave\x12\x12.PromoteAutosaveIn\x1a\x13.PromoteAutosaveOut\"\x00\x12B\n" +
+	"\x11DuplicateMaterial\x12\x14.DuplicateMaterialIn\x1a\x15.DuplicateMaterialOut\"\x00\x12H\n" +
+	"\x13GetDeletedMaterials\x12\x16.GetDeletedMaterialsIn\x1a\x17.GetDeletedMaterialsOut\"\x00\x12<\n" +
+	"\x0fRestoreMaterial\x12\x12.RestoreMaterialIn\x1a\x13.RestoreMaterialOut\"\x00\x12B\n" +
+	"\x11UnarchiveMaterial\x12\x14.UnarchiveMaterialIn\x1a\x15.UnarchiveMaterialOut\"\x00\x12K\n" +
+	"\x14GetArchivedMaterials\x12\x17.GetArchivedMaterialsIn\x1a\x18.GetArchivedMaterialsOut\"\x00\x12<\n" +
+	"\x13BulkDeleteMaterials\x12\x10.BulkMaterialsIn\x1a\x11.BulkMaterialsOut\"\x00\x12=\n" +
+	"\x14BulkArchiveMaterials\x12\x10.BulkMaterialsIn\x1a\x11.BulkMaterialsOut\"\x00\x12=\n" +
+	"\x14BulkPublishMaterials\x12\x10.BulkMaterialsIn\x1a\x11.BulkMaterialsOut\"\x00\x12<\n" +
+	"\x10BulkTagMaterials\x12\x13.BulkTagMaterialsIn\x1a\x11.BulkMaterialsOut\"\x00\x12$\n" +
+	"\aSetLike\x12\n" +
+	".SetLikeIn\x1a\v.SetLikeOut\"\x00\x12E\n" +
+	"\x12ListMaterialLikers\x12\x15.ListMaterialLikersIn\x1a\x16.ListMaterialLikersOut\"\x00\x12.\n" +
+	"\vSetReaction\x12\x0e.SetReactionIn\x1a\r.ReactionsOut\"\x00\x122\n" +
+	"\rClearReaction\x12\x10.ClearReactionIn\x1a\r.ReactionsOut\"\x00\x12K\n" +
+	"\x14GetTrendingMaterials\x12\x17.GetTrendingMaterialsIn\x1a\x18.GetTrendingMaterialsOut\"\x00\x12H\n" +
+	"\x13GetRelatedMaterials\x12\x16.GetRelatedMaterialsIn\x1a\x17.GetRelatedMaterialsOut\"\x00\x129\n" +
+	"\x0eReportMaterial\x12\x11.ReportMaterialIn\x1a\x12.ReportMaterialOut\"\x00\x12H\n" +
+	"\x13ListMaterialReports\x12\x16.ListMaterialReportsIn\x1a\x17.ListMaterialReportsOut\"\x00\x12N\n" +
+	"\x15ResolveMaterialReport\x12\x18.ResolveMaterialReportIn\x1a\x19.ResolveMaterialReportOut\"\x00\x127\n" +
+	"\fHideMaterial\x12\x0f.HideMaterialIn\x1a\x14.ModerateMaterialOut\"\x00\x12;\n" +
+	"\x0eUnhideMaterial\x12\x11.UnhideMaterialIn\x1a\x14.ModerateMaterialOut\"\x00\x122\n" +
+	"\vUploadCover\x12\x0e.UploadCoverIn\x1a\x0f.UploadCoverOut\"\x00(\x01\x12A\n" +
+	"\x10UploadAttachment\x12\x13.UploadAttachmentIn\x1a\x14.UploadAttachmentOut\"\x00(\x01\x12T\n" +
+	"\x17ListMaterialAttachments\x12\x1a.ListMaterialAttachmentsIn\x1a\x1b.ListMaterialAttachmentsOut\"\x00\x12Q\n" +
+	"\x18DeleteMaterialAttachment\x12\x1b.DeleteMaterialAttachmentIn\x1a\x16.google.protobuf.Empty\"\x00\x124\n" +
+	"\x0fExportMaterials\x12\x12.ExportMaterialsIn\x1a\t.Material\"\x000\x01\x129\n" +
+	"\x0eExportMaterial\x12\x11.ExportMaterialIn\x1a\x12.ExportMaterialOut\"\x00\x129\n" +
+	"\x0eExportUserData\x12\x11.ExportUserDataIn\x1a\x12.ExportUserDataOut\"\x00B\x0fZ\rpkg/materialsb\x06proto3"
 
 var (
 	file_api_materials_proto_rawDescOnce sync.Once
@@ -1160,63 +4946,223 @@ func file_api_materials_proto_rawDescGZIP() []byte {
 	return file_api_materials_proto_rawDescData
 }
 
-var file_api_materials_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_api_materials_proto_msgTypes = make([]protoimpl.MessageInfo, 81)
 var file_api_materials_proto_goTypes = []any{
-	(*SaveDraftMaterialIn)(nil),    // 0: SaveDraftMaterialIn
-	(*SaveDraftMaterialOut)(nil),   // 1: SaveDraftMaterialOut
-	(*GetMaterialIn)(nil),          // 2: GetMaterialIn
-	(*GetMaterialOut)(nil),         // 3: GetMaterialOut
-	(*Material)(nil),               // 4: Material
-	(*GetAllMaterialsOut)(nil),     // 5: GetAllMaterialsOut
-	(*EditMaterialIn)(nil),         // 6: EditMaterialIn
-	(*EditMaterialOut)(nil),        // 7: EditMaterialOut
-	(*DeleteMaterialIn)(nil),       // 8: DeleteMaterialIn
-	(*PublishMaterialIn)(nil),      // 9: PublishMaterialIn
-	(*PublishMaterialOut)(nil),     // 10: PublishMaterialOut
-	(*ArchivedMaterialIn)(nil),     // 11: ArchivedMaterialIn
-	(*ToggleLikeIn)(nil),           // 12: ToggleLikeIn
-	(*ToggleLikeOut)(nil),          // 13: ToggleLikeOut
-	(*MaterialDeletedMessage)(nil), // 14: MaterialDeletedMessage
-	(*CreatedMaterial)(nil),        // 15: CreatedMaterial
-	(*ToggleLikeMessage)(nil),      // 16: ToggleLikeMessage
-	(*EditMaterialMessage)(nil),    // 17: EditMaterialMessage
-	(*timestamppb.Timestamp)(nil),  // 18: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),          // 19: google.protobuf.Empty
+	(*SaveDraftMaterialIn)(nil),        // 0: SaveDraftMaterialIn
+	(*SaveDraftMaterialOut)(nil),       // 1: SaveDraftMaterialOut
+	(*GetMaterialIn)(nil),              // 2: GetMaterialIn
+	(*GetMaterialOut)(nil),             // 3: GetMaterialOut
+	(*GetMaterialsByUUIDsIn)(nil),      // 4: GetMaterialsByUUIDsIn
+	(*GetMaterialsByUUIDsOut)(nil),     // 5: GetMaterialsByUUIDsOut
+	(*Material)(nil),                   // 6: Material
+	(*GetAllMaterialsOut)(nil),         // 7: GetAllMaterialsOut
+	(*EditMaterialIn)(nil),             // 8: EditMaterialIn
+	(*EditMaterialOut)(nil),            // 9: EditMaterialOut
+	(*DeleteMaterialIn)(nil),           // 10: DeleteMaterialIn
+	(*PublishMaterialIn)(nil),          // 11: PublishMaterialIn
+	(*PublishMaterialOut)(nil),         // 12: PublishMaterialOut
+	(*ArchivedMaterialIn)(nil),         // 13: ArchivedMaterialIn
+	(*ToggleLikeIn)(nil),               // 14: ToggleLikeIn
+	(*ToggleLikeOut)(nil),              // 15: ToggleLikeOut
+	(*AutosaveDraftIn)(nil),            // 16: AutosaveDraftIn
+	(*AutosaveDraftOut)(nil),           // 17: AutosaveDraftOut
+	(*PromoteAutosaveIn)(nil),          // 18: PromoteAutosaveIn
+	(*PromoteAutosaveOut)(nil),         // 19: PromoteAutosaveOut
+	(*DuplicateMaterialIn)(nil),        // 20: DuplicateMaterialIn
+	(*DuplicateMaterialOut)(nil),       // 21: DuplicateMaterialOut
+	(*GetDeletedMaterialsIn)(nil),      // 22: GetDeletedMaterialsIn
+	(*GetDeletedMaterialsOut)(nil),     // 23: GetDeletedMaterialsOut
+	(*RestoreMaterialIn)(nil),          // 24: RestoreMaterialIn
+	(*RestoreMaterialOut)(nil),         // 25: RestoreMaterialOut
+	(*UnarchiveMaterialIn)(nil),        // 26: UnarchiveMaterialIn
+	(*UnarchiveMaterialOut)(nil),       // 27: UnarchiveMaterialOut
+	(*GetArchivedMaterialsIn)(nil),     // 28: GetArchivedMaterialsIn
+	(*GetArchivedMaterialsOut)(nil),    // 29: GetArchivedMaterialsOut
+	(*BulkMaterialsIn)(nil),            // 30: BulkMaterialsIn
+	(*BulkTagMaterialsIn)(nil),         // 31: BulkTagMaterialsIn
+	(*BulkItemResult)(nil),             // 32: BulkItemResult
+	(*BulkMaterialsOut)(nil),           // 33: BulkMaterialsOut
+	(*SetLikeIn)(nil),                  // 34: SetLikeIn
+	(*SetLikeOut)(nil),                 // 35: SetLikeOut
+	(*ListMaterialLikersIn)(nil),       // 36: ListMaterialLikersIn
+	(*UserSummary)(nil),                // 37: UserSummary
+	(*ListMaterialLikersOut)(nil),      // 38: ListMaterialLikersOut
+	(*ReactionCount)(nil),              // 39: ReactionCount
+	(*SetReactionIn)(nil),              // 40: SetReactionIn
+	(*ClearReactionIn)(nil),            // 41: ClearReactionIn
+	(*ReactionsOut)(nil),               // 42: ReactionsOut
+	(*GetTrendingMaterialsIn)(nil),     // 43: GetTrendingMaterialsIn
+	(*GetTrendingMaterialsOut)(nil),    // 44: GetTrendingMaterialsOut
+	(*GetRelatedMaterialsIn)(nil),      // 45: GetRelatedMaterialsIn
+	(*GetRelatedMaterialsOut)(nil),     // 46: GetRelatedMaterialsOut
+	(*ReportMaterialIn)(nil),           // 47: ReportMaterialIn
+	(*ReportMaterialOut)(nil),          // 48: ReportMaterialOut
+	(*MaterialReport)(nil),             // 49: MaterialReport
+	(*ListMaterialReportsIn)(nil),      // 50: ListMaterialReportsIn
+	(*ListMaterialReportsOut)(nil),     // 51: ListMaterialReportsOut
+	(*ResolveMaterialReportIn)(nil),    // 52: ResolveMaterialReportIn
+	(*ResolveMaterialReportOut)(nil),   // 53: ResolveMaterialReportOut
+	(*HideMaterialIn)(nil),             // 54: HideMaterialIn
+	(*UnhideMaterialIn)(nil),           // 55: UnhideMaterialIn
+	(*ModerateMaterialOut)(nil),        // 56: ModerateMaterialOut
+	(*CoverThumbnail)(nil),             // 57: CoverThumbnail
+	(*UploadCoverIn)(nil),              // 58: UploadCoverIn
+	(*UploadCoverOut)(nil),             // 59: UploadCoverOut
+	(*MaterialAttachment)(nil),         // 60: MaterialAttachment
+	(*UploadAttachmentIn)(nil),         // 61: UploadAttachmentIn
+	(*UploadAttachmentOut)(nil),        // 62: UploadAttachmentOut
+	(*ListMaterialAttachmentsIn)(nil),  // 63: ListMaterialAttachmentsIn
+	(*ListMaterialAttachmentsOut)(nil), // 64: ListMaterialAttachmentsOut
+	(*DeleteMaterialAttachmentIn)(nil), // 65: DeleteMaterialAttachmentIn
+	(*ExportMaterialsIn)(nil),          // 66: ExportMaterialsIn
+	(*ExportMaterialIn)(nil),           // 67: ExportMaterialIn
+	(*ExportMaterialOut)(nil),          // 68: ExportMaterialOut
+	(*ExportUserDataIn)(nil),           // 69: ExportUserDataIn
+	(*ExportUserDataOut)(nil),          // 70: ExportUserDataOut
+	(*UserDataArchive)(nil),            // 71: UserDataArchive
+	(*UserProfile)(nil),                // 72: UserProfile
+	(*UserDataMaterial)(nil),           // 73: UserDataMaterial
+	(*UserReaction)(nil),               // 74: UserReaction
+	(*MaterialDeletedMessage)(nil),     // 75: MaterialDeletedMessage
+	(*CreatedMaterial)(nil),            // 76: CreatedMaterial
+	(*ToggleLikeMessage)(nil),          // 77: ToggleLikeMessage
+	(*EditMaterialMessage)(nil),        // 78: EditMaterialMessage
+	(*BulkOperationMessage)(nil),       // 79: BulkOperationMessage
+	(*ModerationDecisionMessage)(nil),  // 80: ModerationDecisionMessage
+	(*timestamppb.Timestamp)(nil),      // 81: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),              // 82: google.protobuf.Empty
 }
 var file_api_materials_proto_depIdxs = []int32{
-	4,  // 0: GetMaterialOut.material:type_name -> Material
-	18, // 1: Material.created_at:type_name -> google.protobuf.Timestamp
-	18, // 2: Material.edited_at:type_name -> google.protobuf.Timestamp
-	18, // 3: Material.published_at:type_name -> google.protobuf.Timestamp
-	18, // 4: Material.archived_at:type_name -> google.protobuf.Timestamp
-	18, // 5: Material.deleted_at:type_name -> google.protobuf.Timestamp
-	4,  // 6: GetAllMaterialsOut.material_list:type_name -> Material
-	4,  // 7: EditMaterialOut.material:type_name -> Material
-	4,  // 8: PublishMaterialOut.material:type_name -> Material
-	18, // 9: MaterialDeletedMessage.deleted_at:type_name -> google.protobuf.Timestamp
-	4,  // 10: CreatedMaterial.material:type_name -> Material
-	18, // 11: EditMaterialMessage.edited_at:type_name -> google.protobuf.Timestamp
-	0,  // 12: MaterialsService.SaveDraftMaterial:input_type -> SaveDraftMaterialIn
-	2,  // 13: MaterialsService.GetMaterial:input_type -> GetMaterialIn
-	19, // 14: MaterialsService.GetAllMaterials:input_type -> google.protobuf.Empty
-	6,  // 15: MaterialsService.EditMaterial:input_type -> EditMaterialIn
-	9,  // 16: MaterialsService.PublishMaterial:input_type -> PublishMaterialIn
-	8,  // 17: MaterialsService.DeleteMaterial:input_type -> DeleteMaterialIn
-	11, // 18: MaterialsService.ArchivedMaterial:input_type -> ArchivedMaterialIn
-	12, // 19: MaterialsService.ToggleLike:input_type -> ToggleLikeIn
-	1,  // 20: MaterialsService.SaveDraftMaterial:output_type -> SaveDraftMaterialOut
-	3,  // 21: MaterialsService.GetMaterial:output_type -> GetMaterialOut
-	5,  // 22: MaterialsService.GetAllMaterials:output_type -> GetAllMaterialsOut
-	7,  // 23: MaterialsService.EditMaterial:output_type -> EditMaterialOut
-	10, // 24: MaterialsService.PublishMaterial:output_type -> PublishMaterialOut
-	19, // 25: MaterialsService.DeleteMaterial:output_type -> google.protobuf.Empty
-	19, // 26: MaterialsService.ArchivedMaterial:output_type -> google.protobuf.Empty
-	13, // 27: MaterialsService.ToggleLike:output_type -> ToggleLikeOut
-	20, // [20:28] is the sub-list for method output_type
-	12, // [12:20] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	6,  // 0: GetMaterialOut.material:type_name -> Material
+	6,  // 1: GetMaterialsByUUIDsOut.materials:type_name -> Material
+	81, // 2: Material.created_at:type_name -> google.protobuf.Timestamp
+	81, // 3: Material.edited_at:type_name -> google.protobuf.Timestamp
+	81, // 4: Material.published_at:type_name -> google.protobuf.Timestamp
+	81, // 5: Material.archived_at:type_name -> google.protobuf.Timestamp
+	81, // 6: Material.deleted_at:type_name -> google.protobuf.Timestamp
+	39, // 7: Material.reactions:type_name -> ReactionCount
+	57, // 8: Material.cover_thumbnails:type_name -> CoverThumbnail
+	81, // 9: Material.updated_at:type_name -> google.protobuf.Timestamp
+	81, // 10: Material.owner_deleted_at:type_name -> google.protobuf.Timestamp
+	6,  // 11: GetAllMaterialsOut.material_list:type_name -> Material
+	6,  // 12: EditMaterialOut.material:type_name -> Material
+	6,  // 13: PublishMaterialOut.material:type_name -> Material
+	81, // 14: AutosaveDraftOut.saved_at:type_name -> google.protobuf.Timestamp
+	6,  // 15: PromoteAutosaveOut.material:type_name -> Material
+	6,  // 16: DuplicateMaterialOut.material:type_name -> Material
+	6,  // 17: GetDeletedMaterialsOut.material_list:type_name -> Material
+	6,  // 18: RestoreMaterialOut.material:type_name -> Material
+	6,  // 19: UnarchiveMaterialOut.material:type_name -> Material
+	6,  // 20: GetArchivedMaterialsOut.material_list:type_name -> Material
+	32, // 21: BulkMaterialsOut.results:type_name -> BulkItemResult
+	81, // 22: UserSummary.liked_at:type_name -> google.protobuf.Timestamp
+	37, // 23: ListMaterialLikersOut.likers:type_name -> UserSummary
+	39, // 24: ReactionsOut.reactions:type_name -> ReactionCount
+	6,  // 25: GetTrendingMaterialsOut.material_list:type_name -> Material
+	6,  // 26: GetRelatedMaterialsOut.material_list:type_name -> Material
+	81, // 27: MaterialReport.created_at:type_name -> google.protobuf.Timestamp
+	81, // 28: MaterialReport.resolved_at:type_name -> google.protobuf.Timestamp
+	49, // 29: ListMaterialReportsOut.reports:type_name -> MaterialReport
+	49, // 30: ResolveMaterialReportOut.report:type_name -> MaterialReport
+	57, // 31: UploadCoverOut.cover_thumbnails:type_name -> CoverThumbnail
+	81, // 32: MaterialAttachment.created_at:type_name -> google.protobuf.Timestamp
+	60, // 33: UploadAttachmentOut.attachment:type_name -> MaterialAttachment
+	60, // 34: ListMaterialAttachmentsOut.attachments:type_name -> MaterialAttachment
+	81, // 35: ExportMaterialsIn.updated_since:type_name -> google.protobuf.Timestamp
+	81, // 36: UserDataArchive.exported_at:type_name -> google.protobuf.Timestamp
+	72, // 37: UserDataArchive.profile:type_name -> UserProfile
+	73, // 38: UserDataArchive.materials:type_name -> UserDataMaterial
+	74, // 39: UserDataArchive.reactions:type_name -> UserReaction
+	49, // 40: UserDataArchive.reports:type_name -> MaterialReport
+	60, // 41: UserDataArchive.attachments:type_name -> MaterialAttachment
+	6,  // 42: UserDataMaterial.material:type_name -> Material
+	81, // 43: UserReaction.created_at:type_name -> google.protobuf.Timestamp
+	81, // 44: MaterialDeletedMessage.deleted_at:type_name -> google.protobuf.Timestamp
+	6,  // 45: CreatedMaterial.material:type_name -> Material
+	81, // 46: EditMaterialMessage.edited_at:type_name -> google.protobuf.Timestamp
+	81, // 47: BulkOperationMessage.processed_at:type_name -> google.protobuf.Timestamp
+	81, // 48: ModerationDecisionMessage.decided_at:type_name -> google.protobuf.Timestamp
+	0,  // 49: MaterialsService.SaveDraftMaterial:input_type -> SaveDraftMaterialIn
+	2,  // 50: MaterialsService.GetMaterial:input_type -> GetMaterialIn
+	4,  // 51: MaterialsService.GetMaterialsByUUIDs:input_type -> GetMaterialsByUUIDsIn
+	82, // 52: MaterialsService.GetAllMaterials:input_type -> google.protobuf.Empty
+	8,  // 53: MaterialsService.EditMaterial:input_type -> EditMaterialIn
+	11, // 54: MaterialsService.PublishMaterial:input_type -> PublishMaterialIn
+	10, // 55: MaterialsService.DeleteMaterial:input_type -> DeleteMaterialIn
+	13, // 56: MaterialsService.ArchivedMaterial:input_type -> ArchivedMaterialIn
+	14, // 57: MaterialsService.ToggleLike:input_type -> ToggleLikeIn
+	16, // 58: MaterialsService.AutosaveDraft:input_type -> AutosaveDraftIn
+	18, // 59: MaterialsService.PromoteAutosave:input_type -> PromoteAutosaveIn
+	20, // 60: MaterialsService.DuplicateMaterial:input_type -> DuplicateMaterialIn
+	22, // 61: MaterialsService.GetDeletedMaterials:input_type -> GetDeletedMaterialsIn
+	24, // 62: MaterialsService.RestoreMaterial:input_type -> RestoreMaterialIn
+	26, // 63: MaterialsService.UnarchiveMaterial:input_type -> UnarchiveMaterialIn
+	28, // 64: MaterialsService.GetArchivedMaterials:input_type -> GetArchivedMaterialsIn
+	30, // 65: MaterialsService.BulkDeleteMaterials:input_type -> BulkMaterialsIn
+	30, // 66: MaterialsService.BulkArchiveMaterials:input_type -> BulkMaterialsIn
+	30, // 67: MaterialsService.BulkPublishMaterials:input_type -> BulkMaterialsIn
+	31, // 68: MaterialsService.BulkTagMaterials:input_type -> BulkTagMaterialsIn
+	34, // 69: MaterialsService.SetLike:input_type -> SetLikeIn
+	36, // 70: MaterialsService.ListMaterialLikers:input_type -> ListMaterialLikersIn
+	40, // 71: MaterialsService.SetReaction:input_type -> SetReactionIn
+	41, // 72: MaterialsService.ClearReaction:input_type -> ClearReactionIn
+	43, // 73: MaterialsService.GetTrendingMaterials:input_type -> GetTrendingMaterialsIn
+	45, // 74: MaterialsService.GetRelatedMaterials:input_type -> GetRelatedMaterialsIn
+	47, // 75: MaterialsService.ReportMaterial:input_type -> ReportMaterialIn
+	50, // 76: MaterialsService.ListMaterialReports:input_type -> ListMaterialReportsIn
+	52, // 77: MaterialsService.ResolveMaterialReport:input_type -> ResolveMaterialReportIn
+	54, // 78: MaterialsService.HideMaterial:input_type -> HideMaterialIn
+	55, // 79: MaterialsService.UnhideMaterial:input_type -> UnhideMaterialIn
+	58, // 80: MaterialsService.UploadCover:input_type -> UploadCoverIn
+	61, // 81: MaterialsService.UploadAttachment:input_type -> UploadAttachmentIn
+	63, // 82: MaterialsService.ListMaterialAttachments:input_type -> ListMaterialAttachmentsIn
+	65, // 83: MaterialsService.DeleteMaterialAttachment:input_type -> DeleteMaterialAttachmentIn
+	66, // 84: MaterialsService.ExportMaterials:input_type -> ExportMaterialsIn
+	67, // 85: MaterialsService.ExportMaterial:input_type -> ExportMaterialIn
+	69, // 86: MaterialsService.ExportUserData:input_type -> ExportUserDataIn
+	1,  // 87: MaterialsService.SaveDraftMaterial:output_type -> SaveDraftMaterialOut
+	3,  // 88: MaterialsService.GetMaterial:output_type -> GetMaterialOut
+	5,  // 89: MaterialsService.GetMaterialsByUUIDs:output_type -> GetMaterialsByUUIDsOut
+	7,  // 90: MaterialsService.GetAllMaterials:output_type -> GetAllMaterialsOut
+	9,  // 91: MaterialsService.EditMaterial:output_type -> EditMaterialOut
+	12, // 92: MaterialsService.PublishMaterial:output_type -> PublishMaterialOut
+	82, // 93: MaterialsService.DeleteMaterial:output_type -> google.protobuf.Empty
+	82, // 94: MaterialsService.ArchivedMaterial:output_type -> google.protobuf.Empty
+	15, // 95: MaterialsService.ToggleLike:output_type -> ToggleLikeOut
+	17, // 96: MaterialsService.AutosaveDraft:output_type -> AutosaveDraftOut
+	19, // 97: MaterialsService.PromoteAutosave:output_type -> PromoteAutosaveOut
+	21, // 98: MaterialsService.DuplicateMaterial:output_type -> DuplicateMaterialOut
+	23, // 99: MaterialsService.GetDeletedMaterials:output_type -> GetDeletedMaterialsOut
+	25, // 100: MaterialsService.RestoreMaterial:output_type -> RestoreMaterialOut
+	27, // 101: MaterialsService.UnarchiveMaterial:output_type -> UnarchiveMaterialOut
+	29, // 102: MaterialsService.GetArchivedMaterials:output_type -> GetArchivedMaterialsOut
+	33, // 103: MaterialsService.BulkDeleteMaterials:output_type -> BulkMaterialsOut
+	33, // 104: MaterialsService.BulkArchiveMaterials:output_type -> BulkMaterialsOut
+	33, // 105: MaterialsService.BulkPublishMaterials:output_type -> BulkMaterialsOut
+	33, // 106: MaterialsService.BulkTagMaterials:output_type -> BulkMaterialsOut
+	35, // 107: MaterialsService.SetLike:output_type -> SetLikeOut
+	38, // 108: MaterialsService.ListMaterialLikers:output_type -> ListMaterialLikersOut
+	42, // 109: MaterialsService.SetReaction:output_type -> ReactionsOut
+	42, // 110: MaterialsService.ClearReaction:output_type -> ReactionsOut
+	44, // 111: MaterialsService.GetTrendingMaterials:output_type -> GetTrendingMaterialsOut
+	46, // 112: MaterialsService.GetRelatedMaterials:output_type -> GetRelatedMaterialsOut
+	48, // 113: MaterialsService.ReportMaterial:output_type -> ReportMaterialOut
+	51, // 114: MaterialsService.ListMaterialReports:output_type -> ListMaterialReportsOut
+	53, // 115: MaterialsService.ResolveMaterialReport:output_type -> ResolveMaterialReportOut
+	56, // 116: MaterialsService.HideMaterial:output_type -> ModerateMaterialOut
+	56, // 117: MaterialsService.UnhideMaterial:output_type -> ModerateMaterialOut
+	59, // 118: MaterialsService.UploadCover:output_type -> UploadCoverOut
+	62, // 119: MaterialsService.UploadAttachment:output_type -> UploadAttachmentOut
+	64, // 120: MaterialsService.ListMaterialAttachments:output_type -> ListMaterialAttachmentsOut
+	82, // 121: MaterialsService.DeleteMaterialAttachment:output_type -> google.protobuf.Empty
+	6,  // 122: MaterialsService.ExportMaterials:output_type -> Material
+	68, // 123: MaterialsService.ExportMaterial:output_type -> ExportMaterialOut
+	70, // 124: MaterialsService.ExportUserData:output_type -> ExportUserDataOut
+	87, // [87:125] is the sub-list for method output_type
+	49, // [49:87] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_api_materials_proto_init() }
@@ -1230,7 +5176,7 @@ func file_api_materials_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_materials_proto_rawDesc), len(file_api_materials_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   81,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,14 +20,44 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MaterialsService_SaveDraftMaterial_FullMethodName = "/MaterialsService/SaveDraftMaterial"
-	MaterialsService_GetMaterial_FullMethodName       = "/MaterialsService/GetMaterial"
-	MaterialsService_GetAllMaterials_FullMethodName   = "/MaterialsService/GetAllMaterials"
-	MaterialsService_EditMaterial_FullMethodName      = "/MaterialsService/EditMaterial"
-	MaterialsService_PublishMaterial_FullMethodName   = "/MaterialsService/PublishMaterial"
-	MaterialsService_DeleteMaterial_FullMethodName    = "/MaterialsService/DeleteMaterial"
-	MaterialsService_ArchivedMaterial_FullMethodName  = "/MaterialsService/ArchivedMaterial"
-	MaterialsService_ToggleLike_FullMethodName        = "/MaterialsService/ToggleLike"
+	MaterialsService_SaveDraftMaterial_FullMethodName        = "/MaterialsService/SaveDraftMaterial"
+	MaterialsService_GetMaterial_FullMethodName              = "/MaterialsService/GetMaterial"
+	MaterialsService_GetMaterialsByUUIDs_FullMethodName      = "/MaterialsService/GetMaterialsByUUIDs"
+	MaterialsService_GetAllMaterials_FullMethodName          = "/MaterialsService/GetAllMaterials"
+	MaterialsService_EditMaterial_FullMethodName             = "/MaterialsService/EditMaterial"
+	MaterialsService_PublishMaterial_FullMethodName          = "/MaterialsService/PublishMaterial"
+	MaterialsService_DeleteMaterial_FullMethodName           = "/MaterialsService/DeleteMaterial"
+	MaterialsService_ArchivedMaterial_FullMethodName         = "/MaterialsService/ArchivedMaterial"
+	MaterialsService_ToggleLike_FullMethodName               = "/MaterialsService/ToggleLike"
+	MaterialsService_AutosaveDraft_FullMethodName            = "/MaterialsService/AutosaveDraft"
+	MaterialsService_PromoteAutosave_FullMethodName          = "/MaterialsService/PromoteAutosave"
+	MaterialsService_DuplicateMaterial_FullMethodName        = "/MaterialsService/DuplicateMaterial"
+	MaterialsService_GetDeletedMaterials_FullMethodName      = "/MaterialsService/GetDeletedMaterials"
+	MaterialsService_RestoreMaterial_FullMethodName          = "/MaterialsService/RestoreMaterial"
+	MaterialsService_UnarchiveMaterial_FullMethodName        = "/MaterialsService/UnarchiveMaterial"
+	MaterialsService_GetArchivedMaterials_FullMethodName     = "/MaterialsService/GetArchivedMaterials"
+	MaterialsService_BulkDeleteMaterials_FullMethodName      = "/MaterialsService/BulkDeleteMaterials"
+	MaterialsService_BulkArchiveMaterials_FullMethodName     = "/MaterialsService/BulkArchiveMaterials"
+	MaterialsService_BulkPublishMaterials_FullMethodName     = "/MaterialsService/BulkPublishMaterials"
+	MaterialsService_BulkTagMaterials_FullMethodName         = "/MaterialsService/BulkTagMaterials"
+	MaterialsService_SetLike_FullMethodName                  = "/MaterialsService/SetLike"
+	MaterialsService_ListMaterialLikers_FullMethodName       = "/MaterialsService/ListMaterialLikers"
+	MaterialsService_SetReaction_FullMethodName              = "/MaterialsService/SetReaction"
+	MaterialsService_ClearReaction_FullMethodName            = "/MaterialsService/ClearReaction"
+	MaterialsService_GetTrendingMaterials_FullMethodName     = "/MaterialsService/GetTrendingMaterials"
+	MaterialsService_GetRelatedMaterials_FullMethodName      = "/MaterialsService/GetRelatedMaterials"
+	MaterialsService_ReportMaterial_FullMethodName           = "/MaterialsService/ReportMaterial"
+	MaterialsService_ListMaterialReports_FullMethodName      = "/MaterialsService/ListMaterialReports"
+	MaterialsService_ResolveMaterialReport_FullMethodName    = "/MaterialsService/ResolveMaterialReport"
+	MaterialsService_HideMaterial_FullMethodName             = "/MaterialsService/HideMaterial"
+	MaterialsService_UnhideMaterial_FullMethodName           = "/MaterialsService/UnhideMaterial"
+	MaterialsService_UploadCover_FullMethodName              = "/MaterialsService/UploadCover"
+	MaterialsService_UploadAttachment_FullMethodName         = "/MaterialsService/UploadAttachment"
+	MaterialsService_ListMaterialAttachments_FullMethodName  = "/MaterialsService/ListMaterialAttachments"
+	MaterialsService_DeleteMaterialAttachment_FullMethodName = "/MaterialsService/DeleteMaterialAttachment"
+	MaterialsService_ExportMaterials_FullMethodName          = "/MaterialsService/ExportMaterials"
+	MaterialsService_ExportMaterial_FullMethodName           = "/MaterialsService/ExportMaterial"
+	MaterialsService_ExportUserData_FullMethodName           = "/MaterialsService/ExportUserData"
 )
 
 // MaterialsServiceClient is the client API for MaterialsService service.
@@ -36,12 +66,44 @@ const (
 type MaterialsServiceClient interface {
 	SaveDraftMaterial(ctx context.Context, in *SaveDraftMaterialIn, opts ...grpc.CallOption) (*SaveDraftMaterialOut, error)
 	GetMaterial(ctx context.Context, in *GetMaterialIn, opts ...grpc.CallOption) (*GetMaterialOut, error)
+	GetMaterialsByUUIDs(ctx context.Context, in *GetMaterialsByUUIDsIn, opts ...grpc.CallOption) (*GetMaterialsByUUIDsOut, error)
 	GetAllMaterials(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetAllMaterialsOut, error)
 	EditMaterial(ctx context.Context, in *EditMaterialIn, opts ...grpc.CallOption) (*EditMaterialOut, error)
 	PublishMaterial(ctx context.Context, in *PublishMaterialIn, opts ...grpc.CallOption) (*PublishMaterialOut, error)
 	DeleteMaterial(ctx context.Context, in *DeleteMaterialIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ArchivedMaterial(ctx context.Context, in *ArchivedMaterialIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ToggleLike(ctx context.Context, in *ToggleLikeIn, opts ...grpc.CallOption) (*ToggleLikeOut, error)
+	AutosaveDraft(ctx context.Context, in *AutosaveDraftIn, opts ...grpc.CallOption) (*AutosaveDraftOut, error)
+	PromoteAutosave(ctx context.Context, in *PromoteAutosaveIn, opts ...grpc.CallOption) (*PromoteAutosaveOut, error)
+	DuplicateMaterial(ctx context.Context, in *DuplicateMaterialIn, opts ...grpc.CallOption) (*DuplicateMaterialOut, error)
+	GetDeletedMaterials(ctx context.Context, in *GetDeletedMaterialsIn, opts ...grpc.CallOption) (*GetDeletedMaterialsOut, error)
+	RestoreMaterial(ctx context.Context, in *RestoreMaterialIn, opts ...grpc.CallOption) (*RestoreMaterialOut, error)
+	UnarchiveMaterial(ctx context.Context, in *UnarchiveMaterialIn, opts ...grpc.CallOption) (*UnarchiveMaterialOut, error)
+	GetArchivedMaterials(ctx context.Context, in *GetArchivedMaterialsIn, opts ...grpc.CallOption) (*GetArchivedMaterialsOut, error)
+	BulkDeleteMaterials(ctx context.Context, in *BulkMaterialsIn, opts ...grpc.CallOption) (*BulkMaterialsOut, error)
+	BulkArchiveMaterials(ctx context.Context, in *BulkMaterialsIn, opts ...grpc.CallOption) (*BulkMaterialsOut, error)
+	BulkPublishMaterials(ctx context.Context, in *BulkMaterialsIn, opts ...grpc.CallOption) (*BulkMaterialsOut, error)
+	BulkTagMaterials(ctx context.Context, in *BulkTagMaterialsIn, opts ...grpc.CallOption) (*BulkMaterialsOut, error)
+	SetLike(ctx context.Context, in *SetLikeIn, opts ...grpc.CallOption) (*SetLikeOut, error)
+	ListMaterialLikers(ctx context.Context, in *ListMaterialLikersIn, opts ...grpc.CallOption) (*ListMaterialLikersOut, error)
+	SetReaction(ctx context.Context, in *SetReactionIn, opts ...grpc.CallOption) (*ReactionsOut, error)
+	ClearReaction(ctx context.Context, in *ClearReactionIn, opts ...grpc.CallOption) (*ReactionsOut, error)
+	GetTrendingMaterials(ctx context.Context, in *GetTrendingMaterialsIn, opts ...grpc.CallOption) (*GetTrendingMaterialsOut, error)
+	GetRelatedMaterials(ctx context.Context, in *GetRelatedMaterialsIn, opts ...grpc.CallOption) (*GetRelatedMaterialsOut, error)
+	ReportMaterial(ctx context.Context, in *ReportMaterialIn, opts ...grpc.CallOption) (*ReportMaterialOut, error)
+	ListMaterialReports(ctx context.Context, in *ListMaterialReportsIn, opts ...grpc.CallOption) (*ListMaterialReportsOut, error)
+	ResolveMaterialReport(ctx context.Context, in *ResolveMaterialReportIn, opts ...grpc.CallOption) (*ResolveMaterialReportOut, error)
+	HideMaterial(ctx context.Context, in *HideMaterialIn, opts ...grpc.CallOption) (*ModerateMaterialOut, error)
+	UnhideMaterial(ctx context.Context, in *UnhideMaterialIn, opts ...grpc.CallOption) (*ModerateMaterialOut, error)
+	UploadCover(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadCoverIn, UploadCoverOut], error)
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentIn, UploadAttachmentOut], error)
+	ListMaterialAttachments(ctx context.Context, in *ListMaterialAttachmentsIn, opts ...grpc.CallOption) (*ListMaterialAttachmentsOut, error)
+	DeleteMaterialAttachment(ctx context.Context, in *DeleteMaterialAttachmentIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Выгрузка постраничная по ключу (updated_at, uuid): каждая пачка читается после последней отданной строки, материал, изменённый во время выгрузки, может прийти повторно.
+	// updated_at ставится в момент изменения строки, а виден после коммита, поэтому для инкрементальной выгрузки updated_since стоит брать с запасом на длительность пишущих транзакций.
+	ExportMaterials(ctx context.Context, in *ExportMaterialsIn, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Material], error)
+	ExportMaterial(ctx context.Context, in *ExportMaterialIn, opts ...grpc.CallOption) (*ExportMaterialOut, error)
+	ExportUserData(ctx context.Context, in *ExportUserDataIn, opts ...grpc.CallOption) (*ExportUserDataOut, error)
 }
 
 type materialsServiceClient struct {
@@ -72,6 +134,16 @@ func (c *materialsServiceClient) GetMaterial(ctx context.Context, in *GetMateria
 	return out, nil
 }
 
+func (c *materialsServiceClient) GetMaterialsByUUIDs(ctx context.Context, in *GetMaterialsByUUIDsIn, opts ...grpc.CallOption) (*GetMaterialsByUUIDsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMaterialsByUUIDsOut)
+	err := c.cc.Invoke(ctx, MaterialsService_GetMaterialsByUUIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *materialsServiceClient) GetAllMaterials(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetAllMaterialsOut, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAllMaterialsOut)
@@ -132,18 +204,355 @@ func (c *materialsServiceClient) ToggleLike(ctx context.Context, in *ToggleLikeI
 	return out, nil
 }
 
+func (c *materialsServiceClient) AutosaveDraft(ctx context.Context, in *AutosaveDraftIn, opts ...grpc.CallOption) (*AutosaveDraftOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AutosaveDraftOut)
+	err := c.cc.Invoke(ctx, MaterialsService_AutosaveDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) PromoteAutosave(ctx context.Context, in *PromoteAutosaveIn, opts ...grpc.CallOption) (*PromoteAutosaveOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromoteAutosaveOut)
+	err := c.cc.Invoke(ctx, MaterialsService_PromoteAutosave_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) DuplicateMaterial(ctx context.Context, in *DuplicateMaterialIn, opts ...grpc.CallOption) (*DuplicateMaterialOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DuplicateMaterialOut)
+	err := c.cc.Invoke(ctx, MaterialsService_DuplicateMaterial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) GetDeletedMaterials(ctx context.Context, in *GetDeletedMaterialsIn, opts ...grpc.CallOption) (*GetDeletedMaterialsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeletedMaterialsOut)
+	err := c.cc.Invoke(ctx, MaterialsService_GetDeletedMaterials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) RestoreMaterial(ctx context.Context, in *RestoreMaterialIn, opts ...grpc.CallOption) (*RestoreMaterialOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreMaterialOut)
+	err := c.cc.Invoke(ctx, MaterialsService_RestoreMaterial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) UnarchiveMaterial(ctx context.Context, in *UnarchiveMaterialIn, opts ...grpc.CallOption) (*UnarchiveMaterialOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnarchiveMaterialOut)
+	err := c.cc.Invoke(ctx, MaterialsService_UnarchiveMaterial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) GetArchivedMaterials(ctx context.Context, in *GetArchivedMaterialsIn, opts ...grpc.CallOption) (*GetArchivedMaterialsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetArchivedMaterialsOut)
+	err := c.cc.Invoke(ctx, MaterialsService_GetArchivedMaterials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) BulkDeleteMaterials(ctx context.Context, in *BulkMaterialsIn, opts ...grpc.CallOption) (*BulkMaterialsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkMaterialsOut)
+	err := c.cc.Invoke(ctx, MaterialsService_BulkDeleteMaterials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) BulkArchiveMaterials(ctx context.Context, in *BulkMaterialsIn, opts ...grpc.CallOption) (*BulkMaterialsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkMaterialsOut)
+	err := c.cc.Invoke(ctx, MaterialsService_BulkArchiveMaterials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) BulkPublishMaterials(ctx context.Context, in *BulkMaterialsIn, opts ...grpc.CallOption) (*BulkMaterialsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkMaterialsOut)
+	err := c.cc.Invoke(ctx, MaterialsService_BulkPublishMaterials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) BulkTagMaterials(ctx context.Context, in *BulkTagMaterialsIn, opts ...grpc.CallOption) (*BulkMaterialsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkMaterialsOut)
+	err := c.cc.Invoke(ctx, MaterialsService_BulkTagMaterials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) SetLike(ctx context.Context, in *SetLikeIn, opts ...grpc.CallOption) (*SetLikeOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLikeOut)
+	err := c.cc.Invoke(ctx, MaterialsService_SetLike_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) ListMaterialLikers(ctx context.Context, in *ListMaterialLikersIn, opts ...grpc.CallOption) (*ListMaterialLikersOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMaterialLikersOut)
+	err := c.cc.Invoke(ctx, MaterialsService_ListMaterialLikers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) SetReaction(ctx context.Context, in *SetReactionIn, opts ...grpc.CallOption) (*ReactionsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactionsOut)
+	err := c.cc.Invoke(ctx, MaterialsService_SetReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) ClearReaction(ctx context.Context, in *ClearReactionIn, opts ...grpc.CallOption) (*ReactionsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactionsOut)
+	err := c.cc.Invoke(ctx, MaterialsService_ClearReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) GetTrendingMaterials(ctx context.Context, in *GetTrendingMaterialsIn, opts ...grpc.CallOption) (*GetTrendingMaterialsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrendingMaterialsOut)
+	err := c.cc.Invoke(ctx, MaterialsService_GetTrendingMaterials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) GetRelatedMaterials(ctx context.Context, in *GetRelatedMaterialsIn, opts ...grpc.CallOption) (*GetRelatedMaterialsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRelatedMaterialsOut)
+	err := c.cc.Invoke(ctx, MaterialsService_GetRelatedMaterials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) ReportMaterial(ctx context.Context, in *ReportMaterialIn, opts ...grpc.CallOption) (*ReportMaterialOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportMaterialOut)
+	err := c.cc.Invoke(ctx, MaterialsService_ReportMaterial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) ListMaterialReports(ctx context.Context, in *ListMaterialReportsIn, opts ...grpc.CallOption) (*ListMaterialReportsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMaterialReportsOut)
+	err := c.cc.Invoke(ctx, MaterialsService_ListMaterialReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) ResolveMaterialReport(ctx context.Context, in *ResolveMaterialReportIn, opts ...grpc.CallOption) (*ResolveMaterialReportOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveMaterialReportOut)
+	err := c.cc.Invoke(ctx, MaterialsService_ResolveMaterialReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) HideMaterial(ctx context.Context, in *HideMaterialIn, opts ...grpc.CallOption) (*ModerateMaterialOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerateMaterialOut)
+	err := c.cc.Invoke(ctx, MaterialsService_HideMaterial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) UnhideMaterial(ctx context.Context, in *UnhideMaterialIn, opts ...grpc.CallOption) (*ModerateMaterialOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerateMaterialOut)
+	err := c.cc.Invoke(ctx, MaterialsService_UnhideMaterial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) UploadCover(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadCoverIn, UploadCoverOut], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MaterialsService_ServiceDesc.Streams[0], MaterialsService_UploadCover_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadCoverIn, UploadCoverOut]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MaterialsService_UploadCoverClient = grpc.ClientStreamingClient[UploadCoverIn, UploadCoverOut]
+
+func (c *materialsServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentIn, UploadAttachmentOut], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MaterialsService_ServiceDesc.Streams[1], MaterialsService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAttachmentIn, UploadAttachmentOut]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MaterialsService_UploadAttachmentClient = grpc.ClientStreamingClient[UploadAttachmentIn, UploadAttachmentOut]
+
+func (c *materialsServiceClient) ListMaterialAttachments(ctx context.Context, in *ListMaterialAttachmentsIn, opts ...grpc.CallOption) (*ListMaterialAttachmentsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMaterialAttachmentsOut)
+	err := c.cc.Invoke(ctx, MaterialsService_ListMaterialAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) DeleteMaterialAttachment(ctx context.Context, in *DeleteMaterialAttachmentIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MaterialsService_DeleteMaterialAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) ExportMaterials(ctx context.Context, in *ExportMaterialsIn, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Material], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MaterialsService_ServiceDesc.Streams[2], MaterialsService_ExportMaterials_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportMaterialsIn, Material]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MaterialsService_ExportMaterialsClient = grpc.ServerStreamingClient[Material]
+
+func (c *materialsServiceClient) ExportMaterial(ctx context.Context, in *ExportMaterialIn, opts ...grpc.CallOption) (*ExportMaterialOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportMaterialOut)
+	err := c.cc.Invoke(ctx, MaterialsService_ExportMaterial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialsServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataIn, opts ...grpc.CallOption) (*ExportUserDataOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserDataOut)
+	err := c.cc.Invoke(ctx, MaterialsService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MaterialsServiceServer is the server API for MaterialsService service.
 // All implementations must embed UnimplementedMaterialsServiceServer
 // for forward compatibility.
 type MaterialsServiceServer interface {
 	SaveDraftMaterial(context.Context, *SaveDraftMaterialIn) (*SaveDraftMaterialOut, error)
 	GetMaterial(context.Context, *GetMaterialIn) (*GetMaterialOut, error)
+	GetMaterialsByUUIDs(context.Context, *GetMaterialsByUUIDsIn) (*GetMaterialsByUUIDsOut, error)
 	GetAllMaterials(context.Context, *emptypb.Empty) (*GetAllMaterialsOut, error)
 	EditMaterial(context.Context, *EditMaterialIn) (*EditMaterialOut, error)
 	PublishMaterial(context.Context, *PublishMaterialIn) (*PublishMaterialOut, error)
 	DeleteMaterial(context.Context, *DeleteMaterialIn) (*emptypb.Empty, error)
 	ArchivedMaterial(context.Context, *ArchivedMaterialIn) (*emptypb.Empty, error)
 	ToggleLike(context.Context, *ToggleLikeIn) (*ToggleLikeOut, error)
+	AutosaveDraft(context.Context, *AutosaveDraftIn) (*AutosaveDraftOut, error)
+	PromoteAutosave(context.Context, *PromoteAutosaveIn) (*PromoteAutosaveOut, error)
+	DuplicateMaterial(context.Context, *DuplicateMaterialIn) (*DuplicateMaterialOut, error)
+	GetDeletedMaterials(context.Context, *GetDeletedMaterialsIn) (*GetDeletedMaterialsOut, error)
+	RestoreMaterial(context.Context, *RestoreMaterialIn) (*RestoreMaterialOut, error)
+	UnarchiveMaterial(context.Context, *UnarchiveMaterialIn) (*UnarchiveMaterialOut, error)
+	GetArchivedMaterials(context.Context, *GetArchivedMaterialsIn) (*GetArchivedMaterialsOut, error)
+	BulkDeleteMaterials(context.Context, *BulkMaterialsIn) (*BulkMaterialsOut, error)
+	BulkArchiveMaterials(context.Context, *BulkMaterialsIn) (*BulkMaterialsOut, error)
+	BulkPublishMaterials(context.Context, *BulkMaterialsIn) (*BulkMaterialsOut, error)
+	BulkTagMaterials(context.Context, *BulkTagMaterialsIn) (*BulkMaterialsOut, error)
+	SetLike(context.Context, *SetLikeIn) (*SetLikeOut, error)
+	ListMaterialLikers(context.Context, *ListMaterialLikersIn) (*ListMaterialLikersOut, error)
+	SetReaction(context.Context, *SetReactionIn) (*ReactionsOut, error)
+	ClearReaction(context.Context, *ClearReactionIn) (*ReactionsOut, error)
+	GetTrendingMaterials(context.Context, *GetTrendingMaterialsIn) (*GetTrendingMaterialsOut, error)
+	GetRelatedMaterials(context.Context, *GetRelatedMaterialsIn) (*GetRelatedMaterialsOut, error)
+	ReportMaterial(context.Context, *ReportMaterialIn) (*ReportMaterialOut, error)
+	ListMaterialReports(context.Context, *ListMaterialReportsIn) (*ListMaterialReportsOut, error)
+	ResolveMaterialReport(context.Context, *ResolveMaterialReportIn) (*ResolveMaterialReportOut, error)
+	HideMaterial(context.Context, *HideMaterialIn) (*ModerateMaterialOut, error)
+	UnhideMaterial(context.Context, *UnhideMaterialIn) (*ModerateMaterialOut, error)
+	UploadCover(grpc.ClientStreamingServer[UploadCoverIn, UploadCoverOut]) error
+	UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentIn, UploadAttachmentOut]) error
+	ListMaterialAttachments(context.Context, *ListMaterialAttachmentsIn) (*ListMaterialAttachmentsOut, error)
+	DeleteMaterialAttachment(context.Context, *DeleteMaterialAttachmentIn) (*emptypb.Empty, error)
+	// Выгрузка постраничная по ключу (updated_at, uuid): каждая пачка читается после последней отданной строки, материал, изменённый во время выгрузки, может прийти повторно.
+	// updated_at ставится в момент изменения строки, а виден после коммита, поэтому для инкрементальной выгрузки updated_since стоит брать с запасом на длительность пишущих транзакций.
+	ExportMaterials(*ExportMaterialsIn, grpc.ServerStreamingServer[Material]) error
+	ExportMaterial(context.Context, *ExportMaterialIn) (*ExportMaterialOut, error)
+	ExportUserData(context.Context, *ExportUserDataIn) (*ExportUserDataOut, error)
 	mustEmbedUnimplementedMaterialsServiceServer()
 }
 
@@ -160,6 +569,9 @@ func (UnimplementedMaterialsServiceServer) SaveDraftMaterial(context.Context, *S
 func (UnimplementedMaterialsServiceServer) GetMaterial(context.Context, *GetMaterialIn) (*GetMaterialOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMaterial not implemented")
 }
+func (UnimplementedMaterialsServiceServer) GetMaterialsByUUIDs(context.Context, *GetMaterialsByUUIDsIn) (*GetMaterialsByUUIDsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaterialsByUUIDs not implemented")
+}
 func (UnimplementedMaterialsServiceServer) GetAllMaterials(context.Context, *emptypb.Empty) (*GetAllMaterialsOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllMaterials not implemented")
 }
@@ -178,6 +590,93 @@ func (UnimplementedMaterialsServiceServer) ArchivedMaterial(context.Context, *Ar
 func (UnimplementedMaterialsServiceServer) ToggleLike(context.Context, *ToggleLikeIn) (*ToggleLikeOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleLike not implemented")
 }
+func (UnimplementedMaterialsServiceServer) AutosaveDraft(context.Context, *AutosaveDraftIn) (*AutosaveDraftOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutosaveDraft not implemented")
+}
+func (UnimplementedMaterialsServiceServer) PromoteAutosave(context.Context, *PromoteAutosaveIn) (*PromoteAutosaveOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteAutosave not implemented")
+}
+func (UnimplementedMaterialsServiceServer) DuplicateMaterial(context.Context, *DuplicateMaterialIn) (*DuplicateMaterialOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DuplicateMaterial not implemented")
+}
+func (UnimplementedMaterialsServiceServer) GetDeletedMaterials(context.Context, *GetDeletedMaterialsIn) (*GetDeletedMaterialsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeletedMaterials not implemented")
+}
+func (UnimplementedMaterialsServiceServer) RestoreMaterial(context.Context, *RestoreMaterialIn) (*RestoreMaterialOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMaterial not implemented")
+}
+func (UnimplementedMaterialsServiceServer) UnarchiveMaterial(context.Context, *UnarchiveMaterialIn) (*UnarchiveMaterialOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveMaterial not implemented")
+}
+func (UnimplementedMaterialsServiceServer) GetArchivedMaterials(context.Context, *GetArchivedMaterialsIn) (*GetArchivedMaterialsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchivedMaterials not implemented")
+}
+func (UnimplementedMaterialsServiceServer) BulkDeleteMaterials(context.Context, *BulkMaterialsIn) (*BulkMaterialsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkDeleteMaterials not implemented")
+}
+func (UnimplementedMaterialsServiceServer) BulkArchiveMaterials(context.Context, *BulkMaterialsIn) (*BulkMaterialsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkArchiveMaterials not implemented")
+}
+func (UnimplementedMaterialsServiceServer) BulkPublishMaterials(context.Context, *BulkMaterialsIn) (*BulkMaterialsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkPublishMaterials not implemented")
+}
+func (UnimplementedMaterialsServiceServer) BulkTagMaterials(context.Context, *BulkTagMaterialsIn) (*BulkMaterialsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkTagMaterials not implemented")
+}
+func (UnimplementedMaterialsServiceServer) SetLike(context.Context, *SetLikeIn) (*SetLikeOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLike not implemented")
+}
+func (UnimplementedMaterialsServiceServer) ListMaterialLikers(context.Context, *ListMaterialLikersIn) (*ListMaterialLikersOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMaterialLikers not implemented")
+}
+func (UnimplementedMaterialsServiceServer) SetReaction(context.Context, *SetReactionIn) (*ReactionsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReaction not implemented")
+}
+func (UnimplementedMaterialsServiceServer) ClearReaction(context.Context, *ClearReactionIn) (*ReactionsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearReaction not implemented")
+}
+func (UnimplementedMaterialsServiceServer) GetTrendingMaterials(context.Context, *GetTrendingMaterialsIn) (*GetTrendingMaterialsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingMaterials not implemented")
+}
+func (UnimplementedMaterialsServiceServer) GetRelatedMaterials(context.Context, *GetRelatedMaterialsIn) (*GetRelatedMaterialsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelatedMaterials not implemented")
+}
+func (UnimplementedMaterialsServiceServer) ReportMaterial(context.Context, *ReportMaterialIn) (*ReportMaterialOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportMaterial not implemented")
+}
+func (UnimplementedMaterialsServiceServer) ListMaterialReports(context.Context, *ListMaterialReportsIn) (*ListMaterialReportsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMaterialReports not implemented")
+}
+func (UnimplementedMaterialsServiceServer) ResolveMaterialReport(context.Context, *ResolveMaterialReportIn) (*ResolveMaterialReportOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveMaterialReport not implemented")
+}
+func (UnimplementedMaterialsServiceServer) HideMaterial(context.Context, *HideMaterialIn) (*ModerateMaterialOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HideMaterial not implemented")
+}
+func (UnimplementedMaterialsServiceServer) UnhideMaterial(context.Context, *UnhideMaterialIn) (*ModerateMaterialOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnhideMaterial not implemented")
+}
+func (UnimplementedMaterialsServiceServer) UploadCover(grpc.ClientStreamingServer[UploadCoverIn, UploadCoverOut]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadCover not implemented")
+}
+func (UnimplementedMaterialsServiceServer) UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentIn, UploadAttachmentOut]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedMaterialsServiceServer) ListMaterialAttachments(context.Context, *ListMaterialAttachmentsIn) (*ListMaterialAttachmentsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMaterialAttachments not implemented")
+}
+func (UnimplementedMaterialsServiceServer) DeleteMaterialAttachment(context.Context, *DeleteMaterialAttachmentIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMaterialAttachment not implemented")
+}
+func (UnimplementedMaterialsServiceServer) ExportMaterials(*ExportMaterialsIn, grpc.ServerStreamingServer[Material]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportMaterials not implemented")
+}
+func (UnimplementedMaterialsServiceServer) ExportMaterial(context.Context, *ExportMaterialIn) (*ExportMaterialOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMaterial not implemented")
+}
+func (UnimplementedMaterialsServiceServer) ExportUserData(context.Context, *ExportUserDataIn) (*ExportUserDataOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
 func (UnimplementedMaterialsServiceServer) mustEmbedUnimplementedMaterialsServiceServer() {}
 func (UnimplementedMaterialsServiceServer) testEmbeddedByValue()                          {}
 
@@ -235,31 +734,49 @@ func _MaterialsService_GetMaterial_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MaterialsService_GetAllMaterials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MaterialsService_GetMaterialsByUUIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaterialsByUUIDsIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MaterialsServiceServer).GetAllMaterials(ctx, in)
+		return srv.(MaterialsServiceServer).GetMaterialsByUUIDs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MaterialsService_GetAllMaterials_FullMethodName,
+		FullMethod: MaterialsService_GetMaterialsByUUIDs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MaterialsServiceServer).GetAllMaterials(ctx, req.(*emptypb.Empty))
+		return srv.(MaterialsServiceServer).GetMaterialsByUUIDs(ctx, req.(*GetMaterialsByUUIDsIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MaterialsService_EditMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EditMaterialIn)
+func _MaterialsService_GetAllMaterials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MaterialsServiceServer).EditMaterial(ctx, in)
+		return srv.(MaterialsServiceServer).GetAllMaterials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_GetAllMaterials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).GetAllMaterials(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_EditMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditMaterialIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).EditMaterial(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -343,6 +860,499 @@ func _MaterialsService_ToggleLike_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MaterialsService_AutosaveDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutosaveDraftIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).AutosaveDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_AutosaveDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).AutosaveDraft(ctx, req.(*AutosaveDraftIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_PromoteAutosave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteAutosaveIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).PromoteAutosave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_PromoteAutosave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).PromoteAutosave(ctx, req.(*PromoteAutosaveIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_DuplicateMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateMaterialIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).DuplicateMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_DuplicateMaterial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).DuplicateMaterial(ctx, req.(*DuplicateMaterialIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_GetDeletedMaterials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeletedMaterialsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).GetDeletedMaterials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_GetDeletedMaterials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).GetDeletedMaterials(ctx, req.(*GetDeletedMaterialsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_RestoreMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMaterialIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).RestoreMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_RestoreMaterial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).RestoreMaterial(ctx, req.(*RestoreMaterialIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_UnarchiveMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchiveMaterialIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).UnarchiveMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_UnarchiveMaterial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).UnarchiveMaterial(ctx, req.(*UnarchiveMaterialIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_GetArchivedMaterials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArchivedMaterialsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).GetArchivedMaterials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_GetArchivedMaterials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).GetArchivedMaterials(ctx, req.(*GetArchivedMaterialsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_BulkDeleteMaterials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkMaterialsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).BulkDeleteMaterials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_BulkDeleteMaterials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).BulkDeleteMaterials(ctx, req.(*BulkMaterialsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_BulkArchiveMaterials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkMaterialsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).BulkArchiveMaterials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_BulkArchiveMaterials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).BulkArchiveMaterials(ctx, req.(*BulkMaterialsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_BulkPublishMaterials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkMaterialsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).BulkPublishMaterials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_BulkPublishMaterials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).BulkPublishMaterials(ctx, req.(*BulkMaterialsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_BulkTagMaterials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkTagMaterialsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).BulkTagMaterials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_BulkTagMaterials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).BulkTagMaterials(ctx, req.(*BulkTagMaterialsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_SetLike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLikeIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).SetLike(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_SetLike_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).SetLike(ctx, req.(*SetLikeIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_ListMaterialLikers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMaterialLikersIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).ListMaterialLikers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_ListMaterialLikers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).ListMaterialLikers(ctx, req.(*ListMaterialLikersIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_SetReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReactionIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).SetReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_SetReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).SetReaction(ctx, req.(*SetReactionIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_ClearReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearReactionIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).ClearReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_ClearReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).ClearReaction(ctx, req.(*ClearReactionIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_GetTrendingMaterials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingMaterialsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).GetTrendingMaterials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_GetTrendingMaterials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).GetTrendingMaterials(ctx, req.(*GetTrendingMaterialsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_GetRelatedMaterials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelatedMaterialsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).GetRelatedMaterials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_GetRelatedMaterials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).GetRelatedMaterials(ctx, req.(*GetRelatedMaterialsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_ReportMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportMaterialIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).ReportMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_ReportMaterial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).ReportMaterial(ctx, req.(*ReportMaterialIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_ListMaterialReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMaterialReportsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).ListMaterialReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_ListMaterialReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).ListMaterialReports(ctx, req.(*ListMaterialReportsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_ResolveMaterialReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveMaterialReportIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).ResolveMaterialReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_ResolveMaterialReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).ResolveMaterialReport(ctx, req.(*ResolveMaterialReportIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_HideMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HideMaterialIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).HideMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_HideMaterial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).HideMaterial(ctx, req.(*HideMaterialIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_UnhideMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnhideMaterialIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).UnhideMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_UnhideMaterial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).UnhideMaterial(ctx, req.(*UnhideMaterialIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_UploadCover_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MaterialsServiceServer).UploadCover(&grpc.GenericServerStream[UploadCoverIn, UploadCoverOut]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MaterialsService_UploadCoverServer = grpc.ClientStreamingServer[UploadCoverIn, UploadCoverOut]
+
+func _MaterialsService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MaterialsServiceServer).UploadAttachment(&grpc.GenericServerStream[UploadAttachmentIn, UploadAttachmentOut]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MaterialsService_UploadAttachmentServer = grpc.ClientStreamingServer[UploadAttachmentIn, UploadAttachmentOut]
+
+func _MaterialsService_ListMaterialAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMaterialAttachmentsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).ListMaterialAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_ListMaterialAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).ListMaterialAttachments(ctx, req.(*ListMaterialAttachmentsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_DeleteMaterialAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMaterialAttachmentIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).DeleteMaterialAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_DeleteMaterialAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).DeleteMaterialAttachment(ctx, req.(*DeleteMaterialAttachmentIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_ExportMaterials_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportMaterialsIn)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MaterialsServiceServer).ExportMaterials(m, &grpc.GenericServerStream[ExportMaterialsIn, Material]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MaterialsService_ExportMaterialsServer = grpc.ServerStreamingServer[Material]
+
+func _MaterialsService_ExportMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMaterialIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).ExportMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_ExportMaterial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).ExportMaterial(ctx, req.(*ExportMaterialIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialsService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialsServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialsService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialsServiceServer).ExportUserData(ctx, req.(*ExportUserDataIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MaterialsService_ServiceDesc is the grpc.ServiceDesc for MaterialsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -358,6 +1368,10 @@ var MaterialsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMaterial",
 			Handler:    _MaterialsService_GetMaterial_Handler,
 		},
+		{
+			MethodName: "GetMaterialsByUUIDs",
+			Handler:    _MaterialsService_GetMaterialsByUUIDs_Handler,
+		},
 		{
 			MethodName: "GetAllMaterials",
 			Handler:    _MaterialsService_GetAllMaterials_Handler,
@@ -382,7 +1396,127 @@ var MaterialsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleLike",
 			Handler:    _MaterialsService_ToggleLike_Handler,
 		},
+		{
+			MethodName: "AutosaveDraft",
+			Handler:    _MaterialsService_AutosaveDraft_Handler,
+		},
+		{
+			MethodName: "PromoteAutosave",
+			Handler:    _MaterialsService_PromoteAutosave_Handler,
+		},
+		{
+			MethodName: "DuplicateMaterial",
+			Handler:    _MaterialsService_DuplicateMaterial_Handler,
+		},
+		{
+			MethodName: "GetDeletedMaterials",
+			Handler:    _MaterialsService_GetDeletedMaterials_Handler,
+		},
+		{
+			MethodName: "RestoreMaterial",
+			Handler:    _MaterialsService_RestoreMaterial_Handler,
+		},
+		{
+			MethodName: "UnarchiveMaterial",
+			Handler:    _MaterialsService_UnarchiveMaterial_Handler,
+		},
+		{
+			MethodName: "GetArchivedMaterials",
+			Handler:    _MaterialsService_GetArchivedMaterials_Handler,
+		},
+		{
+			MethodName: "BulkDeleteMaterials",
+			Handler:    _MaterialsService_BulkDeleteMaterials_Handler,
+		},
+		{
+			MethodName: "BulkArchiveMaterials",
+			Handler:    _MaterialsService_BulkArchiveMaterials_Handler,
+		},
+		{
+			MethodName: "BulkPublishMaterials",
+			Handler:    _MaterialsService_BulkPublishMaterials_Handler,
+		},
+		{
+			MethodName: "BulkTagMaterials",
+			Handler:    _MaterialsService_BulkTagMaterials_Handler,
+		},
+		{
+			MethodName: "SetLike",
+			Handler:    _MaterialsService_SetLike_Handler,
+		},
+		{
+			MethodName: "ListMaterialLikers",
+			Handler:    _MaterialsService_ListMaterialLikers_Handler,
+		},
+		{
+			MethodName: "SetReaction",
+			Handler:    _MaterialsService_SetReaction_Handler,
+		},
+		{
+			MethodName: "ClearReaction",
+			Handler:    _MaterialsService_ClearReaction_Handler,
+		},
+		{
+			MethodName: "GetTrendingMaterials",
+			Handler:    _MaterialsService_GetTrendingMaterials_Handler,
+		},
+		{
+			MethodName: "GetRelatedMaterials",
+			Handler:    _MaterialsService_GetRelatedMaterials_Handler,
+		},
+		{
+			MethodName: "ReportMaterial",
+			Handler:    _MaterialsService_ReportMaterial_Handler,
+		},
+		{
+			MethodName: "ListMaterialReports",
+			Handler:    _MaterialsService_ListMaterialReports_Handler,
+		},
+		{
+			MethodName: "ResolveMaterialReport",
+			Handler:    _MaterialsService_ResolveMaterialReport_Handler,
+		},
+		{
+			MethodName: "HideMaterial",
+			Handler:    _MaterialsService_HideMaterial_Handler,
+		},
+		{
+			MethodName: "UnhideMaterial",
+			Handler:    _MaterialsService_UnhideMaterial_Handler,
+		},
+		{
+			MethodName: "ListMaterialAttachments",
+			Handler:    _MaterialsService_ListMaterialAttachments_Handler,
+		},
+		{
+			MethodName: "DeleteMaterialAttachment",
+			Handler:    _MaterialsService_DeleteMaterialAttachment_Handler,
+		},
+		{
+			MethodName: "ExportMaterial",
+			Handler:    _MaterialsService_ExportMaterial_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _MaterialsService_ExportUserData_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadCover",
+			Handler:       _MaterialsService_UploadCover_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _MaterialsService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportMaterials",
+			Handler:       _MaterialsService_ExportMaterials_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/materials.proto",
 }